@@ -15,6 +15,8 @@ type AutonomousDatabasesSpec struct {
 	AdbId         OCID   `json:"id,omitempty"`
 	CompartmentId OCID   `json:"compartmentId,omitempty"`
 	DisplayName   string `json:"displayName,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dbName is immutable"
 	DbName               string         `json:"dbName,omitempty"`
 	DbWorkload           string         `json:"dbWorkload,omitempty"`
@@ -59,6 +59,17 @@ type ContainerVolumeMount struct {
 	IsReadOnly *bool `json:"isReadOnly,omitempty"`
 }
 
+// ContainerVolume declares an empty directory volume that the containers in the instance can mount.
+type ContainerVolume struct {
+	// Name identifies the volume in volume mounts and must be unique within the container instance.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// BackingStore is EPHEMERAL_STORAGE (the default) or MEMORY.
+	// +kubebuilder:validation:Enum=EPHEMERAL_STORAGE;MEMORY
+	BackingStore string `json:"backingStore,omitempty"`
+}
+
 // ContainerImagePullSecret holds credentials for pulling images from a private registry.
 type ContainerImagePullSecret struct {
 	// RegistryEndpoint is the registry hostname (e.g. "registry.example.com").
@@ -114,6 +125,10 @@ type ContainerInstanceGCPolicy struct {
 }
 
 // ContainerInstanceSpec defines the desired state of ContainerInstance
+// +kubebuilder:validation:XValidation:rule="(has(self.recreateOnChange) && self.recreateOnChange) || self.shape == oldSelf.shape",message="shape is immutable unless recreateOnChange is set"
+// +kubebuilder:validation:XValidation:rule="(has(self.recreateOnChange) && self.recreateOnChange) || self.containers == oldSelf.containers",message="containers is immutable unless recreateOnChange is set"
+// +kubebuilder:validation:XValidation:rule="(has(self.recreateOnChange) && self.recreateOnChange) || has(self.volumes) == has(oldSelf.volumes) && (!has(self.volumes) || self.volumes == oldSelf.volumes)",message="volumes is immutable unless recreateOnChange is set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.recreateOnChange) && self.recreateOnChange) || !has(self.id) || size(self.id) == 0",message="recreateOnChange cannot be set on an instance bound through id"
 type ContainerInstanceSpec struct {
 	// ContainerInstanceId is the OCID of an existing ContainerInstance to bind to (optional).
 	ContainerInstanceId OCID `json:"id,omitempty"`
@@ -129,7 +144,6 @@ type ContainerInstanceSpec struct {
 
 	// Shape is the OCI shape for the container instance (e.g. "CI.Standard.E4.Flex").
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="shape is immutable"
 	Shape string `json:"shape"`
 
 	// ShapeConfig specifies the OCPUs and memory for the shape.
@@ -139,9 +153,11 @@ type ContainerInstanceSpec struct {
 	// Containers is the list of containers to run in this instance.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="containers is immutable"
 	Containers []ContainerDetails `json:"containers"`
 
+	// Volumes declares the volumes the containers can mount by name.
+	Volumes []ContainerVolume `json:"volumes,omitempty"`
+
 	// Vnics defines the networking configuration for the container instance.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
@@ -160,29 +176,68 @@ type ContainerInstanceSpec struct {
 	GracefulShutdownTimeoutInSeconds *int64 `json:"gracefulShutdownTimeoutInSeconds,omitempty"`
 
 	// ContainerRestartPolicy controls container restart behaviour (ALWAYS, NEVER, ON_FAILURE).
+	// +kubebuilder:validation:Enum=ALWAYS;NEVER;ON_FAILURE
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="containerRestartPolicy is immutable"
 	ContainerRestartPolicy *string `json:"containerRestartPolicy,omitempty"`
 
+	// State is the run state to keep the container instance in (RUNNING, INACTIVE). The instance is
+	// started or stopped when its live state differs. When empty, the run state is left as it is in OCI.
+	// +kubebuilder:validation:Enum=RUNNING;INACTIVE
+	State string `json:"state,omitempty"`
+
 	// ImagePullSecrets provides credentials for pulling images from private registries.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="imagePullSecrets is immutable"
 	ImagePullSecrets []ContainerImagePullSecret `json:"imagePullSecrets,omitempty"`
 
+	// ImagePullSecretRef names a kubernetes.io/dockerconfigjson secret in the resource's namespace. Each
+	// registry in it is added to ImagePullSecrets when the container instance is created.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="imagePullSecretRef is immutable"
+	ImagePullSecretRef SecretSource `json:"imagePullSecretRef,omitempty"`
+
 	// GCPolicy controls garbage collection of old container instances.
 	// Defaults to keeping the 3 most recent non-DELETED instances.
 	GCPolicy *ContainerInstanceGCPolicy `json:"gcPolicy,omitempty"`
 
+	// RecreateOnChange allows Shape, Volumes and Containers changes by deleting the container instance
+	// and creating a new one, since OCI cannot update them in place. Any difference in a container's
+	// image, command, arguments, environment, resources or mounts triggers a recreate. It cannot be
+	// set together with id, since the operator does not recreate instances it did not create.
+	RecreateOnChange bool `json:"recreateOnChange,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
+// ContainerState is the observed state of one container in the container instance.
+type ContainerState struct {
+	// Name is the display name of the container.
+	Name string `json:"name"`
+
+	// ContainerId is the OCID of the container.
+	ContainerId OCID `json:"containerId,omitempty"`
+
+	// LifecycleState is the container's OCI lifecycle state (e.g. ACTIVE, INACTIVE, FAILED).
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// ExitCode is the exit code of the container process once it has stopped.
+	ExitCode *int `json:"exitCode,omitempty"`
+}
+
 // ContainerInstanceStatus defines the observed state of ContainerInstance
 type ContainerInstanceStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// ContainerStates is refreshed from OCI on every reconcile.
+	ContainerStates []ContainerState `json:"containerStates,omitempty"`
+
+	// Ready reports how many containers are ACTIVE, as "<active>/<total>".
+	Ready string `json:"ready,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".spec.displayName",priority=1
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the ContainerInstance",priority=0
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="active containers out of total",priority=0
 // +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the ContainerInstance",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 
@@ -10,13 +10,17 @@ import (
 )
 
 // OciVcnSpec defines the desired state of OciVcn
+// +kubebuilder:validation:XValidation:rule="has(self.compartmentId) || has(self.compartmentName)",message="one of compartmentId or compartmentName is required"
 type OciVcnSpec struct {
 	// VcnId is the OCID of an existing VCN to bind to (optional; if omitted, a new VCN is created)
 	VcnId OCID `json:"id,omitempty"`
 
-	// CompartmentId is the OCID of the compartment in which to create the VCN
-	// +kubebuilder:validation:Required
-	CompartmentId OCID `json:"compartmentId"`
+	// CompartmentId is the OCID of the compartment in which to create the VCN (required unless compartmentName is set)
+	CompartmentId OCID `json:"compartmentId,omitempty"`
+
+	// CompartmentName names the compartment instead of its OCID, either as a plain name that is
+	// unique in the tenancy or as a path from the root such as "network/prod" (optional)
+	CompartmentName string `json:"compartmentName,omitempty"`
 
 	// DisplayName is a user-friendly name for the VCN
 	// +kubebuilder:validation:Required
@@ -31,12 +35,34 @@ type OciVcnSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsLabel is immutable"
 	DnsLabel string `json:"dnsLabel,omitempty"`
 
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciVcnStatus defines the observed state of OciVcn
 type OciVcnStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// DefaultRouteTableId is the OCID of the route table OCI created with the VCN
+	DefaultRouteTableId OCID `json:"defaultRouteTableId,omitempty"`
+
+	// DefaultSecurityListId is the OCID of the security list OCI created with the VCN
+	DefaultSecurityListId OCID `json:"defaultSecurityListId,omitempty"`
+
+	// DefaultDhcpOptionsId is the OCID of the DHCP options OCI created with the VCN
+	DefaultDhcpOptionsId OCID `json:"defaultDhcpOptionsId,omitempty"`
+
+	// CreateRetryToken is the OCI retry token reserved for creating the VCN; it is cleared once the VCN's
+	// OCID is recorded
+	CreateRetryToken string `json:"createRetryToken,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -68,29 +94,51 @@ func init() {
 	SchemeBuilder.Register(&OciVcn{}, &OciVcnList{})
 }
 
+// ResourceRef names another custom resource by namespace and name.
+type ResourceRef struct {
+	// Namespace of the referenced resource (defaults to the referencing resource's namespace)
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the referenced resource
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
 // OciSubnetSpec defines the desired state of OciSubnet
+// +kubebuilder:validation:XValidation:rule="!has(self.flowLogsEnabled) || !self.flowLogsEnabled || has(self.flowLogGroupId)",message="flowLogGroupId is required when flowLogsEnabled is true"
+// +kubebuilder:validation:XValidation:rule="has(self.compartmentId) || has(self.compartmentName)",message="one of compartmentId or compartmentName is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.useVcnDefaultRouteTable) || !self.useVcnDefaultRouteTable || !has(self.routeTableId)",message="routeTableId must be empty when useVcnDefaultRouteTable is true"
+// +kubebuilder:validation:XValidation:rule="has(self.vcnId) || has(self.vcnRef)",message="one of vcnId or vcnRef is required"
 type OciSubnetSpec struct {
 	// SubnetId is the OCID of an existing Subnet to bind to (optional; if omitted, a new subnet is created)
 	SubnetId OCID `json:"id,omitempty"`
 
-	// CompartmentId is the OCID of the compartment in which to create the Subnet
-	// +kubebuilder:validation:Required
-	CompartmentId OCID `json:"compartmentId"`
+	// CompartmentId is the OCID of the compartment in which to create the Subnet (required unless compartmentName is set)
+	CompartmentId OCID `json:"compartmentId,omitempty"`
+
+	// CompartmentName names the compartment instead of its OCID, either as a plain name that is
+	// unique in the tenancy or as a path from the root such as "network/prod" (optional)
+	CompartmentName string `json:"compartmentName,omitempty"`
 
 	// DisplayName is a user-friendly name for the Subnet
 	// +kubebuilder:validation:Required
 	DisplayName string `json:"displayName"`
 
-	// VcnId is the OCID of the VCN that contains this subnet
-	// +kubebuilder:validation:Required
+	// VcnId is the OCID of the VCN that contains this subnet (required unless vcnRef is set)
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
-	VcnId OCID `json:"vcnId"`
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// VcnRef names the OciVcn that contains this subnet, used instead of vcnId. The VCN's OCID is read
+	// from the OciVcn's status, and reconciling waits until the OciVcn has one.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnRef is immutable"
+	VcnRef *ResourceRef `json:"vcnRef,omitempty"`
 
 	// CidrBlock is the CIDR block for the subnet
 	// +kubebuilder:validation:Required
 	CidrBlock string `json:"cidrBlock"`
 
-	// AvailabilityDomain is the availability domain for the subnet (omit for regional subnet)
+	// AvailabilityDomain is the availability domain for the subnet (omit for regional subnet).
+	// A short name such as "AD-1" or "1" is resolved to the full name in the subnet's region.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="availabilityDomain is immutable"
 	AvailabilityDomain string `json:"availabilityDomain,omitempty"`
 
@@ -98,22 +146,85 @@ type OciSubnetSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsLabel is immutable"
 	DnsLabel string `json:"dnsLabel,omitempty"`
 
+	// AutoDnsLabel derives the DNS label from DisplayName at creation when DnsLabel is empty and the
+	// VCN is DNS-enabled; a numeric suffix is added if another subnet in the VCN already uses it (optional)
+	AutoDnsLabel bool `json:"autoDnsLabel,omitempty"`
+
 	// ProhibitPublicIpOnVnic controls whether VNICs in this subnet can have public IPs
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="prohibitPublicIpOnVnic is immutable"
 	ProhibitPublicIpOnVnic bool `json:"prohibitPublicIpOnVnic,omitempty"`
 
-	// RouteTableId is the OCID of the route table the subnet uses (optional)
+	// RouteTableId is the OCID of the route table the subnet uses (optional). Removing it after it was
+	// applied moves the subnet back to the VCN's default route table
 	RouteTableId OCID `json:"routeTableId,omitempty"`
 
+	// UseVcnDefaultRouteTable keeps the subnet on the VCN's default route table, resetting it if the
+	// route table was changed (optional)
+	UseVcnDefaultRouteTable bool `json:"useVcnDefaultRouteTable,omitempty"`
+
 	// SecurityListIds is the list of security list OCIDs associated with the subnet (optional)
 	SecurityListIds []OCID `json:"securityListIds,omitempty"`
 
+	// Ipv6CidrBlocks are the /64 IPv6 prefixes assigned to the subnet; the VCN must be IPv6-enabled (optional)
+	Ipv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// FlowLogsEnabled captures VCN flow logs for the subnet into FlowLogGroupId when true, and
+	// removes the flow log when set back to false (optional)
+	FlowLogsEnabled bool `json:"flowLogsEnabled,omitempty"`
+
+	// FlowLogGroupId is the OCID of the log group that receives the subnet's flow log
+	FlowLogGroupId OCID `json:"flowLogGroupId,omitempty"`
+
+	// PrivateViewId is the OCID of a private DNS view to attach to the VCN's DNS resolver so names in
+	// its zones resolve for the subnet; clearing it detaches the view (optional)
+	PrivateViewId OCID `json:"privateViewId,omitempty"`
+
+	// DnsResolverId is the OCID of the DNS resolver to attach PrivateViewId to; defaults to the
+	// resolver OCI created for the VCN (optional)
+	DnsResolverId OCID `json:"dnsResolverId,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciSubnetStatus defines the observed state of OciSubnet
 type OciSubnetStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// FlowLogGroupId is the log group holding the flow log the operator created for the subnet
+	FlowLogGroupId OCID `json:"flowLogGroupId,omitempty"`
+
+	// FlowLogCaptureFilterId is the flow log capture filter the operator created for the subnet
+	FlowLogCaptureFilterId OCID `json:"flowLogCaptureFilterId,omitempty"`
+
+	// PrivateViewId is the private DNS view the operator attached for the subnet
+	PrivateViewId OCID `json:"privateViewId,omitempty"`
+
+	// DnsResolverId is the DNS resolver PrivateViewId is attached to
+	DnsResolverId OCID `json:"dnsResolverId,omitempty"`
+
+	// RouteTableId is the route table the operator last applied to the subnet from spec.routeTableId
+	RouteTableId OCID `json:"routeTableId,omitempty"`
+
+	// CreateRetryToken is the OCI retry token reserved for creating the subnet; it is cleared once the
+	// subnet's OCID is recorded
+	CreateRetryToken string `json:"createRetryToken,omitempty"`
+
+	// IsPublic reports whether the subnet is internet-facing: it allows public IPs and internet ingress,
+	// and its route table sends the default route to an internet gateway
+	IsPublic bool `json:"isPublic"`
+
+	// VcnGeneration is the metadata.generation of the parent OciVcn when the resource was last reconciled
+	// successfully, so a later change to the VCN spec reconciles the resource again
+	VcnGeneration int64 `json:"vcnGeneration,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -121,6 +232,7 @@ type OciSubnetStatus struct {
 // +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".spec.displayName",priority=1
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciSubnet",priority=0
 // +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciSubnet",priority=1
+// +kubebuilder:printcolumn:name="Public",type="boolean",JSONPath=".status.isPublic",description="whether the OciSubnet is internet-facing",priority=0
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 
 // OciSubnet is the Schema for the ocisubnets API
@@ -146,6 +258,7 @@ func init() {
 }
 
 // OciInternetGatewaySpec defines the desired state of OciInternetGateway
+// +kubebuilder:validation:XValidation:rule="has(self.vcnId) || has(self.vcnRef)",message="one of vcnId or vcnRef is required"
 type OciInternetGatewaySpec struct {
 	// InternetGatewayId is the OCID of an existing Internet Gateway to bind to (optional)
 	InternetGatewayId OCID `json:"id,omitempty"`
@@ -154,10 +267,14 @@ type OciInternetGatewaySpec struct {
 	// +kubebuilder:validation:Required
 	CompartmentId OCID `json:"compartmentId"`
 
-	// VcnId is the OCID of the VCN that contains this Internet Gateway
-	// +kubebuilder:validation:Required
+	// VcnId is the OCID of the VCN that contains this Internet Gateway (required unless vcnRef is set)
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
-	VcnId OCID `json:"vcnId"`
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// VcnRef names the OciVcn that contains this Internet Gateway, used instead of vcnId. The VCN's OCID is read
+	// from the OciVcn's status, and reconciling waits until the OciVcn has one.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnRef is immutable"
+	VcnRef *ResourceRef `json:"vcnRef,omitempty"`
 
 	// DisplayName is a user-friendly name for the Internet Gateway
 	// +kubebuilder:validation:Required
@@ -166,12 +283,25 @@ type OciInternetGatewaySpec struct {
 	// IsEnabled controls whether the Internet Gateway is enabled (default true)
 	IsEnabled bool `json:"isEnabled,omitempty"`
 
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciInternetGatewayStatus defines the observed state of OciInternetGateway
 type OciInternetGatewayStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// VcnGeneration is the metadata.generation of the parent OciVcn when the resource was last reconciled
+	// successfully, so a later change to the VCN spec reconciles the resource again
+	VcnGeneration int64 `json:"vcnGeneration,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -204,6 +334,7 @@ func init() {
 }
 
 // OciNatGatewaySpec defines the desired state of OciNatGateway
+// +kubebuilder:validation:XValidation:rule="has(self.vcnId) || has(self.vcnRef)",message="one of vcnId or vcnRef is required"
 type OciNatGatewaySpec struct {
 	// NatGatewayId is the OCID of an existing NAT Gateway to bind to (optional)
 	NatGatewayId OCID `json:"id,omitempty"`
@@ -212,10 +343,14 @@ type OciNatGatewaySpec struct {
 	// +kubebuilder:validation:Required
 	CompartmentId OCID `json:"compartmentId"`
 
-	// VcnId is the OCID of the VCN that contains this NAT Gateway
-	// +kubebuilder:validation:Required
+	// VcnId is the OCID of the VCN that contains this NAT Gateway (required unless vcnRef is set)
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
-	VcnId OCID `json:"vcnId"`
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// VcnRef names the OciVcn that contains this NAT Gateway, used instead of vcnId. The VCN's OCID is read
+	// from the OciVcn's status, and reconciling waits until the OciVcn has one.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnRef is immutable"
+	VcnRef *ResourceRef `json:"vcnRef,omitempty"`
 
 	// DisplayName is a user-friendly name for the NAT Gateway
 	// +kubebuilder:validation:Required
@@ -224,12 +359,25 @@ type OciNatGatewaySpec struct {
 	// BlockTraffic controls whether the NAT Gateway blocks traffic (default false)
 	BlockTraffic bool `json:"blockTraffic,omitempty"`
 
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciNatGatewayStatus defines the observed state of OciNatGateway
 type OciNatGatewayStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// VcnGeneration is the metadata.generation of the parent OciVcn when the resource was last reconciled
+	// successfully, so a later change to the VCN spec reconciles the resource again
+	VcnGeneration int64 `json:"vcnGeneration,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -262,6 +410,7 @@ func init() {
 }
 
 // OciServiceGatewaySpec defines the desired state of OciServiceGateway
+// +kubebuilder:validation:XValidation:rule="has(self.vcnId) || has(self.vcnRef)",message="one of vcnId or vcnRef is required"
 type OciServiceGatewaySpec struct {
 	// ServiceGatewayId is the OCID of an existing Service Gateway to bind to (optional)
 	ServiceGatewayId OCID `json:"id,omitempty"`
@@ -270,10 +419,14 @@ type OciServiceGatewaySpec struct {
 	// +kubebuilder:validation:Required
 	CompartmentId OCID `json:"compartmentId"`
 
-	// VcnId is the OCID of the VCN that contains this Service Gateway
-	// +kubebuilder:validation:Required
+	// VcnId is the OCID of the VCN that contains this Service Gateway (required unless vcnRef is set)
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
-	VcnId OCID `json:"vcnId"`
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// VcnRef names the OciVcn that contains this Service Gateway, used instead of vcnId. The VCN's OCID is read
+	// from the OciVcn's status, and reconciling waits until the OciVcn has one.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnRef is immutable"
+	VcnRef *ResourceRef `json:"vcnRef,omitempty"`
 
 	// DisplayName is a user-friendly name for the Service Gateway
 	// +kubebuilder:validation:Required
@@ -283,12 +436,29 @@ type OciServiceGatewaySpec struct {
 	// +kubebuilder:validation:Required
 	Services []string `json:"services"`
 
+	// BlockTraffic controls whether the Service Gateway blocks traffic (default false).
+	// OCI always creates service gateways unblocked, so a true value is applied by the first update after create.
+	BlockTraffic bool `json:"blockTraffic,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciServiceGatewayStatus defines the observed state of OciServiceGateway
 type OciServiceGatewayStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// VcnGeneration is the metadata.generation of the parent OciVcn when the resource was last reconciled
+	// successfully, so a later change to the VCN spec reconciles the resource again
+	VcnGeneration int64 `json:"vcnGeneration,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -333,18 +503,67 @@ type OciDrgSpec struct {
 	// +kubebuilder:validation:Required
 	DisplayName string `json:"displayName"`
 
+	// RouteDistribution manages the statements of one of the DRG's route distributions (optional).
+	// When omitted, route distributions are left as configured in OCI.
+	RouteDistribution *DrgRouteDistribution `json:"routeDistribution,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
+// DrgRouteDistribution selects a DRG route distribution and the complete set of statements it should contain
+type DrgRouteDistribution struct {
+	// DrgRouteDistributionId is the OCID of the route distribution to manage.
+	// Defaults to the DRG's default export route distribution.
+	DrgRouteDistributionId OCID `json:"id,omitempty"`
+
+	// Statements replace the distribution's statements; statements not listed here are removed
+	Statements []DrgRouteDistributionStatement `json:"statements,omitempty"`
+}
+
+// DrgRouteDistributionStatement is an ACCEPT statement in a DRG route distribution
+// +kubebuilder:validation:XValidation:rule="self.matchType != 'DRG_ATTACHMENT_TYPE' || has(self.attachmentType)",message="attachmentType is required when matchType is DRG_ATTACHMENT_TYPE"
+// +kubebuilder:validation:XValidation:rule="self.matchType != 'DRG_ATTACHMENT_ID' || has(self.drgAttachmentId)",message="drgAttachmentId is required when matchType is DRG_ATTACHMENT_ID"
+type DrgRouteDistributionStatement struct {
+	// Priority orders the statement within the distribution; lower numbers are applied first and must be unique
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority int `json:"priority"`
+
+	// MatchType selects which DRG attachments the statement matches
+	// +kubebuilder:validation:Enum=MATCH_ALL;DRG_ATTACHMENT_TYPE;DRG_ATTACHMENT_ID
+	MatchType string `json:"matchType"`
+
+	// AttachmentType is the attachment type to match when MatchType is DRG_ATTACHMENT_TYPE
+	// +kubebuilder:validation:Enum=VCN;VIRTUAL_CIRCUIT;REMOTE_PEERING_CONNECTION;IPSEC_TUNNEL
+	AttachmentType string `json:"attachmentType,omitempty"`
+
+	// DrgAttachmentId is the OCID of the attachment to match when MatchType is DRG_ATTACHMENT_ID
+	DrgAttachmentId OCID `json:"drgAttachmentId,omitempty"`
+}
+
 // OciDrgStatus defines the observed state of OciDrg
 type OciDrgStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// DrgVersion is V1 for a legacy DRG that has not been upgraded, or V2 for a DRGv2.
+	// Route distributions can only be managed on a V2 DRG.
+	DrgVersion string `json:"drgVersion,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".spec.displayName",priority=1
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciDrg",priority=0
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.drgVersion",description="V1 or V2 DRG",priority=1
 // +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciDrg",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 
@@ -370,6 +589,86 @@ func init() {
 	SchemeBuilder.Register(&OciDrg{}, &OciDrgList{})
 }
 
+// OciLocalPeeringGatewaySpec defines the desired state of OciLocalPeeringGateway
+type OciLocalPeeringGatewaySpec struct {
+	// LocalPeeringGatewayId is the OCID of an existing Local Peering Gateway to bind to (optional)
+	LocalPeeringGatewayId OCID `json:"id,omitempty"`
+
+	// CompartmentId is the OCID of the compartment in which to create the Local Peering Gateway
+	// +kubebuilder:validation:Required
+	CompartmentId OCID `json:"compartmentId"`
+
+	// VcnId is the OCID of the VCN that contains this Local Peering Gateway
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
+	VcnId OCID `json:"vcnId"`
+
+	// DisplayName is a user-friendly name for the Local Peering Gateway
+	// +kubebuilder:validation:Required
+	DisplayName string `json:"displayName"`
+
+	// RouteTableId is the OCID of the route table that routes traffic arriving from the peer (optional)
+	RouteTableId OCID `json:"routeTableId,omitempty"`
+
+	// PeerId is the OCID of the Local Peering Gateway in the other VCN to peer with (optional).
+	// The peering is established once both gateways are AVAILABLE and cannot be moved to another peer.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="peerId cannot be changed once set"
+	PeerId OCID `json:"peerId,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
+	TagResources `json:",inline,omitempty"`
+}
+
+// OciLocalPeeringGatewayStatus defines the observed state of OciLocalPeeringGateway
+type OciLocalPeeringGatewayStatus struct {
+	OsokStatus OSOKStatus `json:"status"`
+
+	// PeeringStatus is the peering state OCI reports, such as NEW, PENDING, or PEERED
+	PeeringStatus string `json:"peeringStatus,omitempty"`
+
+	// VcnGeneration is the metadata.generation of the parent OciVcn when the resource was last reconciled
+	// successfully, so a later change to the VCN spec reconciles the resource again
+	VcnGeneration int64 `json:"vcnGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".spec.displayName",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciLocalPeeringGateway",priority=0
+// +kubebuilder:printcolumn:name="Peering",type="string",JSONPath=".status.peeringStatus",description="peering status of the OciLocalPeeringGateway",priority=0
+// +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciLocalPeeringGateway",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// OciLocalPeeringGateway is the Schema for the ocilocalpeeringgateways API
+type OciLocalPeeringGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OciLocalPeeringGatewaySpec   `json:"spec,omitempty"`
+	Status OciLocalPeeringGatewayStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OciLocalPeeringGatewayList contains a list of OciLocalPeeringGateway
+type OciLocalPeeringGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OciLocalPeeringGateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OciLocalPeeringGateway{}, &OciLocalPeeringGatewayList{})
+}
+
 // IngressSecurityRule defines an ingress rule for a security list
 type IngressSecurityRule struct {
 	Protocol    string      `json:"protocol"`
@@ -378,6 +677,12 @@ type IngressSecurityRule struct {
 	Description string      `json:"description,omitempty"`
 	TcpOptions  *TcpOptions `json:"tcpOptions,omitempty"`
 	UdpOptions  *UdpOptions `json:"udpOptions,omitempty"`
+
+	// Ports expands a TCP or UDP rule into one OCI rule per destination port
+	Ports []int `json:"ports,omitempty"`
+
+	// PortRanges expands a TCP or UDP rule into one OCI rule per destination port range
+	PortRanges []PortRange `json:"portRanges,omitempty"`
 }
 
 // EgressSecurityRule defines an egress rule
@@ -389,6 +694,12 @@ type EgressSecurityRule struct {
 	Description     string      `json:"description,omitempty"`
 	TcpOptions      *TcpOptions `json:"tcpOptions,omitempty"`
 	UdpOptions      *UdpOptions `json:"udpOptions,omitempty"`
+
+	// Ports expands a TCP or UDP rule into one OCI rule per destination port
+	Ports []int `json:"ports,omitempty"`
+
+	// PortRanges expands a TCP or UDP rule into one OCI rule per destination port range
+	PortRanges []PortRange `json:"portRanges,omitempty"`
 }
 
 // PortRange defines min/max port
@@ -433,12 +744,58 @@ type OciSecurityListSpec struct {
 	// EgressSecurityRules are the egress rules
 	EgressSecurityRules []EgressSecurityRule `json:"egressSecurityRules,omitempty"`
 
+	// RuleSetRefs names OciSecurityRuleSets in the resource's namespace whose rules are added to the
+	// inline rules. Rules that repeat an earlier rule are dropped (optional)
+	RuleSetRefs []string `json:"ruleSetRefs,omitempty"`
+
+	// RulesFromConfigMap names a ConfigMap key in the resource's namespace whose rules are added to the
+	// inline rules after those of RuleSetRefs. Rules that repeat an earlier rule are dropped (optional)
+	RulesFromConfigMap *ConfigMapRulesSource `json:"rulesFromConfigMap,omitempty"`
+
+	// RuleManagementMode controls how the rules are applied on update. Replace makes the Security List
+	// hold exactly the spec rules. Merge only adds, updates and removes rules the operator owns and
+	// leaves rules managed outside the operator in place.
+	// +kubebuilder:validation:Enum=Replace;Merge
+	// +kubebuilder:default=Replace
+	RuleManagementMode string `json:"ruleManagementMode,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
+// ConfigMapRulesSource selects a key of a ConfigMap holding security rules as YAML or JSON, in the form
+// of an OciSecurityRuleSet spec: ingressSecurityRules and egressSecurityRules
+type ConfigMapRulesSource struct {
+	// Name is the name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key of the ConfigMap entry holding the rules
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
 // OciSecurityListStatus defines the observed state of OciSecurityList
 type OciSecurityListStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// ObservedIngressRules are the ingress rules OCI reported on the last reconcile, including rules
+	// managed outside the operator
+	ObservedIngressRules []IngressSecurityRule `json:"observedIngressRules,omitempty"`
+
+	// ObservedEgressRules are the egress rules OCI reported on the last reconcile
+	ObservedEgressRules []EgressSecurityRule `json:"observedEgressRules,omitempty"`
+
+	// RuleSetHash is a SHA-256 of the rules last applied from RuleSetRefs, used to detect a changed rule set
+	RuleSetHash string `json:"ruleSetHash,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -470,14 +827,52 @@ func init() {
 	SchemeBuilder.Register(&OciSecurityList{}, &OciSecurityListList{})
 }
 
+// OciSecurityRuleSetSpec defines a reusable group of security rules
+type OciSecurityRuleSetSpec struct {
+	// IngressSecurityRules are the ingress rules of the group
+	IngressSecurityRules []IngressSecurityRule `json:"ingressSecurityRules,omitempty"`
+
+	// EgressSecurityRules are the egress rules of the group
+	EgressSecurityRules []EgressSecurityRule `json:"egressSecurityRules,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// OciSecurityRuleSet is the Schema for the ocisecurityrulesets API. It has no OCI counterpart: an
+// OciSecurityList in the same namespace lists it in ruleSetRefs to include its rules.
+type OciSecurityRuleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OciSecurityRuleSetSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OciSecurityRuleSetList contains a list of OciSecurityRuleSet
+type OciSecurityRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OciSecurityRuleSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OciSecurityRuleSet{}, &OciSecurityRuleSetList{})
+}
+
 // OciNetworkSecurityGroupSpec defines the desired state of OciNetworkSecurityGroup
+// +kubebuilder:validation:XValidation:rule="has(self.compartmentId) || has(self.compartmentName)",message="one of compartmentId or compartmentName is required"
 type OciNetworkSecurityGroupSpec struct {
 	// NetworkSecurityGroupId is the OCID of an existing NSG to bind to (optional)
 	NetworkSecurityGroupId OCID `json:"id,omitempty"`
 
-	// CompartmentId is the OCID of the compartment
-	// +kubebuilder:validation:Required
-	CompartmentId OCID `json:"compartmentId"`
+	// CompartmentId is the OCID of the compartment (required unless compartmentName is set)
+	CompartmentId OCID `json:"compartmentId,omitempty"`
+
+	// CompartmentName names the compartment instead of its OCID, either as a plain name that is
+	// unique in the tenancy or as a path from the root such as "network/prod" (optional)
+	CompartmentName string `json:"compartmentName,omitempty"`
 
 	// VcnId is the OCID of the VCN that contains this NSG
 	// +kubebuilder:validation:Required
@@ -488,6 +883,15 @@ type OciNetworkSecurityGroupSpec struct {
 	// +kubebuilder:validation:Required
 	DisplayName string `json:"displayName"`
 
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
@@ -527,7 +931,7 @@ func init() {
 
 // RouteRule defines a single route in a route table
 type RouteRule struct {
-	// NetworkEntityId is the OCID of the gateway (IGW, NGW, etc.)
+	// NetworkEntityId is the OCID of the gateway (IGW, NGW, etc.), or the next-hop DRG attachment for DRG route tables
 	NetworkEntityId string `json:"networkEntityId"`
 
 	// Destination is the CIDR, e.g. "0.0.0.0/0"
@@ -541,18 +945,28 @@ type RouteRule struct {
 }
 
 // OciRouteTableSpec defines the desired state of OciRouteTable
+// +kubebuilder:validation:XValidation:rule="has(self.routeTableType) && self.routeTableType == 'DRG' ? has(self.drgId) : has(self.vcnId)",message="drgId is required for DRG route tables and vcnId for VCN route tables"
 type OciRouteTableSpec struct {
 	// RouteTableId is the OCID of an existing Route Table to bind to (optional)
 	RouteTableId OCID `json:"id,omitempty"`
 
+	// RouteTableType selects whether this is a VCN route table or a DRG route table
+	// +kubebuilder:validation:Enum=VCN;DRG
+	// +kubebuilder:default=VCN
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="routeTableType is immutable"
+	RouteTableType string `json:"routeTableType,omitempty"`
+
 	// CompartmentId is the OCID of the compartment
 	// +kubebuilder:validation:Required
 	CompartmentId OCID `json:"compartmentId"`
 
-	// VcnId is the OCID of the VCN that contains this Route Table
-	// +kubebuilder:validation:Required
+	// VcnId is the OCID of the VCN that contains this Route Table (required for VCN route tables)
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vcnId is immutable"
-	VcnId OCID `json:"vcnId"`
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// DrgId is the OCID of the DRG that contains this Route Table (required for DRG route tables)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="drgId is immutable"
+	DrgId OCID `json:"drgId,omitempty"`
 
 	// DisplayName is a user-friendly name for the Route Table
 	// +kubebuilder:validation:Required
@@ -561,12 +975,31 @@ type OciRouteTableSpec struct {
 	// RouteRules are the routing rules for this table
 	RouteRules []RouteRule `json:"routeRules,omitempty"`
 
+	// AttachToSubnetIds lists subnets that should use this Route Table once it is available.
+	// On delete, those subnets are pointed back at the VCN's default route table first.
+	// Only applies to VCN route tables (optional)
+	AttachToSubnetIds []OCID `json:"attachToSubnetIds,omitempty"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
 	TagResources `json:",inline,omitempty"`
 }
 
 // OciRouteTableStatus defines the observed state of OciRouteTable
 type OciRouteTableStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// EffectiveRoutes lists the spec's route rules in evaluation order: CIDR destinations from the
+	// longest prefix to the shortest, followed by service destinations
+	// +optional
+	EffectiveRoutes []RouteRule `json:"effectiveRoutes,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -597,3 +1030,125 @@ type OciRouteTableList struct {
 func init() {
 	SchemeBuilder.Register(&OciRouteTable{}, &OciRouteTableList{})
 }
+
+// NetworkSubnet describes one of the subnets an OciNetwork creates
+type NetworkSubnet struct {
+	// CidrBlock is the CIDR block for the subnet; it must fall inside the network's CidrBlock
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cidrBlock is immutable"
+	CidrBlock string `json:"cidrBlock"`
+
+	// DnsLabel is the DNS label for the subnet (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsLabel is immutable"
+	DnsLabel string `json:"dnsLabel,omitempty"`
+
+	// IngressSecurityRules are the ingress rules of the subnet's security list (optional)
+	IngressSecurityRules []IngressSecurityRule `json:"ingressSecurityRules,omitempty"`
+}
+
+// OciNetworkSpec defines the desired state of OciNetwork
+type OciNetworkSpec struct {
+	// CompartmentId is the OCID of the compartment in which to create the network's resources
+	// +kubebuilder:validation:Required
+	CompartmentId OCID `json:"compartmentId"`
+
+	// DisplayName names the VCN and prefixes the names of the resources created with it
+	// +kubebuilder:validation:Required
+	DisplayName string `json:"displayName"`
+
+	// CidrBlock is the CIDR block for the VCN
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cidrBlock is immutable"
+	CidrBlock string `json:"cidrBlock"`
+
+	// DnsLabel is the DNS label for the VCN (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsLabel is immutable"
+	DnsLabel string `json:"dnsLabel,omitempty"`
+
+	// PublicSubnet is routed to the internet through an Internet Gateway
+	// +kubebuilder:validation:Required
+	PublicSubnet NetworkSubnet `json:"publicSubnet"`
+
+	// PrivateSubnet has no public IPs and reaches the internet through a NAT Gateway
+	// +kubebuilder:validation:Required
+	PrivateSubnet NetworkSubnet `json:"privateSubnet"`
+
+	// AuthSecretRef names a secret in the resource's namespace holding OCI user principal
+	// credentials to use for this resource instead of the operator's default identity (optional)
+	AuthSecretRef SecretSource `json:"authSecretRef,omitempty"`
+
+	// Region is the OCI region the resource lives in, such as us-phoenix-1. When empty the operator's
+	// configured region is used (optional)
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
+	TagResources `json:",inline,omitempty"`
+}
+
+// OciNetworkStatus defines the observed state of OciNetwork
+type OciNetworkStatus struct {
+	OsokStatus OSOKStatus `json:"status"`
+
+	// VcnId is the OCID of the network's VCN
+	VcnId OCID `json:"vcnId,omitempty"`
+
+	// InternetGatewayId is the OCID of the Internet Gateway used by the public subnet
+	InternetGatewayId OCID `json:"internetGatewayId,omitempty"`
+
+	// NatGatewayId is the OCID of the NAT Gateway used by the private subnet
+	NatGatewayId OCID `json:"natGatewayId,omitempty"`
+
+	// PublicRouteTableId is the OCID of the public subnet's route table
+	PublicRouteTableId OCID `json:"publicRouteTableId,omitempty"`
+
+	// PrivateRouteTableId is the OCID of the private subnet's route table
+	PrivateRouteTableId OCID `json:"privateRouteTableId,omitempty"`
+
+	// PublicSecurityListId is the OCID of the public subnet's security list
+	PublicSecurityListId OCID `json:"publicSecurityListId,omitempty"`
+
+	// PrivateSecurityListId is the OCID of the private subnet's security list
+	PrivateSecurityListId OCID `json:"privateSecurityListId,omitempty"`
+
+	// PublicSubnetId is the OCID of the public subnet
+	PublicSubnetId OCID `json:"publicSubnetId,omitempty"`
+
+	// PrivateSubnetId is the OCID of the private subnet
+	PrivateSubnetId OCID `json:"privateSubnetId,omitempty"`
+
+	// Ready is true only when every child resource of the network reports AVAILABLE
+	Ready bool `json:"ready,omitempty"`
+
+	// ChildStatuses maps each child resource, keyed as <kind>/<name>, to its last observed lifecycle
+	// state. Children the operator has not reconciled yet are PENDING
+	ChildStatuses map[string]string `json:"childStatuses,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="DisplayName",type="string",JSONPath=".spec.displayName",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciNetwork",priority=0
+// +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciNetwork's VCN",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// OciNetwork is the Schema for the ocinetworks API
+type OciNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OciNetworkSpec   `json:"spec,omitempty"`
+	Status OciNetworkStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OciNetworkList contains a list of OciNetwork
+type OciNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OciNetwork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OciNetwork{}, &OciNetworkList{})
+}
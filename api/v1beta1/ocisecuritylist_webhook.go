@@ -0,0 +1,54 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// securityRuleProtocolAliases maps the protocol names users may write in security rules
+// onto the IANA protocol numbers (or "all") that OCI expects.
+var securityRuleProtocolAliases = map[string]string{
+	"tcp":    "6",
+	"udp":    "17",
+	"icmp":   "1",
+	"icmpv6": "58",
+	"all":    "all",
+}
+
+// SetupWebhookWithManager registers the OciSecurityList webhooks with the manager.
+func (r *OciSecurityList) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-oci-oracle-com-v1beta1-ocisecuritylist,mutating=true,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocisecuritylists,verbs=create;update,versions=v1beta1,name=mocisecuritylist.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &OciSecurityList{}
+
+// Default rewrites protocol aliases in the ingress and egress rules to the values OCI accepts.
+// Protocols that are not a known alias, including numeric protocols, are left untouched.
+func (r *OciSecurityList) Default() {
+	for i := range r.Spec.IngressSecurityRules {
+		r.Spec.IngressSecurityRules[i].Protocol = NormalizeSecurityRuleProtocol(r.Spec.IngressSecurityRules[i].Protocol)
+	}
+	for i := range r.Spec.EgressSecurityRules {
+		r.Spec.EgressSecurityRules[i].Protocol = NormalizeSecurityRuleProtocol(r.Spec.EgressSecurityRules[i].Protocol)
+	}
+}
+
+// NormalizeSecurityRuleProtocol returns the value OCI accepts for a protocol alias such as "tcp", or the
+// protocol unchanged when it is not a known alias. The controller applies it too, since the webhook is optional.
+func NormalizeSecurityRuleProtocol(protocol string) string {
+	if normalized, ok := securityRuleProtocolAliases[strings.ToLower(strings.TrimSpace(protocol))]; ok {
+		return normalized
+	}
+	return protocol
+}
@@ -0,0 +1,63 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciSecurityListDefault_NormalizesProtocolAliases(t *testing.T) {
+	cases := map[string]string{
+		"tcp":    "6",
+		"udp":    "17",
+		"icmp":   "1",
+		"icmpv6": "58",
+		"all":    "all",
+		"TCP":    "6",
+		" Udp ":  "17",
+	}
+
+	for alias, expected := range cases {
+		t.Run(alias, func(t *testing.T) {
+			sl := &OciSecurityList{}
+			sl.Spec.IngressSecurityRules = []IngressSecurityRule{{Protocol: alias, Source: "0.0.0.0/0"}}
+			sl.Spec.EgressSecurityRules = []EgressSecurityRule{{Protocol: alias, Destination: "0.0.0.0/0"}}
+
+			sl.Default()
+
+			assert.Equal(t, expected, sl.Spec.IngressSecurityRules[0].Protocol)
+			assert.Equal(t, expected, sl.Spec.EgressSecurityRules[0].Protocol)
+		})
+	}
+}
+
+func TestOciSecurityListDefault_LeavesNumericProtocolsUntouched(t *testing.T) {
+	sl := &OciSecurityList{}
+	sl.Spec.IngressSecurityRules = []IngressSecurityRule{
+		{Protocol: "6", Source: "10.0.0.0/16"},
+		{Protocol: "17", Source: "10.0.0.0/16"},
+	}
+	sl.Spec.EgressSecurityRules = []EgressSecurityRule{
+		{Protocol: "1", Destination: "0.0.0.0/0"},
+		{Protocol: "58", Destination: "::/0"},
+	}
+
+	sl.Default()
+
+	assert.Equal(t, "6", sl.Spec.IngressSecurityRules[0].Protocol)
+	assert.Equal(t, "17", sl.Spec.IngressSecurityRules[1].Protocol)
+	assert.Equal(t, "1", sl.Spec.EgressSecurityRules[0].Protocol)
+	assert.Equal(t, "58", sl.Spec.EgressSecurityRules[1].Protocol)
+}
+
+func TestOciSecurityListDefault_NoRules(t *testing.T) {
+	sl := &OciSecurityList{}
+	assert.NotPanics(t, sl.Default)
+	assert.Empty(t, sl.Spec.IngressSecurityRules)
+	assert.Empty(t, sl.Spec.EgressSecurityRules)
+}
@@ -72,13 +72,42 @@ type OpenSearchClusterSpec struct {
 	SecurityMode                   string `json:"securityMode,omitempty"`
 	SecurityMasterUserName         string `json:"securityMasterUserName,omitempty"`
 	SecurityMasterUserPasswordHash string `json:"securityMasterUserPasswordHash,omitempty"`
+	// MasterUserSecretRef names a secret in the cluster's namespace holding the master user's username
+	// and passwordHash. When set, it takes precedence over SecurityMasterUserName and
+	// SecurityMasterUserPasswordHash, and a change to the secret is applied to the cluster.
+	MasterUserSecretRef SecretSource `json:"masterUserSecretRef,omitempty"`
+
+	// BackupPolicy schedules backups of the cluster taken by the operator
+	BackupPolicy *OpenSearchBackupPolicy `json:"backupPolicy,omitempty"`
 
 	TagResources `json:",inline,omitempty"`
 }
 
+// OpenSearchBackupPolicy controls the backups the operator takes of an ACTIVE cluster. They are taken
+// in addition to the daily backups OCI takes of every cluster.
+type OpenSearchBackupPolicy struct {
+	// IsEnabled turns the scheduled backups on
+	IsEnabled bool `json:"isEnabled,omitempty"`
+
+	// FrequencyInHours is the time between two backups, in hours. Defaults to 24.
+	// +kubebuilder:validation:Minimum:=1
+	FrequencyInHours int `json:"frequencyInHours,omitempty"`
+
+	// RetentionInDays is how long backups taken by the operator are kept, in days. 0 keeps them until
+	// they are deleted in OCI.
+	// +kubebuilder:validation:Minimum:=0
+	RetentionInDays int `json:"retentionInDays,omitempty"`
+}
+
 // OpenSearchClusterStatus defines the observed state of OpenSearchCluster
 type OpenSearchClusterStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// MasterUserSecretHash is the SHA-256 of the master user credentials last applied from MasterUserSecretRef
+	MasterUserSecretHash string `json:"masterUserSecretHash,omitempty"`
+
+	// LastBackupAt is when the operator last started a backup under BackupPolicy
+	LastBackupAt *metav1.Time `json:"lastBackupAt,omitempty"`
 }
 
 //+kubebuilder:object:root=true
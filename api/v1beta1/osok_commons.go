@@ -35,14 +35,19 @@ type OSOKCondition struct {
 }
 
 type OSOKStatus struct {
-	Conditions  []OSOKCondition `json:"conditions,omitempty"`
-	Ocid        OCID            `json:"ocid,omitempty"`
-	Message     string          `json:"message,omitempty"`
-	Reason      string          `json:"reason,omitempty"`
-	CreatedAt   *metav1.Time    `json:"createdAt,omitempty"`
-	UpdatedAt   *metav1.Time    `json:"updatedAt,omitempty"`
-	RequestedAt *metav1.Time    `json:"requestedAt,omitempty"`
-	DeletedAt   *metav1.Time    `json:"deletedAt,omitempty"`
+	Conditions       []OSOKCondition `json:"conditions,omitempty"`
+	Ocid             OCID            `json:"ocid,omitempty"`
+	Message          string          `json:"message,omitempty"`
+	Reason           string          `json:"reason,omitempty"`
+	CreatedAt        *metav1.Time    `json:"createdAt,omitempty"`
+	UpdatedAt        *metav1.Time    `json:"updatedAt,omitempty"`
+	RequestedAt      *metav1.Time    `json:"requestedAt,omitempty"`
+	DeletedAt        *metav1.Time    `json:"deletedAt,omitempty"`
+	WorkRequestId    string          `json:"workRequestId,omitempty"`
+	WorkRequestState string          `json:"workRequestState,omitempty"`
+
+	// ObservedGeneration is the metadata.generation of the spec that was last reconciled successfully
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 type TagResources struct {
@@ -34,6 +34,12 @@ type OciQueueSpec struct {
 	// +kubebuilder:validation:Minimum:=1
 	TimeoutInSeconds int `json:"timeoutInSeconds,omitempty"`
 
+	// ChannelConsumptionLimit is the percentage of the queue's resources a single channel can consume
+	// Messages are grouped into channels by the channel ID they are published with; 100 means no limit
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	ChannelConsumptionLimit int `json:"channelConsumptionLimit,omitempty"`
+
 	// DeadLetterQueueDeliveryCount is the number of times a message can be delivered before being moved to the DLQ
 	// A value of 0 disables the DLQ
 	// +kubebuilder:validation:Minimum:=0
@@ -48,6 +54,19 @@ type OciQueueSpec struct {
 // OciQueueStatus defines the observed state of OciQueue
 type OciQueueStatus struct {
 	OsokStatus OSOKStatus `json:"status"`
+
+	// LastPurgedAt is the osok.oracle.com/purge annotation timestamp of the last purge sent to OCI
+	LastPurgedAt *metav1.Time `json:"lastPurgedAt,omitempty"`
+
+	// VisibleMessages is the approximate number of messages waiting for delivery at the last reconcile
+	VisibleMessages int64 `json:"visibleMessages,omitempty"`
+
+	// InFlightMessages is the approximate number of messages delivered but not yet deleted at the last reconcile
+	InFlightMessages int64 `json:"inFlightMessages,omitempty"`
+
+	// Saturation is the percentage of the queue storage limit in use at the last reconcile. It is advisory
+	// only; the operator takes no action on it
+	Saturation int32 `json:"saturation,omitempty"`
 }
 
 //+kubebuilder:object:root=true
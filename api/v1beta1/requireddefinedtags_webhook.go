@@ -0,0 +1,150 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// RequiredDefinedTagsValidator rejects resources whose spec.definedTags lack any of the required tags.
+// Each required tag is written as "Namespace.Key", e.g. "CostTracking.Project".
+type RequiredDefinedTagsValidator struct {
+	RequiredTags []string
+}
+
+var _ admission.CustomValidator = &RequiredDefinedTagsValidator{}
+
+// definedTagResources are the resources whose spec embeds TagResources and so carries definedTags.
+func definedTagResources() []runtime.Object {
+	return []runtime.Object{
+		&ApiGateway{}, &ApiGatewayDeployment{}, &AutonomousDatabases{}, &ComputeInstance{}, &ContainerInstance{},
+		&DataFlowApplication{}, &FunctionsApplication{}, &FunctionsFunction{}, &MySqlDbSystem{}, &NoSQLDatabase{},
+		&ObjectStorageBucket{}, &OciDrg{}, &OciInternetGateway{}, &OciLocalPeeringGateway{}, &OciNatGateway{},
+		&OciNetwork{}, &OciNetworkSecurityGroup{}, &OciQueue{}, &OciRouteTable{}, &OciSecurityList{},
+		&OciServiceGateway{}, &OciStreamPool{}, &OciSubnet{}, &OciVaultSecret{}, &OciVcn{},
+		&OpenSearchCluster{}, &PostgresDbSystem{}, &RedisCluster{}, &Stream{},
+	}
+}
+
+// SetupWebhookWithManager registers the validator for every resource that has defined tags.
+func (v *RequiredDefinedTagsValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	for _, obj := range definedTagResources() {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(obj).WithValidator(v).Complete(); err != nil {
+			return fmt.Errorf("setup required defined tags webhook for %T: %w", obj, err)
+		}
+	}
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-apigateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=apigateways,verbs=create;update,versions=v1beta1,name=vapigateway.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-apigatewaydeployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=apigatewaydeployments,verbs=create;update,versions=v1beta1,name=vapigatewaydeployment.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-autonomousdatabases,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=autonomousdatabases,verbs=create;update,versions=v1beta1,name=vautonomousdatabases.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-computeinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=computeinstances,verbs=create;update,versions=v1beta1,name=vcomputeinstance.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-containerinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=containerinstances,verbs=create;update,versions=v1beta1,name=vcontainerinstance.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-dataflowapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=dataflowapplications,verbs=create;update,versions=v1beta1,name=vdataflowapplication.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-functionsapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=functionsapplications,verbs=create;update,versions=v1beta1,name=vfunctionsapplication.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-functionsfunction,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=functionsfunctions,verbs=create;update,versions=v1beta1,name=vfunctionsfunction.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-mysqldbsystem,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=mysqldbsystems,verbs=create;update,versions=v1beta1,name=vmysqldbsystem.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-nosqldatabase,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=nosqldatabases,verbs=create;update,versions=v1beta1,name=vnosqldatabase.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-objectstoragebucket,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=objectstoragebuckets,verbs=create;update,versions=v1beta1,name=vobjectstoragebucket.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocidrg,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocidrgs,verbs=create;update,versions=v1beta1,name=vocidrg.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ociinternetgateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ociinternetgateways,verbs=create;update,versions=v1beta1,name=vociinternetgateway.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocilocalpeeringgateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocilocalpeeringgateways,verbs=create;update,versions=v1beta1,name=vocilocalpeeringgateway.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocinatgateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocinatgateways,verbs=create;update,versions=v1beta1,name=vocinatgateway.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocinetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocinetworks,verbs=create;update,versions=v1beta1,name=vocinetwork.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocinetworksecuritygroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocinetworksecuritygroups,verbs=create;update,versions=v1beta1,name=vocinetworksecuritygroup.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ociqueue,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ociqueues,verbs=create;update,versions=v1beta1,name=vociqueue.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ociroutetable,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ociroutetables,verbs=create;update,versions=v1beta1,name=vociroutetable.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocisecuritylist,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocisecuritylists,verbs=create;update,versions=v1beta1,name=vocisecuritylist.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ociservicegateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ociservicegateways,verbs=create;update,versions=v1beta1,name=vociservicegateway.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocistreampool,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocistreampools,verbs=create;update,versions=v1beta1,name=vocistreampool.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocisubnet,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocisubnets,verbs=create;update,versions=v1beta1,name=vocisubnet.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocivaultsecret,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocivaultsecrets,verbs=create;update,versions=v1beta1,name=vocivaultsecret.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-ocivcn,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=ocivcns,verbs=create;update,versions=v1beta1,name=vocivcn.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-opensearchcluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=opensearchclusters,verbs=create;update,versions=v1beta1,name=vopensearchcluster.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-postgresdbsystem,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=postgresdbsystems,verbs=create;update,versions=v1beta1,name=vpostgresdbsystem.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-rediscluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=redisclusters,verbs=create;update,versions=v1beta1,name=vrediscluster.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-oci-oracle-com-v1beta1-stream,mutating=false,failurePolicy=fail,sideEffects=None,groups=oci.oracle.com,resources=streams,verbs=create;update,versions=v1beta1,name=vstream.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate rejects a new resource that is missing a required defined tag.
+func (v *RequiredDefinedTagsValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+// ValidateUpdate rejects an update that leaves a required defined tag missing. Updates to a resource that
+// is being deleted are allowed, so finalizers can still be removed from resources created before the
+// tags were required.
+func (v *RequiredDefinedTagsValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	accessor, err := meta.Accessor(newObj)
+	if err != nil {
+		return nil, err
+	}
+	if accessor.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+	return nil, v.validate(newObj)
+}
+
+// ValidateDelete allows every delete.
+func (v *RequiredDefinedTagsValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *RequiredDefinedTagsValidator) validate(obj runtime.Object) error {
+	if len(v.RequiredTags) == 0 {
+		return nil
+	}
+	definedTags, err := specDefinedTags(obj)
+	if err != nil {
+		return err
+	}
+	missing := missingDefinedTags(definedTags, v.RequiredTags)
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("spec.definedTags is missing required tags: %s", strings.Join(missing, ", "))
+}
+
+// specDefinedTags reads spec.definedTags from any resource that embeds TagResources in its spec.
+func specDefinedTags(obj runtime.Object) (map[string]MapValue, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec.definedTags: %w", err)
+	}
+	namespaces, _, err := unstructured.NestedMap(content, "spec", "definedTags")
+	if err != nil {
+		return nil, fmt.Errorf("reading spec.definedTags: %w", err)
+	}
+	definedTags := make(map[string]MapValue, len(namespaces))
+	for namespace := range namespaces {
+		values, _, err := unstructured.NestedStringMap(namespaces, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("reading spec.definedTags.%s: %w", namespace, err)
+		}
+		definedTags[namespace] = values
+	}
+	return definedTags, nil
+}
+
+// missingDefinedTags returns the required "Namespace.Key" tags that are absent or empty in definedTags.
+func missingDefinedTags(definedTags map[string]MapValue, required []string) []string {
+	var missing []string
+	for _, tag := range required {
+		namespace, key, _ := strings.Cut(tag, ".")
+		if definedTags[namespace][key] == "" {
+			missing = append(missing, tag)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,78 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func costTrackingValidator() *RequiredDefinedTagsValidator {
+	return &RequiredDefinedTagsValidator{RequiredTags: []string{"CostTracking.Project", "CostTracking.Owner"}}
+}
+
+func TestRequiredDefinedTags_AllPresentIsAdmitted(t *testing.T) {
+	vcn := &OciVcn{}
+	vcn.Spec.DefinedTags = map[string]MapValue{"CostTracking": {"Project": "osok", "Owner": "platform"}}
+
+	_, err := costTrackingValidator().ValidateCreate(context.Background(), vcn)
+	assert.NoError(t, err)
+}
+
+func TestRequiredDefinedTags_MissingTagIsRejected(t *testing.T) {
+	queue := &OciQueue{}
+	queue.Spec.DefinedTags = map[string]MapValue{"CostTracking": {"Project": "osok"}}
+
+	_, err := costTrackingValidator().ValidateCreate(context.Background(), queue)
+	assert.EqualError(t, err, "spec.definedTags is missing required tags: CostTracking.Owner")
+}
+
+func TestRequiredDefinedTags_NoDefinedTagsIsRejected(t *testing.T) {
+	_, err := costTrackingValidator().ValidateCreate(context.Background(), &Stream{})
+	assert.EqualError(t, err, "spec.definedTags is missing required tags: CostTracking.Project, CostTracking.Owner")
+}
+
+func TestRequiredDefinedTags_EmptyValueIsRejected(t *testing.T) {
+	bucket := &ObjectStorageBucket{}
+	bucket.Spec.DefinedTags = map[string]MapValue{"CostTracking": {"Project": "", "Owner": "platform"}}
+
+	_, err := costTrackingValidator().ValidateCreate(context.Background(), bucket)
+	assert.EqualError(t, err, "spec.definedTags is missing required tags: CostTracking.Project")
+}
+
+func TestRequiredDefinedTags_NoRequiredTagsAdmitsEverything(t *testing.T) {
+	validator := &RequiredDefinedTagsValidator{}
+
+	_, err := validator.ValidateCreate(context.Background(), &OciSubnet{})
+	assert.NoError(t, err)
+}
+
+func TestRequiredDefinedTags_UpdateRemovingTagIsRejected(t *testing.T) {
+	oldVcn := &OciVcn{}
+	oldVcn.Spec.DefinedTags = map[string]MapValue{"CostTracking": {"Project": "osok", "Owner": "platform"}}
+	newVcn := oldVcn.DeepCopy()
+	delete(newVcn.Spec.DefinedTags["CostTracking"], "Owner")
+
+	_, err := costTrackingValidator().ValidateUpdate(context.Background(), oldVcn, newVcn)
+	assert.EqualError(t, err, "spec.definedTags is missing required tags: CostTracking.Owner")
+}
+
+func TestRequiredDefinedTags_UpdateWhileDeletingIsAdmitted(t *testing.T) {
+	subnet := &OciSubnet{}
+	now := metav1.Now()
+	subnet.DeletionTimestamp = &now
+
+	_, err := costTrackingValidator().ValidateUpdate(context.Background(), subnet, subnet)
+	assert.NoError(t, err)
+}
+
+func TestRequiredDefinedTags_DeleteIsAdmitted(t *testing.T) {
+	_, err := costTrackingValidator().ValidateDelete(context.Background(), &OciVcn{})
+	assert.NoError(t, err)
+}
@@ -25,9 +25,10 @@ type StreamSpec struct {
 	// +kubebuilder:validation:Maximum=168
 	// +kubebuilder:validation:Minimum=24
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="retentionInHours is immutable"
-	RetentionInHours int  `json:"retentionInHours,omitempty"`
-	StreamPoolId     OCID `json:"streamPoolId,omitempty"`
-	TagResources     `json:",inline"`
+	RetentionInHours int `json:"retentionInHours,omitempty"`
+	// StreamPoolId places the stream in a stream pool, e.g. one managed by an OciStreamPool
+	StreamPoolId OCID `json:"streamPoolId,omitempty"`
+	TagResources `json:",inline"`
 }
 
 // StreamStatus defines the observed state of Stream
@@ -0,0 +1,68 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OciStreamPoolPrivateEndpoint configures the private endpoint of a stream pool.
+type OciStreamPoolPrivateEndpoint struct {
+	// SubnetId is the subnet from which the private stream pool can be accessed
+	SubnetId OCID `json:"subnetId"`
+	// PrivateEndpointIp is an optional private IP to assign within the subnet
+	PrivateEndpointIp string `json:"privateEndpointIp,omitempty"`
+	// NsgIds are the network security groups associated with the private endpoint
+	NsgIds []OCID `json:"nsgIds,omitempty"`
+}
+
+// OciStreamPoolSpec defines the desired state of OciStreamPool
+type OciStreamPoolSpec struct {
+	// StreamPoolId is the OCID of an existing stream pool to bind to (optional)
+	StreamPoolId  OCID   `json:"id,omitempty"`
+	CompartmentId OCID   `json:"compartmentId,omitempty"`
+	Name          string `json:"name,omitempty"`
+	// KmsKeyId is the OCID of a custom encryption key; when empty the pool uses Oracle-managed keys
+	KmsKeyId OCID `json:"kmsKeyId,omitempty"`
+	// PrivateEndpoint makes the stream pool private; it can only be set at creation time
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="privateEndpoint is immutable"
+	PrivateEndpoint *OciStreamPoolPrivateEndpoint `json:"privateEndpoint,omitempty"`
+	TagResources    `json:",inline"`
+}
+
+// OciStreamPoolStatus defines the observed state of OciStreamPool
+type OciStreamPoolStatus struct {
+	OsokStatus OSOKStatus `json:"status"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciStreamPool",priority=0
+// +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciStreamPool",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// OciStreamPool is the Schema for the ocistreampools API
+type OciStreamPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OciStreamPoolSpec   `json:"spec,omitempty"`
+	Status OciStreamPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OciStreamPoolList contains a list of OciStreamPool
+type OciStreamPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OciStreamPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OciStreamPool{}, &OciStreamPoolList{})
+}
@@ -0,0 +1,98 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultSecretContentSource points at the Kubernetes secret key that holds the content of an OciVaultSecret.
+type VaultSecretContentSource struct {
+	// SecretName is the name of a Kubernetes secret in the same namespace as the OciVaultSecret
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+
+	// Key is the key of the Kubernetes secret whose value is stored in the vault
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// OciVaultSecretSpec defines the desired state of OciVaultSecret
+type OciVaultSecretSpec struct {
+	// The OCID of an existing secret to bind to (optional; if omitted, a new secret is created)
+	SecretId OCID `json:"id,omitempty"`
+
+	// CompartmentId is the OCID of the compartment in which to create the secret
+	// +kubebuilder:validation:Required
+	CompartmentId OCID `json:"compartmentId"`
+
+	// VaultId is the OCID of the vault that stores the secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vaultId is immutable"
+	VaultId OCID `json:"vaultId"`
+
+	// KeyId is the OCID of the master encryption key in the vault that encrypts the secret
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="keyId is immutable"
+	KeyId OCID `json:"keyId"`
+
+	// SecretName is the name of the secret in the vault, unique within the vault
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="secretName is immutable"
+	SecretName string `json:"secretName"`
+
+	// Description is a brief description of the secret
+	Description string `json:"description,omitempty"`
+
+	// Content is the Kubernetes secret key whose value is stored in the vault. When the value changes,
+	// a new secret version is created and made current.
+	// +kubebuilder:validation:Required
+	Content VaultSecretContentSource `json:"content"`
+
+	TagResources `json:",inline,omitempty"`
+}
+
+// OciVaultSecretStatus defines the observed state of OciVaultSecret
+type OciVaultSecretStatus struct {
+	OsokStatus OSOKStatus `json:"status"`
+
+	// CurrentVersionNumber is the version number of the secret's current version
+	CurrentVersionNumber int64 `json:"currentVersionNumber,omitempty"`
+
+	// ContentHash is the SHA-256 of the content last written to the vault, used to detect changes
+	// to the source Kubernetes secret
+	ContentHash string `json:"contentHash,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SecretName",type="string",JSONPath=".spec.secretName",priority=1
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status.conditions[-1].type",description="status of the OciVaultSecret",priority=0
+// +kubebuilder:printcolumn:name="Version",type="integer",JSONPath=".status.currentVersionNumber",description="current version of the secret",priority=0
+// +kubebuilder:printcolumn:name="Ocid",type="string",JSONPath=".status.status.ocid",description="Ocid of the OciVaultSecret",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+
+// OciVaultSecret is the Schema for the ocivaultsecrets API
+type OciVaultSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OciVaultSecretSpec   `json:"spec,omitempty"`
+	Status OciVaultSecretStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OciVaultSecretList contains a list of OciVaultSecret
+type OciVaultSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OciVaultSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OciVaultSecret{}, &OciVaultSecretList{})
+}
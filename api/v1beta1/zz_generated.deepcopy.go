@@ -468,6 +468,21 @@ func (in *ComputeInstanceStatus) DeepCopy() *ComputeInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapRulesSource) DeepCopyInto(out *ConfigMapRulesSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapRulesSource.
+func (in *ConfigMapRulesSource) DeepCopy() *ConfigMapRulesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapRulesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDetails) DeepCopyInto(out *ContainerDetails) {
 	*out = *in
@@ -637,6 +652,11 @@ func (in *ContainerInstanceSpec) DeepCopyInto(out *ContainerInstanceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ContainerVolume, len(*in))
+		copy(*out, *in)
+	}
 	if in.Vnics != nil {
 		in, out := &in.Vnics, &out.Vnics
 		*out = make([]ContainerVnicDetails, len(*in))
@@ -669,6 +689,7 @@ func (in *ContainerInstanceSpec) DeepCopyInto(out *ContainerInstanceSpec) {
 		*out = make([]ContainerImagePullSecret, len(*in))
 		copy(*out, *in)
 	}
+	out.ImagePullSecretRef = in.ImagePullSecretRef
 	if in.GCPolicy != nil {
 		in, out := &in.GCPolicy, &out.GCPolicy
 		*out = new(ContainerInstanceGCPolicy)
@@ -691,6 +712,13 @@ func (in *ContainerInstanceSpec) DeepCopy() *ContainerInstanceSpec {
 func (in *ContainerInstanceStatus) DeepCopyInto(out *ContainerInstanceStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.ContainerStates != nil {
+		in, out := &in.ContainerStates, &out.ContainerStates
+		*out = make([]ContainerState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerInstanceStatus.
@@ -728,6 +756,26 @@ func (in *ContainerResourceConfig) DeepCopy() *ContainerResourceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerState) DeepCopyInto(out *ContainerState) {
+	*out = *in
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerState.
+func (in *ContainerState) DeepCopy() *ContainerState {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerVnicDetails) DeepCopyInto(out *ContainerVnicDetails) {
 	*out = *in
@@ -753,6 +801,21 @@ func (in *ContainerVnicDetails) DeepCopy() *ContainerVnicDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerVolume) DeepCopyInto(out *ContainerVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerVolume.
+func (in *ContainerVolume) DeepCopy() *ContainerVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerVolumeMount) DeepCopyInto(out *ContainerVolumeMount) {
 	*out = *in
@@ -942,6 +1005,41 @@ func (in *DataFlowApplicationStatus) DeepCopy() *DataFlowApplicationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrgRouteDistribution) DeepCopyInto(out *DrgRouteDistribution) {
+	*out = *in
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]DrgRouteDistributionStatement, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrgRouteDistribution.
+func (in *DrgRouteDistribution) DeepCopy() *DrgRouteDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(DrgRouteDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrgRouteDistributionStatement) DeepCopyInto(out *DrgRouteDistributionStatement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrgRouteDistributionStatement.
+func (in *DrgRouteDistributionStatement) DeepCopy() *DrgRouteDistributionStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(DrgRouteDistributionStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressSecurityRule) DeepCopyInto(out *EgressSecurityRule) {
 	*out = *in
@@ -955,6 +1053,16 @@ func (in *EgressSecurityRule) DeepCopyInto(out *EgressSecurityRule) {
 		*out = new(UdpOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortRanges != nil {
+		in, out := &in.PortRanges, &out.PortRanges
+		*out = make([]PortRange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressSecurityRule.
@@ -1186,6 +1294,16 @@ func (in *IngressSecurityRule) DeepCopyInto(out *IngressSecurityRule) {
 		*out = new(UdpOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortRanges != nil {
+		in, out := &in.PortRanges, &out.PortRanges
+		*out = make([]PortRange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSecurityRule.
@@ -1316,6 +1434,28 @@ func (in *MySqlDbSystemStatus) DeepCopy() *MySqlDbSystemStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSubnet) DeepCopyInto(out *NetworkSubnet) {
+	*out = *in
+	if in.IngressSecurityRules != nil {
+		in, out := &in.IngressSecurityRules, &out.IngressSecurityRules
+		*out = make([]IngressSecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSubnet.
+func (in *NetworkSubnet) DeepCopy() *NetworkSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NoSQLDatabase) DeepCopyInto(out *NoSQLDatabase) {
 	*out = *in
@@ -1637,6 +1777,12 @@ func (in *OciDrgList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciDrgSpec) DeepCopyInto(out *OciDrgSpec) {
 	*out = *in
+	if in.RouteDistribution != nil {
+		in, out := &in.RouteDistribution, &out.RouteDistribution
+		*out = new(DrgRouteDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -1728,6 +1874,12 @@ func (in *OciInternetGatewayList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciInternetGatewaySpec) DeepCopyInto(out *OciInternetGatewaySpec) {
 	*out = *in
+	if in.VcnRef != nil {
+		in, out := &in.VcnRef, &out.VcnRef
+		*out = new(ResourceRef)
+		**out = **in
+	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -1757,6 +1909,98 @@ func (in *OciInternetGatewayStatus) DeepCopy() *OciInternetGatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciLocalPeeringGateway) DeepCopyInto(out *OciLocalPeeringGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciLocalPeeringGateway.
+func (in *OciLocalPeeringGateway) DeepCopy() *OciLocalPeeringGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(OciLocalPeeringGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciLocalPeeringGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciLocalPeeringGatewayList) DeepCopyInto(out *OciLocalPeeringGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciLocalPeeringGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciLocalPeeringGatewayList.
+func (in *OciLocalPeeringGatewayList) DeepCopy() *OciLocalPeeringGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciLocalPeeringGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciLocalPeeringGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciLocalPeeringGatewaySpec) DeepCopyInto(out *OciLocalPeeringGatewaySpec) {
+	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
+	in.TagResources.DeepCopyInto(&out.TagResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciLocalPeeringGatewaySpec.
+func (in *OciLocalPeeringGatewaySpec) DeepCopy() *OciLocalPeeringGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciLocalPeeringGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciLocalPeeringGatewayStatus) DeepCopyInto(out *OciLocalPeeringGatewayStatus) {
+	*out = *in
+	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciLocalPeeringGatewayStatus.
+func (in *OciLocalPeeringGatewayStatus) DeepCopy() *OciLocalPeeringGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OciLocalPeeringGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciNatGateway) DeepCopyInto(out *OciNatGateway) {
 	*out = *in
@@ -1819,6 +2063,12 @@ func (in *OciNatGatewayList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciNatGatewaySpec) DeepCopyInto(out *OciNatGatewaySpec) {
 	*out = *in
+	if in.VcnRef != nil {
+		in, out := &in.VcnRef, &out.VcnRef
+		*out = new(ResourceRef)
+		**out = **in
+	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -1848,6 +2098,65 @@ func (in *OciNatGatewayStatus) DeepCopy() *OciNatGatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciNetwork) DeepCopyInto(out *OciNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciNetwork.
+func (in *OciNetwork) DeepCopy() *OciNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(OciNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciNetworkList) DeepCopyInto(out *OciNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciNetworkList.
+func (in *OciNetworkList) DeepCopy() *OciNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciNetworkSecurityGroup) DeepCopyInto(out *OciNetworkSecurityGroup) {
 	*out = *in
@@ -1910,6 +2219,7 @@ func (in *OciNetworkSecurityGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciNetworkSecurityGroupSpec) DeepCopyInto(out *OciNetworkSecurityGroupSpec) {
 	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -1939,6 +2249,48 @@ func (in *OciNetworkSecurityGroupStatus) DeepCopy() *OciNetworkSecurityGroupStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciNetworkSpec) DeepCopyInto(out *OciNetworkSpec) {
+	*out = *in
+	in.PublicSubnet.DeepCopyInto(&out.PublicSubnet)
+	in.PrivateSubnet.DeepCopyInto(&out.PrivateSubnet)
+	out.AuthSecretRef = in.AuthSecretRef
+	in.TagResources.DeepCopyInto(&out.TagResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciNetworkSpec.
+func (in *OciNetworkSpec) DeepCopy() *OciNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciNetworkStatus) DeepCopyInto(out *OciNetworkStatus) {
+	*out = *in
+	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.ChildStatuses != nil {
+		in, out := &in.ChildStatuses, &out.ChildStatuses
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciNetworkStatus.
+func (in *OciNetworkStatus) DeepCopy() *OciNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OciNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciQueue) DeepCopyInto(out *OciQueue) {
 	*out = *in
@@ -2018,6 +2370,10 @@ func (in *OciQueueSpec) DeepCopy() *OciQueueSpec {
 func (in *OciQueueStatus) DeepCopyInto(out *OciQueueStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.LastPurgedAt != nil {
+		in, out := &in.LastPurgedAt, &out.LastPurgedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciQueueStatus.
@@ -2097,6 +2453,12 @@ func (in *OciRouteTableSpec) DeepCopyInto(out *OciRouteTableSpec) {
 		*out = make([]RouteRule, len(*in))
 		copy(*out, *in)
 	}
+	if in.AttachToSubnetIds != nil {
+		in, out := &in.AttachToSubnetIds, &out.AttachToSubnetIds
+		*out = make([]OCID, len(*in))
+		copy(*out, *in)
+	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -2114,6 +2476,11 @@ func (in *OciRouteTableSpec) DeepCopy() *OciRouteTableSpec {
 func (in *OciRouteTableStatus) DeepCopyInto(out *OciRouteTableStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.EffectiveRoutes != nil {
+		in, out := &in.EffectiveRoutes, &out.EffectiveRoutes
+		*out = make([]RouteRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciRouteTableStatus.
@@ -2202,8 +2569,19 @@ func (in *OciSecurityListSpec) DeepCopyInto(out *OciSecurityListSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.TagResources.DeepCopyInto(&out.TagResources)
-}
+	if in.RuleSetRefs != nil {
+		in, out := &in.RuleSetRefs, &out.RuleSetRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RulesFromConfigMap != nil {
+		in, out := &in.RulesFromConfigMap, &out.RulesFromConfigMap
+		*out = new(ConfigMapRulesSource)
+		**out = **in
+	}
+	out.AuthSecretRef = in.AuthSecretRef
+	in.TagResources.DeepCopyInto(&out.TagResources)
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciSecurityListSpec.
 func (in *OciSecurityListSpec) DeepCopy() *OciSecurityListSpec {
@@ -2219,6 +2597,20 @@ func (in *OciSecurityListSpec) DeepCopy() *OciSecurityListSpec {
 func (in *OciSecurityListStatus) DeepCopyInto(out *OciSecurityListStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.ObservedIngressRules != nil {
+		in, out := &in.ObservedIngressRules, &out.ObservedIngressRules
+		*out = make([]IngressSecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedEgressRules != nil {
+		in, out := &in.ObservedEgressRules, &out.ObservedEgressRules
+		*out = make([]EgressSecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciSecurityListStatus.
@@ -2231,6 +2623,93 @@ func (in *OciSecurityListStatus) DeepCopy() *OciSecurityListStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciSecurityRuleSet) DeepCopyInto(out *OciSecurityRuleSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciSecurityRuleSet.
+func (in *OciSecurityRuleSet) DeepCopy() *OciSecurityRuleSet {
+	if in == nil {
+		return nil
+	}
+	out := new(OciSecurityRuleSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciSecurityRuleSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciSecurityRuleSetList) DeepCopyInto(out *OciSecurityRuleSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciSecurityRuleSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciSecurityRuleSetList.
+func (in *OciSecurityRuleSetList) DeepCopy() *OciSecurityRuleSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciSecurityRuleSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciSecurityRuleSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciSecurityRuleSetSpec) DeepCopyInto(out *OciSecurityRuleSetSpec) {
+	*out = *in
+	if in.IngressSecurityRules != nil {
+		in, out := &in.IngressSecurityRules, &out.IngressSecurityRules
+		*out = make([]IngressSecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EgressSecurityRules != nil {
+		in, out := &in.EgressSecurityRules, &out.EgressSecurityRules
+		*out = make([]EgressSecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciSecurityRuleSetSpec.
+func (in *OciSecurityRuleSetSpec) DeepCopy() *OciSecurityRuleSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciSecurityRuleSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciServiceGateway) DeepCopyInto(out *OciServiceGateway) {
 	*out = *in
@@ -2293,11 +2772,17 @@ func (in *OciServiceGatewayList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciServiceGatewaySpec) DeepCopyInto(out *OciServiceGatewaySpec) {
 	*out = *in
+	if in.VcnRef != nil {
+		in, out := &in.VcnRef, &out.VcnRef
+		*out = new(ResourceRef)
+		**out = **in
+	}
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -2327,6 +2812,122 @@ func (in *OciServiceGatewayStatus) DeepCopy() *OciServiceGatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciStreamPool) DeepCopyInto(out *OciStreamPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciStreamPool.
+func (in *OciStreamPool) DeepCopy() *OciStreamPool {
+	if in == nil {
+		return nil
+	}
+	out := new(OciStreamPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciStreamPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciStreamPoolList) DeepCopyInto(out *OciStreamPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciStreamPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciStreamPoolList.
+func (in *OciStreamPoolList) DeepCopy() *OciStreamPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciStreamPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciStreamPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciStreamPoolPrivateEndpoint) DeepCopyInto(out *OciStreamPoolPrivateEndpoint) {
+	*out = *in
+	if in.NsgIds != nil {
+		in, out := &in.NsgIds, &out.NsgIds
+		*out = make([]OCID, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciStreamPoolPrivateEndpoint.
+func (in *OciStreamPoolPrivateEndpoint) DeepCopy() *OciStreamPoolPrivateEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(OciStreamPoolPrivateEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciStreamPoolSpec) DeepCopyInto(out *OciStreamPoolSpec) {
+	*out = *in
+	if in.PrivateEndpoint != nil {
+		in, out := &in.PrivateEndpoint, &out.PrivateEndpoint
+		*out = new(OciStreamPoolPrivateEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	in.TagResources.DeepCopyInto(&out.TagResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciStreamPoolSpec.
+func (in *OciStreamPoolSpec) DeepCopy() *OciStreamPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciStreamPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciStreamPoolStatus) DeepCopyInto(out *OciStreamPoolStatus) {
+	*out = *in
+	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciStreamPoolStatus.
+func (in *OciStreamPoolStatus) DeepCopy() *OciStreamPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OciStreamPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciSubnet) DeepCopyInto(out *OciSubnet) {
 	*out = *in
@@ -2389,11 +2990,22 @@ func (in *OciSubnetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciSubnetSpec) DeepCopyInto(out *OciSubnetSpec) {
 	*out = *in
+	if in.VcnRef != nil {
+		in, out := &in.VcnRef, &out.VcnRef
+		*out = new(ResourceRef)
+		**out = **in
+	}
 	if in.SecurityListIds != nil {
 		in, out := &in.SecurityListIds, &out.SecurityListIds
 		*out = make([]OCID, len(*in))
 		copy(*out, *in)
 	}
+	if in.Ipv6CidrBlocks != nil {
+		in, out := &in.Ipv6CidrBlocks, &out.Ipv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -2423,6 +3035,98 @@ func (in *OciSubnetStatus) DeepCopy() *OciSubnetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciVaultSecret) DeepCopyInto(out *OciVaultSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciVaultSecret.
+func (in *OciVaultSecret) DeepCopy() *OciVaultSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(OciVaultSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciVaultSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciVaultSecretList) DeepCopyInto(out *OciVaultSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciVaultSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciVaultSecretList.
+func (in *OciVaultSecretList) DeepCopy() *OciVaultSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciVaultSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciVaultSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciVaultSecretSpec) DeepCopyInto(out *OciVaultSecretSpec) {
+	*out = *in
+	out.Content = in.Content
+	in.TagResources.DeepCopyInto(&out.TagResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciVaultSecretSpec.
+func (in *OciVaultSecretSpec) DeepCopy() *OciVaultSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciVaultSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciVaultSecretStatus) DeepCopyInto(out *OciVaultSecretStatus) {
+	*out = *in
+	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciVaultSecretStatus.
+func (in *OciVaultSecretStatus) DeepCopy() *OciVaultSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OciVaultSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciVcn) DeepCopyInto(out *OciVcn) {
 	*out = *in
@@ -2485,6 +3189,7 @@ func (in *OciVcnList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OciVcnSpec) DeepCopyInto(out *OciVcnSpec) {
 	*out = *in
+	out.AuthSecretRef = in.AuthSecretRef
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -2514,6 +3219,21 @@ func (in *OciVcnStatus) DeepCopy() *OciVcnStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchBackupPolicy) DeepCopyInto(out *OpenSearchBackupPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchBackupPolicy.
+func (in *OpenSearchBackupPolicy) DeepCopy() *OpenSearchBackupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchBackupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenSearchCluster) DeepCopyInto(out *OpenSearchCluster) {
 	*out = *in
@@ -2576,6 +3296,12 @@ func (in *OpenSearchClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenSearchClusterSpec) DeepCopyInto(out *OpenSearchClusterSpec) {
 	*out = *in
+	out.MasterUserSecretRef = in.MasterUserSecretRef
+	if in.BackupPolicy != nil {
+		in, out := &in.BackupPolicy, &out.BackupPolicy
+		*out = new(OpenSearchBackupPolicy)
+		**out = **in
+	}
 	in.TagResources.DeepCopyInto(&out.TagResources)
 }
 
@@ -2593,6 +3319,10 @@ func (in *OpenSearchClusterSpec) DeepCopy() *OpenSearchClusterSpec {
 func (in *OpenSearchClusterStatus) DeepCopyInto(out *OpenSearchClusterStatus) {
 	*out = *in
 	in.OsokStatus.DeepCopyInto(&out.OsokStatus)
+	if in.LastBackupAt != nil {
+		in, out := &in.LastBackupAt, &out.LastBackupAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchClusterStatus.
@@ -2820,6 +3550,21 @@ func (in *RedisClusterStatus) DeepCopy() *RedisClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRef.
+func (in *ResourceRef) DeepCopy() *ResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteRule) DeepCopyInto(out *RouteRule) {
 	*out = *in
@@ -3046,3 +3791,18 @@ func (in *UsernameSource) DeepCopy() *UsernameSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretContentSource) DeepCopyInto(out *VaultSecretContentSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretContentSource.
+func (in *VaultSecretContentSource) DeepCopy() *VaultSecretContentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretContentSource)
+	in.DeepCopyInto(out)
+	return out
+}
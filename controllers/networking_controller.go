@@ -7,12 +7,21 @@ package controllers
 
 import (
 	"context"
+	"slices"
 
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // OciVcnReconciler reconciles an OciVcn object
@@ -58,15 +67,25 @@ func (r *OciSubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return r.Reconciler.Reconcile(ctx, req, subnet)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Spec changes to an OciVcn requeue the
+// OciSubnets whose vcnId or vcnRef names it.
 func (r *OciSubnetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OciSubnet{}).
+		For(&ociv1beta1.OciSubnet{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciVcn{}, handler.EnqueueRequestsFromMapFunc(r.subnetsForVcn),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+// subnetsForVcn maps an OciVcn to the OciSubnets in its namespace that belong to it.
+func (r *OciSubnetReconciler) subnetsForVcn(ctx context.Context, vcn client.Object) []reconcile.Request {
+	return vcnDependentRequests(ctx, r.Reconciler, vcn, &ociv1beta1.OciSubnetList{}, func(obj runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef) {
+		spec := obj.(*ociv1beta1.OciSubnet).Spec
+		return spec.VcnId, spec.VcnRef
+	})
+}
+
 // OciInternetGatewayReconciler reconciles an OciInternetGateway object
 type OciInternetGatewayReconciler struct {
 	Reconciler *core.BaseReconciler
@@ -84,15 +103,25 @@ func (r *OciInternetGatewayReconciler) Reconcile(ctx context.Context, req ctrl.R
 	return r.Reconciler.Reconcile(ctx, req, igw)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Spec changes to an OciVcn requeue the
+// OciInternetGateways whose vcnId or vcnRef names it.
 func (r *OciInternetGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OciInternetGateway{}).
+		For(&ociv1beta1.OciInternetGateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciVcn{}, handler.EnqueueRequestsFromMapFunc(r.internetGatewaysForVcn),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+// internetGatewaysForVcn maps an OciVcn to the OciInternetGateways in its namespace that belong to it.
+func (r *OciInternetGatewayReconciler) internetGatewaysForVcn(ctx context.Context, vcn client.Object) []reconcile.Request {
+	return vcnDependentRequests(ctx, r.Reconciler, vcn, &ociv1beta1.OciInternetGatewayList{}, func(obj runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef) {
+		spec := obj.(*ociv1beta1.OciInternetGateway).Spec
+		return spec.VcnId, spec.VcnRef
+	})
+}
+
 // OciNatGatewayReconciler reconciles an OciNatGateway object
 type OciNatGatewayReconciler struct {
 	Reconciler *core.BaseReconciler
@@ -110,15 +139,25 @@ func (r *OciNatGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return r.Reconciler.Reconcile(ctx, req, nat)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Spec changes to an OciVcn requeue the
+// OciNatGateways whose vcnId or vcnRef names it.
 func (r *OciNatGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OciNatGateway{}).
+		For(&ociv1beta1.OciNatGateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciVcn{}, handler.EnqueueRequestsFromMapFunc(r.natGatewaysForVcn),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+// natGatewaysForVcn maps an OciVcn to the OciNatGateways in its namespace that belong to it.
+func (r *OciNatGatewayReconciler) natGatewaysForVcn(ctx context.Context, vcn client.Object) []reconcile.Request {
+	return vcnDependentRequests(ctx, r.Reconciler, vcn, &ociv1beta1.OciNatGatewayList{}, func(obj runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef) {
+		spec := obj.(*ociv1beta1.OciNatGateway).Spec
+		return spec.VcnId, spec.VcnRef
+	})
+}
+
 // OciServiceGatewayReconciler reconciles an OciServiceGateway object
 type OciServiceGatewayReconciler struct {
 	Reconciler *core.BaseReconciler
@@ -136,15 +175,25 @@ func (r *OciServiceGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	return r.Reconciler.Reconcile(ctx, req, sgw)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Spec changes to an OciVcn requeue the
+// OciServiceGateways whose vcnId or vcnRef names it.
 func (r *OciServiceGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OciServiceGateway{}).
+		For(&ociv1beta1.OciServiceGateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciVcn{}, handler.EnqueueRequestsFromMapFunc(r.serviceGatewaysForVcn),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+// serviceGatewaysForVcn maps an OciVcn to the OciServiceGateways in its namespace that belong to it.
+func (r *OciServiceGatewayReconciler) serviceGatewaysForVcn(ctx context.Context, vcn client.Object) []reconcile.Request {
+	return vcnDependentRequests(ctx, r.Reconciler, vcn, &ociv1beta1.OciServiceGatewayList{}, func(obj runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef) {
+		spec := obj.(*ociv1beta1.OciServiceGateway).Spec
+		return spec.VcnId, spec.VcnRef
+	})
+}
+
 // OciDrgReconciler reconciles an OciDrg object
 type OciDrgReconciler struct {
 	Reconciler *core.BaseReconciler
@@ -179,6 +228,8 @@ type OciSecurityListReconciler struct {
 // +kubebuilder:rbac:groups=oci.oracle.com,resources=ocisecuritylists,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oci.oracle.com,resources=ocisecuritylists/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=oci.oracle.com,resources=ocisecuritylists/finalizers,verbs=update
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocisecurityrulesets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -188,15 +239,63 @@ func (r *OciSecurityListReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return r.Reconciler.Reconcile(ctx, req, sl)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Changes to an OciSecurityRuleSet or to a
+// ConfigMap of rules requeue the OciSecurityLists that reference it, so the changed rules are applied.
 func (r *OciSecurityListReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OciSecurityList{}).
+		For(&ociv1beta1.OciSecurityList{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciSecurityRuleSet{}, handler.EnqueueRequestsFromMapFunc(r.securityListsForRuleSet),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.securityListsForConfigMap),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+// securityListsForRuleSet maps an OciSecurityRuleSet to the OciSecurityLists in its namespace that
+// reference it.
+func (r *OciSecurityListReconciler) securityListsForRuleSet(ctx context.Context, ruleSet client.Object) []reconcile.Request {
+	securityLists := &ociv1beta1.OciSecurityListList{}
+	if err := r.Reconciler.List(ctx, securityLists, client.InNamespace(ruleSet.GetNamespace())); err != nil {
+		r.Reconciler.Log.ErrorLog(err, "Listing OciSecurityLists for rule set failed", "ruleSet", ruleSet.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sl := range securityLists.Items {
+		if !slices.Contains(sl.Spec.RuleSetRefs, ruleSet.GetName()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: sl.Namespace,
+			Name:      sl.Name,
+		}})
+	}
+	return requests
+}
+
+// securityListsForConfigMap maps a ConfigMap to the OciSecurityLists in its namespace that load rules
+// from it.
+func (r *OciSecurityListReconciler) securityListsForConfigMap(ctx context.Context, configMap client.Object) []reconcile.Request {
+	securityLists := &ociv1beta1.OciSecurityListList{}
+	if err := r.Reconciler.List(ctx, securityLists, client.InNamespace(configMap.GetNamespace())); err != nil {
+		r.Reconciler.Log.ErrorLog(err, "Listing OciSecurityLists for ConfigMap failed", "configMap", configMap.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sl := range securityLists.Items {
+		if sl.Spec.RulesFromConfigMap == nil || sl.Spec.RulesFromConfigMap.Name != configMap.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: sl.Namespace,
+			Name:      sl.Name,
+		}})
+	}
+	return requests
+}
+
 // OciNetworkSecurityGroupReconciler reconciles an OciNetworkSecurityGroup object
 type OciNetworkSecurityGroupReconciler struct {
 	Reconciler *core.BaseReconciler
@@ -248,3 +347,116 @@ func (r *OciRouteTableReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
+
+// OciNetworkReconciler reconciles an OciNetwork object
+type OciNetworkReconciler struct {
+	Reconciler *core.BaseReconciler
+}
+
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocinetworks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocinetworks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocinetworks/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *OciNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	network := &ociv1beta1.OciNetwork{}
+	return r.Reconciler.Reconcile(ctx, req, network)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OciNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ociv1beta1.OciNetwork{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// OciLocalPeeringGatewayReconciler reconciles an OciLocalPeeringGateway object
+type OciLocalPeeringGatewayReconciler struct {
+	Reconciler *core.BaseReconciler
+}
+
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocilocalpeeringgateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocilocalpeeringgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocilocalpeeringgateways/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *OciLocalPeeringGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	lpg := &ociv1beta1.OciLocalPeeringGateway{}
+	return r.Reconciler.Reconcile(ctx, req, lpg)
+}
+
+// SetupWithManager sets up the controller with the Manager. Spec changes to an OciVcn requeue the
+// OciLocalPeeringGateways whose vcnId names it.
+func (r *OciLocalPeeringGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ociv1beta1.OciLocalPeeringGateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&ociv1beta1.OciVcn{}, handler.EnqueueRequestsFromMapFunc(r.localPeeringGatewaysForVcn),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
+		Complete(r)
+}
+
+// localPeeringGatewaysForVcn maps an OciVcn to the OciLocalPeeringGateways in its namespace that belong to it.
+func (r *OciLocalPeeringGatewayReconciler) localPeeringGatewaysForVcn(ctx context.Context, vcn client.Object) []reconcile.Request {
+	return vcnDependentRequests(ctx, r.Reconciler, vcn, &ociv1beta1.OciLocalPeeringGatewayList{}, func(obj runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef) {
+		return obj.(*ociv1beta1.OciLocalPeeringGateway).Spec.VcnId, nil
+	})
+}
+
+// vcnDependentRequests lists the resources of one kind in the OciVcn's namespace and returns a request
+// for each whose vcnId, read by vcnOf, is the OCID of the VCN or whose vcnRef names the OciVcn. A VCN
+// without an OCID yet has no dependents.
+func vcnDependentRequests(ctx context.Context, reconciler *core.BaseReconciler, obj client.Object,
+	list client.ObjectList, vcnOf func(runtime.Object) (ociv1beta1.OCID, *ociv1beta1.ResourceRef)) []reconcile.Request {
+	vcn, ok := obj.(*ociv1beta1.OciVcn)
+	if !ok {
+		return nil
+	}
+	vcnId := vcn.Status.OsokStatus.Ocid
+	if vcnId == "" {
+		vcnId = vcn.Spec.VcnId
+	}
+	if vcnId == "" {
+		return nil
+	}
+
+	if err := reconciler.List(ctx, list, client.InNamespace(vcn.Namespace)); err != nil {
+		reconciler.Log.ErrorLog(err, "Listing dependents of OciVcn failed", "vcn", vcn.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(item runtime.Object) error {
+		dependent, err := meta.Accessor(item)
+		if err != nil {
+			return nil
+		}
+		id, ref := vcnOf(item)
+		if id != vcnId && !refersToVcn(ref, dependent.GetNamespace(), vcn) {
+			return nil
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: dependent.GetNamespace(),
+			Name:      dependent.GetName(),
+		}})
+		return nil
+	})
+	return requests
+}
+
+// refersToVcn reports whether ref, set on a resource in namespace, names the OciVcn.
+func refersToVcn(ref *ociv1beta1.ResourceRef, namespace string, vcn *ociv1beta1.OciVcn) bool {
+	if ref == nil || ref.Name != vcn.Name {
+		return false
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return namespace == vcn.Namespace
+}
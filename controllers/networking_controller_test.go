@@ -0,0 +1,169 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// subnetListClient serves List for OciSubnets from a fixed set and records the namespace asked for.
+type subnetListClient struct {
+	client.Client
+	subnets   []ociv1beta1.OciSubnet
+	namespace string
+}
+
+func (c *subnetListClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	c.namespace = listOpts.Namespace
+	list.(*ociv1beta1.OciSubnetList).Items = c.subnets
+	return nil
+}
+
+func makeSubnet(name string, vcnId ociv1beta1.OCID) ociv1beta1.OciSubnet {
+	subnet := ociv1beta1.OciSubnet{}
+	subnet.Name = name
+	subnet.Namespace = "default"
+	subnet.Spec.VcnId = vcnId
+	return subnet
+}
+
+func TestSubnetsForVcn_EnqueuesDependentSubnets(t *testing.T) {
+	listClient := &subnetListClient{subnets: []ociv1beta1.OciSubnet{
+		makeSubnet("public", "ocid1.vcn.oc1..changed"),
+		makeSubnet("other-vcn", "ocid1.vcn.oc1..other"),
+		makeSubnet("private", "ocid1.vcn.oc1..changed"),
+	}}
+	r := &OciSubnetReconciler{Reconciler: &core.BaseReconciler{
+		Client: listClient,
+		Log:    loggerutil.OSOKLogger{Logger: logr.Discard()},
+	}}
+
+	vcn := &ociv1beta1.OciVcn{}
+	vcn.Name = "my-vcn"
+	vcn.Namespace = "default"
+	vcn.Status.OsokStatus.Ocid = "ocid1.vcn.oc1..changed"
+
+	requests := r.subnetsForVcn(context.Background(), vcn)
+	assert.Equal(t, "default", listClient.namespace)
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "public"}},
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "private"}},
+	}, requests)
+}
+
+func TestSubnetsForVcn_EnqueuesSubnetsReferencingVcn(t *testing.T) {
+	byRef := makeSubnet("by-ref", "")
+	byRef.Spec.VcnRef = &ociv1beta1.ResourceRef{Name: "my-vcn"}
+	otherRef := makeSubnet("other-ref", "")
+	otherRef.Spec.VcnRef = &ociv1beta1.ResourceRef{Name: "other-vcn"}
+	listClient := &subnetListClient{subnets: []ociv1beta1.OciSubnet{byRef, otherRef}}
+	r := &OciSubnetReconciler{Reconciler: &core.BaseReconciler{
+		Client: listClient,
+		Log:    loggerutil.OSOKLogger{Logger: logr.Discard()},
+	}}
+
+	vcn := &ociv1beta1.OciVcn{}
+	vcn.Name = "my-vcn"
+	vcn.Namespace = "default"
+	vcn.Status.OsokStatus.Ocid = "ocid1.vcn.oc1..changed"
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "by-ref"}},
+	}, r.subnetsForVcn(context.Background(), vcn))
+}
+
+func TestSubnetsForVcn_VcnWithoutOcidHasNoDependents(t *testing.T) {
+	listClient := &subnetListClient{subnets: []ociv1beta1.OciSubnet{makeSubnet("orphan", "")}}
+	r := &OciSubnetReconciler{Reconciler: &core.BaseReconciler{
+		Client: listClient,
+		Log:    loggerutil.OSOKLogger{Logger: logr.Discard()},
+	}}
+
+	vcn := &ociv1beta1.OciVcn{}
+	vcn.Name = "pending-vcn"
+	vcn.Namespace = "default"
+
+	assert.Empty(t, r.subnetsForVcn(context.Background(), vcn))
+}
+
+// securityListListClient serves List for OciSecurityLists from a fixed set.
+type securityListListClient struct {
+	client.Client
+	securityLists []ociv1beta1.OciSecurityList
+}
+
+func (c *securityListListClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	list.(*ociv1beta1.OciSecurityListList).Items = c.securityLists
+	return nil
+}
+
+func TestSecurityListsForRuleSet_EnqueuesReferencingLists(t *testing.T) {
+	makeSecurityList := func(name string, refs ...string) ociv1beta1.OciSecurityList {
+		sl := ociv1beta1.OciSecurityList{}
+		sl.Name = name
+		sl.Namespace = "default"
+		sl.Spec.RuleSetRefs = refs
+		return sl
+	}
+	r := &OciSecurityListReconciler{Reconciler: &core.BaseReconciler{
+		Client: &securityListListClient{securityLists: []ociv1beta1.OciSecurityList{
+			makeSecurityList("web", "baseline", "http"),
+			makeSecurityList("inline-only"),
+			makeSecurityList("db", "baseline"),
+		}},
+		Log: loggerutil.OSOKLogger{Logger: logr.Discard()},
+	}}
+
+	ruleSet := &ociv1beta1.OciSecurityRuleSet{}
+	ruleSet.Name = "baseline"
+	ruleSet.Namespace = "default"
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "web"}},
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "db"}},
+	}, r.securityListsForRuleSet(context.Background(), ruleSet))
+}
+
+func TestSecurityListsForConfigMap_EnqueuesLoadingLists(t *testing.T) {
+	makeSecurityList := func(name, configMap string) ociv1beta1.OciSecurityList {
+		sl := ociv1beta1.OciSecurityList{}
+		sl.Name = name
+		sl.Namespace = "default"
+		if configMap != "" {
+			sl.Spec.RulesFromConfigMap = &ociv1beta1.ConfigMapRulesSource{Name: configMap, Key: "rules"}
+		}
+		return sl
+	}
+	r := &OciSecurityListReconciler{Reconciler: &core.BaseReconciler{
+		Client: &securityListListClient{securityLists: []ociv1beta1.OciSecurityList{
+			makeSecurityList("web", "web-rules"),
+			makeSecurityList("inline-only", ""),
+			makeSecurityList("db", "db-rules"),
+		}},
+		Log: loggerutil.OSOKLogger{Logger: logr.Discard()},
+	}}
+
+	configMap := &corev1.ConfigMap{}
+	configMap.Name = "web-rules"
+	configMap.Namespace = "default"
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "web"}},
+	}, r.securityListsForConfigMap(context.Background(), configMap))
+}
@@ -7,9 +7,16 @@ package controllers
 
 import (
 	"context"
+
 	"github.com/oracle/oci-service-operator/pkg/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,11 +40,34 @@ func (r *OpenSearchClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	return r.Reconciler.Reconcile(ctx, req, openSearchCluster)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Changes to a Kubernetes secret requeue the
+// OpenSearchClusters that read their master user from it, so rotated credentials are applied.
 func (r *OpenSearchClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&ociv1beta1.OpenSearchCluster{}).
+		For(&ociv1beta1.OpenSearchCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.clustersForMasterUserSecret)).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
+
+// clustersForMasterUserSecret maps a Kubernetes secret to the OpenSearchClusters in its namespace that
+// read their master user from it.
+func (r *OpenSearchClusterReconciler) clustersForMasterUserSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	clusters := &ociv1beta1.OpenSearchClusterList{}
+	if err := r.Reconciler.List(ctx, clusters, client.InNamespace(secret.GetNamespace())); err != nil {
+		r.Reconciler.Log.ErrorLog(err, "Listing OpenSearchClusters for secret failed", "secret", secret.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.MasterUserSecretRef.SecretName != secret.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		}})
+	}
+	return requests
+}
@@ -33,11 +33,12 @@ func (r *OciQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	return r.Reconciler.Reconcile(ctx, req, queue)
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Annotation changes are reconciled too, so
+// setting the purge annotation takes effect without a spec change.
 func (r *OciQueueReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&ociv1beta1.OciQueue{}).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})).
 		Complete(r)
 }
@@ -46,3 +46,28 @@ func (r *StreamReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
+
+// StreamPoolReconciler reconciles an OciStreamPool object
+type StreamPoolReconciler struct {
+	Reconciler *core.BaseReconciler
+}
+
+//+kubebuilder:rbac:groups=oci.oracle.com,resources=ocistreampools,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=oci.oracle.com,resources=ocistreampools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=oci.oracle.com,resources=ocistreampools/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *StreamPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	pool := &ociv1beta1.OciStreamPool{}
+	return r.Reconciler.Reconcile(ctx, req, pool)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StreamPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ociv1beta1.OciStreamPool{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
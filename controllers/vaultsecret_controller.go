@@ -0,0 +1,72 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/oracle/oci-service-operator/pkg/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// OciVaultSecretReconciler reconciles an OciVaultSecret object
+type OciVaultSecretReconciler struct {
+	Reconciler *core.BaseReconciler
+}
+
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocivaultsecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocivaultsecrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oci.oracle.com,resources=ocivaultsecrets/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *OciVaultSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	vaultSecret := &ociv1beta1.OciVaultSecret{}
+	return r.Reconciler.Reconcile(ctx, req, vaultSecret)
+}
+
+// SetupWithManager sets up the controller with the Manager. Changes to a Kubernetes secret requeue the
+// OciVaultSecrets that source their content from it, so the vault secret is rotated when the source changes.
+func (r *OciVaultSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ociv1beta1.OciVaultSecret{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.vaultSecretsForSecret)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 3}).
+		Complete(r)
+}
+
+// vaultSecretsForSecret maps a Kubernetes secret to the OciVaultSecrets in its namespace that read from it.
+func (r *OciVaultSecretReconciler) vaultSecretsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	vaultSecrets := &ociv1beta1.OciVaultSecretList{}
+	if err := r.Reconciler.List(ctx, vaultSecrets, client.InNamespace(secret.GetNamespace())); err != nil {
+		r.Reconciler.Log.ErrorLog(err, "Listing OciVaultSecrets for secret failed", "secret", secret.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, vaultSecret := range vaultSecrets.Items {
+		if vaultSecret.Spec.Content.SecretName != secret.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: vaultSecret.Namespace,
+			Name:      vaultSecret.Name,
+		}})
+	}
+	return requests
+}
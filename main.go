@@ -6,6 +6,7 @@ Licensed under the Universal Permissive License v 1.0 as shown at http://oss.ora
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -23,7 +24,11 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/authhelper"
+	"github.com/oracle/oci-service-operator/pkg/config"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	ocinetworking "github.com/oracle/oci-service-operator/pkg/servicemanager/networking"
+	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
 var (
@@ -51,10 +56,63 @@ func run() error {
 	flags, zapOptions, explicitFlags := parseManagerFlags()
 	ctrl.SetLogger(newZapLogger(zapOptions))
 
+	if flags.printConfig {
+		osokConfig := config.GetConfigDetails(loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("config")})
+		authConfigProvider := &authhelper.AuthConfigProvider{
+			Log: loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("config")},
+		}
+		if _, err := authConfigProvider.GetAuthProvider(osokConfig); err != nil {
+			setupLog.ErrorLog(err, "OCI auth detection failed")
+		}
+		resolved, err := resolveEffectiveConfig(flags, explicitFlags, osokConfig, authConfigProvider.Method)
+		if err != nil {
+			return fmt.Errorf("resolve effective config: %w", err)
+		}
+		return printEffectiveConfig(os.Stdout, resolved)
+	}
+
+	if flags.exportCompartment != "" {
+		osokConfig := config.GetConfigDetails(loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("config")})
+		authConfigProvider := &authhelper.AuthConfigProvider{
+			Log: loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("setup").WithName("config")},
+		}
+		provider, err := authConfigProvider.GetAuthProvider(osokConfig)
+		if err != nil {
+			return fmt.Errorf("get OCI auth provider: %w", err)
+		}
+		return ocinetworking.ExportCompartment(context.Background(), provider, flags.exportCompartment, os.Stdout)
+	}
+
 	managerOptions, err := buildManagerOptions(flags, explicitFlags)
 	if err != nil {
 		return fmt.Errorf("build manager options: %w", err)
 	}
+	controllerResyncPeriods, err = buildResyncPeriods(flags)
+	if err != nil {
+		return fmt.Errorf("build resync periods: %w", err)
+	}
+	controllerDefaultTags, err = buildDefaultTags(flags)
+	if err != nil {
+		return fmt.Errorf("build default tags: %w", err)
+	}
+	requiredDefinedTags, err := buildRequiredDefinedTags(flags)
+	if err != nil {
+		return fmt.Errorf("build required defined tags: %w", err)
+	}
+	controllerProtectedTagNamespaces, err = buildProtectedTagNamespaces(flags)
+	if err != nil {
+		return fmt.Errorf("build protected tag namespaces: %w", err)
+	}
+	controllerFinalizerName = flags.finalizerName
+	controllerDeleteTimeout = flags.deleteTimeout
+	if err := util.ValidateQuietWindow(flags.quietWindow); err != nil {
+		return fmt.Errorf("parse quiet window: %w", err)
+	}
+	controllerQuietWindow = flags.quietWindow
+	controllerRetentionTag, err = ocinetworking.ParseRetentionTag(flags.retentionTag)
+	if err != nil {
+		return fmt.Errorf("parse retention tag: %w", err)
+	}
 
 	manager, err := ctrl.NewManager(ctrl.GetConfigOrDie(), managerOptions)
 	if err != nil {
@@ -71,6 +129,9 @@ func run() error {
 	if err := registerControllers(manager, provider, credClient, metricsClient); err != nil {
 		return err
 	}
+	if err := registerWebhooks(flags.enableWebhooks, requiredDefinedTags, manager); err != nil {
+		return err
+	}
 	if err := registerHealthChecks(manager); err != nil {
 		return err
 	}
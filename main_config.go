@@ -8,7 +8,11 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -18,9 +22,18 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"gopkg.in/yaml.v3"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/authhelper"
+	osokconfig "github.com/oracle/oci-service-operator/pkg/config"
+	"github.com/oracle/oci-service-operator/pkg/core"
+	ocinetworking "github.com/oracle/oci-service-operator/pkg/servicemanager/networking"
 )
 
-const defaultLeaderElectionID = "40558063.oci"
+const (
+	defaultLeaderElectionID = "40558063.oci"
+	redactedConfigValue     = "<redacted>"
+)
 
 type managerFlags struct {
 	configFile           string
@@ -28,6 +41,13 @@ type managerFlags struct {
 	probeAddr            string
 	enableLeaderElection bool
 	initOSOKResources    bool
+	enableWebhooks       bool
+	printConfig          bool
+	exportCompartment    string
+	finalizerName        string
+	retentionTag         string
+	deleteTimeout        time.Duration
+	quietWindow          string
 }
 
 type controllerManagerConfig struct {
@@ -35,6 +55,9 @@ type controllerManagerConfig struct {
 	CacheNamespace          string                           `yaml:"cacheNamespace,omitempty"`
 	GracefulShutdownTimeout *controllerManagerDuration       `yaml:"gracefulShutDown,omitempty"`
 	Controller              *controllerManagerController     `yaml:"controller,omitempty"`
+	DefaultTags             *controllerManagerDefaultTags    `yaml:"defaultTags,omitempty"`
+	RequiredDefinedTags     []string                         `yaml:"requiredDefinedTags,omitempty"`
+	ProtectedTagNamespaces  []string                         `yaml:"protectedTagNamespaces,omitempty"`
 	Metrics                 controllerManagerMetrics         `yaml:"metrics,omitempty"`
 	Health                  controllerManagerHealth          `yaml:"health,omitempty"`
 	LeaderElection          *controllerManagerLeaderElection `yaml:"leaderElection,omitempty"`
@@ -44,6 +67,15 @@ type controllerManagerController struct {
 	GroupKindConcurrency map[string]int             `yaml:"groupKindConcurrency,omitempty"`
 	CacheSyncTimeout     *controllerManagerDuration `yaml:"cacheSyncTimeout,omitempty"`
 	RecoverPanic         *bool                      `yaml:"recoverPanic,omitempty"`
+	// ResyncPeriods opts controllers into periodic resync, keyed by controller name (e.g. OciVcn).
+	ResyncPeriods map[string]controllerManagerDuration `yaml:"resyncPeriods,omitempty"`
+}
+
+// controllerManagerDefaultTags are tags added to the VCNs and subnets the operator manages. Tags set in
+// a resource's spec take precedence.
+type controllerManagerDefaultTags struct {
+	FreeformTags map[string]string            `yaml:"freeformTags,omitempty"`
+	DefinedTags  map[string]map[string]string `yaml:"definedTags,omitempty"`
 }
 
 type controllerManagerMetrics struct {
@@ -113,6 +145,26 @@ func parseManagerFlags() (managerFlags, zap.Options, map[string]bool) {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&flags.initOSOKResources, "init-osok-resources", false,
 		"Install OSOK prerequisites like CRDs at manager bootup")
+	flag.BoolVar(&flags.enableWebhooks, "enable-webhooks", false,
+		"Serve the admission webhooks. Requires serving certificates in the webhook server's cert directory.")
+	flag.StringVar(&flags.finalizerName, "finalizer-name", core.OSOKFinalizerName,
+		"The finalizer added to managed resources. Give operators running side by side distinct names; "+
+			"resources carrying the default finalizer are migrated to this one.")
+	flag.StringVar(&flags.retentionTag, "retention-tag", "",
+		"A defined tag, written as <namespace>.<key>, that protects live VCNs and subnets from deletion. "+
+			"When the OCI resource carries the tag, deleting the Kubernetes resource leaves it in OCI and keeps the finalizer.")
+	flag.DurationVar(&flags.deleteTimeout, "delete-timeout", 0,
+		"How long a VCN or subnet may stay in OCI after its Kubernetes resource is deleted before a DeleteTimedOut warning is emitted. "+
+			"Resources annotated with "+ocinetworking.ForceRemoveFinalizerAnnotation+"=true then lose their finalizer, orphaning the OCI resource. "+
+			"Zero disables the timeout.")
+	flag.StringVar(&flags.quietWindow, "quiet-window", "",
+		"Comma-separated UTC time ranges, such as \"Sat-Sun 00:00-24:00\" or \"Mon-Fri 22:00-06:00\", in which VCN and subnet "+
+			"creates, updates and deletes are deferred until the window closes. Reads and status updates still run.")
+	flag.BoolVar(&flags.printConfig, "print-config", false,
+		"Print the effective configuration resolved from flags, the config file, and the environment as YAML, then exit. "+
+			"Credentials are redacted.")
+	flag.StringVar(&flags.exportCompartment, "export-compartment", "",
+		"Print OciVcn and OciSubnet manifests that adopt the existing VCNs and subnets in this compartment OCID as YAML, then exit.")
 
 	zapOptions.BindFlags(flag.CommandLine)
 	flag.Parse()
@@ -276,3 +328,272 @@ func applyControllerOptions(options *ctrl.Options, config controllerManagerConfi
 		options.Controller.RecoverPanic = config.Controller.RecoverPanic
 	}
 }
+
+// buildResyncPeriods returns the per-controller resync periods from the config file, if any.
+func buildResyncPeriods(flags managerFlags) (map[string]time.Duration, error) {
+	if flags.configFile == "" {
+		return nil, nil
+	}
+
+	config, err := loadControllerManagerConfig(flags.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return resyncPeriodsFromConfig(config), nil
+}
+
+func resyncPeriodsFromConfig(config controllerManagerConfig) map[string]time.Duration {
+	if config.Controller == nil || len(config.Controller.ResyncPeriods) == 0 {
+		return nil
+	}
+
+	periods := make(map[string]time.Duration, len(config.Controller.ResyncPeriods))
+	for name, period := range config.Controller.ResyncPeriods {
+		periods[name] = period.Duration
+	}
+	return periods
+}
+
+// buildDefaultTags returns the default tags from the config file, if any.
+func buildDefaultTags(flags managerFlags) (ociv1beta1.TagResources, error) {
+	if flags.configFile == "" {
+		return ociv1beta1.TagResources{}, nil
+	}
+
+	config, err := loadControllerManagerConfig(flags.configFile)
+	if err != nil {
+		return ociv1beta1.TagResources{}, err
+	}
+
+	return defaultTagsFromConfig(config), nil
+}
+
+func defaultTagsFromConfig(config controllerManagerConfig) ociv1beta1.TagResources {
+	tags := ociv1beta1.TagResources{}
+	if config.DefaultTags == nil {
+		return tags
+	}
+
+	if len(config.DefaultTags.FreeformTags) > 0 {
+		tags.FreeFormTags = config.DefaultTags.FreeformTags
+	}
+	if len(config.DefaultTags.DefinedTags) > 0 {
+		tags.DefinedTags = make(map[string]ociv1beta1.MapValue, len(config.DefaultTags.DefinedTags))
+		for namespace, values := range config.DefaultTags.DefinedTags {
+			tags.DefinedTags[namespace] = values
+		}
+	}
+	return tags
+}
+
+// buildRequiredDefinedTags returns the "Namespace.Key" defined tags the admission webhook requires on every
+// resource, if any are set in the config file.
+func buildRequiredDefinedTags(flags managerFlags) ([]string, error) {
+	if flags.configFile == "" {
+		return nil, nil
+	}
+
+	config, err := loadControllerManagerConfig(flags.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range config.RequiredDefinedTags {
+		namespace, key, ok := strings.Cut(tag, ".")
+		if !ok || namespace == "" || key == "" {
+			return nil, fmt.Errorf("required defined tag %q must be in Namespace.Key form", tag)
+		}
+	}
+	return config.RequiredDefinedTags, nil
+}
+
+// buildProtectedTagNamespaces returns the defined-tag namespaces VCN and subnet updates leave as they are
+// in OCI. Without a config file or a protectedTagNamespaces entry, Oracle-Tags is protected.
+func buildProtectedTagNamespaces(flags managerFlags) ([]string, error) {
+	if flags.configFile == "" {
+		return ocinetworking.DefaultProtectedTagNamespaces, nil
+	}
+
+	config, err := loadControllerManagerConfig(flags.configFile)
+	if err != nil {
+		return nil, err
+	}
+	if config.ProtectedTagNamespaces == nil {
+		return ocinetworking.DefaultProtectedTagNamespaces, nil
+	}
+	return config.ProtectedTagNamespaces, nil
+}
+
+// effectiveConfig is the configuration the manager resolved from its flags, config file, and environment.
+type effectiveConfig struct {
+	Auth    effectiveAuthConfig    `yaml:"auth"`
+	Manager effectiveManagerConfig `yaml:"manager"`
+}
+
+type effectiveAuthConfig struct {
+	Method       string `yaml:"method"`
+	Region       string `yaml:"region,omitempty"`
+	Tenancy      string `yaml:"tenancy,omitempty"`
+	User         string `yaml:"user,omitempty"`
+	Fingerprint  string `yaml:"fingerprint,omitempty"`
+	PrivateKey   string `yaml:"privateKey,omitempty"`
+	Passphrase   string `yaml:"passphrase,omitempty"`
+	VaultDetails string `yaml:"vaultDetails,omitempty"`
+}
+
+type effectiveManagerConfig struct {
+	ConfigFile              string                       `yaml:"configFile,omitempty"`
+	MetricsBindAddress      string                       `yaml:"metricsBindAddress"`
+	HealthProbeBindAddress  string                       `yaml:"healthProbeBindAddress"`
+	EnableWebhooks          bool                         `yaml:"enableWebhooks"`
+	FinalizerName           string                       `yaml:"finalizerName"`
+	RetentionTag            string                       `yaml:"retentionTag,omitempty"`
+	DeleteTimeout           string                       `yaml:"deleteTimeout,omitempty"`
+	QuietWindow             string                       `yaml:"quietWindow,omitempty"`
+	LeaderElection          bool                         `yaml:"leaderElection"`
+	LeaderElectionID        string                       `yaml:"leaderElectionID"`
+	LeaderElectionNamespace string                       `yaml:"leaderElectionNamespace,omitempty"`
+	CacheNamespaces         []string                     `yaml:"cacheNamespaces,omitempty"`
+	GroupKindConcurrency    map[string]int               `yaml:"groupKindConcurrency,omitempty"`
+	SyncPeriod              string                       `yaml:"syncPeriod,omitempty"`
+	ResyncPeriods           map[string]string            `yaml:"resyncPeriods,omitempty"`
+	DefaultFreeformTags     map[string]string            `yaml:"defaultFreeformTags,omitempty"`
+	DefaultDefinedTags      map[string]map[string]string `yaml:"defaultDefinedTags,omitempty"`
+	RequiredDefinedTags     []string                     `yaml:"requiredDefinedTags,omitempty"`
+	ProtectedTagNamespaces  []string                     `yaml:"protectedTagNamespaces,omitempty"`
+	CacheSyncTimeout        string                       `yaml:"cacheSyncTimeout,omitempty"`
+	GracefulShutdownTimeout string                       `yaml:"gracefulShutdownTimeout,omitempty"`
+	LeaseDuration           string                       `yaml:"leaseDuration,omitempty"`
+	RenewDeadline           string                       `yaml:"renewDeadline,omitempty"`
+	RetryPeriod             string                       `yaml:"retryPeriod,omitempty"`
+}
+
+// resolveEffectiveConfig applies the same precedence the manager uses at startup and
+// returns the result with credentials redacted. authMethod is the method auth detection chose,
+// if it ran.
+func resolveEffectiveConfig(flags managerFlags, explicitFlags map[string]bool, osokConfig osokconfig.OsokConfig,
+	authMethod authhelper.AuthMethod) (effectiveConfig, error) {
+	options, err := buildManagerOptions(flags, explicitFlags)
+	if err != nil {
+		return effectiveConfig{}, err
+	}
+	resyncPeriods, err := buildResyncPeriods(flags)
+	if err != nil {
+		return effectiveConfig{}, err
+	}
+	defaultTags, err := buildDefaultTags(flags)
+	if err != nil {
+		return effectiveConfig{}, err
+	}
+	requiredDefinedTags, err := buildRequiredDefinedTags(flags)
+	if err != nil {
+		return effectiveConfig{}, err
+	}
+	protectedTagNamespaces, err := buildProtectedTagNamespaces(flags)
+	if err != nil {
+		return effectiveConfig{}, err
+	}
+
+	manager := effectiveManager(flags, options)
+	for name, period := range resyncPeriods {
+		if manager.ResyncPeriods == nil {
+			manager.ResyncPeriods = map[string]string{}
+		}
+		manager.ResyncPeriods[name] = period.String()
+	}
+	manager.DefaultFreeformTags = defaultTags.FreeFormTags
+	for namespace, values := range defaultTags.DefinedTags {
+		if manager.DefaultDefinedTags == nil {
+			manager.DefaultDefinedTags = map[string]map[string]string{}
+		}
+		manager.DefaultDefinedTags[namespace] = values
+	}
+	manager.RequiredDefinedTags = requiredDefinedTags
+	manager.ProtectedTagNamespaces = protectedTagNamespaces
+
+	return effectiveConfig{
+		Auth:    effectiveAuth(osokConfig, authMethod),
+		Manager: manager,
+	}, nil
+}
+
+func effectiveAuth(osokConfig osokconfig.OsokConfig, authMethod authhelper.AuthMethod) effectiveAuthConfig {
+	auth := osokConfig.Auth()
+	resolved := effectiveAuthConfig{
+		Method:       string(authhelper.AuthMethodInstancePrincipal),
+		Region:       auth.Region,
+		Tenancy:      auth.Tenancy,
+		User:         auth.User,
+		Fingerprint:  auth.Fingerprint,
+		PrivateKey:   redactConfigValue(auth.PrivateKey),
+		Passphrase:   redactConfigValue(auth.Passphrase),
+		VaultDetails: osokConfig.VaultDetails(),
+	}
+	if authMethod != "" {
+		resolved.Method = string(authMethod)
+		return resolved
+	}
+	// Without detection, report the method GetAuthProvider tries when instance principals
+	// are not available.
+	if !reflect.DeepEqual(auth, osokconfig.UserAuthConfig{}) {
+		resolved.Method = string(authhelper.AuthMethodUserPrincipal)
+	}
+	return resolved
+}
+
+func effectiveManager(flags managerFlags, options ctrl.Options) effectiveManagerConfig {
+	resolved := effectiveManagerConfig{
+		ConfigFile:              flags.configFile,
+		MetricsBindAddress:      options.Metrics.BindAddress,
+		HealthProbeBindAddress:  options.HealthProbeBindAddress,
+		EnableWebhooks:          flags.enableWebhooks,
+		FinalizerName:           flags.finalizerName,
+		RetentionTag:            flags.retentionTag,
+		LeaderElection:          options.LeaderElection,
+		LeaderElectionID:        options.LeaderElectionID,
+		LeaderElectionNamespace: options.LeaderElectionNamespace,
+		GroupKindConcurrency:    options.Controller.GroupKindConcurrency,
+		SyncPeriod:              formatDuration(options.Cache.SyncPeriod),
+		GracefulShutdownTimeout: formatDuration(options.GracefulShutdownTimeout),
+		LeaseDuration:           formatDuration(options.LeaseDuration),
+		RenewDeadline:           formatDuration(options.RenewDeadline),
+		RetryPeriod:             formatDuration(options.RetryPeriod),
+	}
+	if options.Controller.CacheSyncTimeout != 0 {
+		resolved.CacheSyncTimeout = options.Controller.CacheSyncTimeout.String()
+	}
+	if flags.deleteTimeout != 0 {
+		resolved.DeleteTimeout = flags.deleteTimeout.String()
+	}
+	resolved.QuietWindow = flags.quietWindow
+	for namespace := range options.Cache.DefaultNamespaces {
+		resolved.CacheNamespaces = append(resolved.CacheNamespaces, namespace)
+	}
+	sort.Strings(resolved.CacheNamespaces)
+
+	return resolved
+}
+
+func printEffectiveConfig(w io.Writer, resolved effectiveConfig) error {
+	data, err := yaml.Marshal(resolved)
+	if err != nil {
+		return fmt.Errorf("marshal effective config: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func redactConfigValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return redactedConfigValue
+}
+
+func formatDuration(duration *time.Duration) string {
+	if duration == nil {
+		return ""
+	}
+	return duration.String()
+}
@@ -7,11 +7,13 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
 	"github.com/oracle/oci-service-operator/controllers"
 	"github.com/oracle/oci-service-operator/pkg/authhelper"
 	"github.com/oracle/oci-service-operator/pkg/config"
@@ -36,9 +38,37 @@ import (
 	ociqueue "github.com/oracle/oci-service-operator/pkg/servicemanager/queue"
 	ociredis "github.com/oracle/oci-service-operator/pkg/servicemanager/redis"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager/streams"
+	ocivaultsecret "github.com/oracle/oci-service-operator/pkg/servicemanager/vaultsecret"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
+// controllerResyncPeriods holds the periodic resync configured for each controller, keyed by
+// controller name. Controllers without an entry are only reconciled when their resource changes.
+var controllerResyncPeriods map[string]time.Duration
+
+// controllerFinalizerName is the finalizer every controller adds to its resources.
+var controllerFinalizerName = core.OSOKFinalizerName
+
+// controllerRetentionTag is the defined tag that protects live VCNs and subnets from deletion,
+// including those created by an OciNetwork.
+var controllerRetentionTag ocinetworking.RetentionTag
+
+// controllerDeleteTimeout is how long a VCN or subnet delete may run before its finalizer can be
+// force-removed. Zero disables the timeout.
+var controllerDeleteTimeout time.Duration
+
+// controllerQuietWindow lists the UTC time ranges in which VCN and subnet creates, updates and deletes
+// are deferred. Empty disables it.
+var controllerQuietWindow string
+
+// controllerDefaultTags are added to every VCN and subnet the operator creates or updates, including
+// those created by an OciNetwork. Tags set in the resource's spec take precedence.
+var controllerDefaultTags ociv1beta1.TagResources
+
+// controllerProtectedTagNamespaces are the defined-tag namespaces VCN and subnet updates keep as they are
+// in OCI, including for those created by an OciNetwork.
+var controllerProtectedTagNamespaces []string
+
 type controllerRegistration struct {
 	name  string
 	setup func() error
@@ -91,6 +121,9 @@ func controllerRegistrations(manager ctrl.Manager, provider common.Configuration
 			return setupAutonomousDatabasesController(manager, provider, credentialClient, metricsClient)
 		}},
 		{name: "Streams", setup: func() error { return setupStreamsController(manager, provider, credentialClient, metricsClient) }},
+		{name: "OciStreamPool", setup: func() error {
+			return setupStreamPoolController(manager, provider, credentialClient, metricsClient)
+		}},
 		{name: "MySqlDbSystem", setup: func() error { return setupMySQLDBSystemController(manager, provider, credentialClient, metricsClient) }},
 		{name: "RedisCluster", setup: func() error { return setupRedisClusterController(manager, provider, credentialClient, metricsClient) }},
 		{name: "PostgresDbSystem", setup: func() error {
@@ -105,6 +138,9 @@ func controllerRegistrations(manager ctrl.Manager, provider common.Configuration
 			return setupOpenSearchClusterController(manager, provider, credentialClient, metricsClient)
 		}},
 		{name: "OciQueue", setup: func() error { return setupQueueController(manager, provider, credentialClient, metricsClient) }},
+		{name: "OciVaultSecret", setup: func() error {
+			return setupVaultSecretController(manager, provider, credentialClient, metricsClient)
+		}},
 		{name: "ObjectStorageBucket", setup: func() error { return setupObjectStorageController(manager, provider, credentialClient, metricsClient) }},
 		{name: "FunctionsApplication", setup: func() error {
 			return setupFunctionsApplicationController(manager, provider, credentialClient, metricsClient)
@@ -132,7 +168,29 @@ func controllerRegistrations(manager ctrl.Manager, provider common.Configuration
 			return setupNetworkSecurityGroupController(manager, provider, credentialClient, metricsClient)
 		}},
 		{name: "OciRouteTable", setup: func() error { return setupRouteTableController(manager, provider, credentialClient, metricsClient) }},
+		{name: "OciNetwork", setup: func() error { return setupNetworkController(manager, provider, credentialClient, metricsClient) }},
+		{name: "OciLocalPeeringGateway", setup: func() error {
+			return setupLocalPeeringGatewayController(manager, provider, credentialClient, metricsClient)
+		}},
+	}
+}
+
+func registerWebhooks(enableWebhooks bool, requiredDefinedTags []string, manager ctrl.Manager) error {
+	if !enableWebhooks {
+		return nil
 	}
+
+	if err := (&ociv1beta1.OciSecurityList{}).SetupWebhookWithManager(manager); err != nil {
+		return fmt.Errorf("setup OciSecurityList webhook: %w", err)
+	}
+	// The validating webhook configuration covers every resource, so the validator is always served; it
+	// admits everything when no tags are required.
+	validator := &ociv1beta1.RequiredDefinedTagsValidator{RequiredTags: requiredDefinedTags}
+	if err := validator.SetupWebhookWithManager(manager); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func registerHealthChecks(manager ctrl.Manager) error {
@@ -155,6 +213,8 @@ func newBaseReconciler(manager ctrl.Manager, serviceManager servicemanager.OSOKS
 		Metrics:            metricsClient,
 		Recorder:           manager.GetEventRecorderFor(controllerName),
 		Scheme:             scheme,
+		FinalizerName:      controllerFinalizerName,
+		ResyncPeriod:       controllerResyncPeriods[controllerName],
 	}
 }
 
@@ -180,6 +240,13 @@ func setupStreamsController(manager ctrl.Manager, provider common.ConfigurationP
 	return reconciler.SetupWithManager(manager)
 }
 
+func setupStreamPoolController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	reconciler := &controllers.StreamPoolReconciler{
+		Reconciler: newBaseReconciler(manager, streams.NewStreamPoolServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciStreamPool"), metricsClient), "OciStreamPool", metricsClient),
+	}
+	return reconciler.SetupWithManager(manager)
+}
+
 func setupMySQLDBSystemController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
 	reconciler := &controllers.MySqlDBsystemReconciler{
 		Reconciler: newBaseReconciler(manager, dbsystem.NewDbSystemServiceManager(provider, credentialClient, scheme, serviceManagerLogger("MySqlDbSystem")), "MySqlDbSystem", metricsClient),
@@ -236,6 +303,13 @@ func setupQueueController(manager ctrl.Manager, provider common.ConfigurationPro
 	return reconciler.SetupWithManager(manager)
 }
 
+func setupVaultSecretController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	reconciler := &controllers.OciVaultSecretReconciler{
+		Reconciler: newBaseReconciler(manager, ocivaultsecret.NewOciVaultSecretServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciVaultSecret")), "OciVaultSecret", metricsClient),
+	}
+	return reconciler.SetupWithManager(manager)
+}
+
 func setupObjectStorageController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
 	reconciler := &controllers.ObjectStorageBucketReconciler{
 		Reconciler: newBaseReconciler(manager, ociobjectstorage.NewObjectStorageBucketServiceManager(provider, credentialClient, scheme, serviceManagerLogger("ObjectStorageBucket")), "ObjectStorageBucket", metricsClient),
@@ -279,36 +353,59 @@ func setupComputeInstanceController(manager ctrl.Manager, provider common.Config
 }
 
 func setupVCNController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciVcnServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciVcn"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciVcn")
+	serviceManager.RetentionTag = controllerRetentionTag
+	serviceManager.DeleteTimeout = controllerDeleteTimeout
+	serviceManager.QuietWindow = controllerQuietWindow
+	serviceManager.DefaultTags = controllerDefaultTags
+	serviceManager.ProtectedTagNamespaces = controllerProtectedTagNamespaces
 	reconciler := &controllers.OciVcnReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciVcnServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciVcn")), "OciVcn", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciVcn", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
 
 func setupSubnetController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciSubnetServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciSubnet"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciSubnet")
+	serviceManager.KubeClient = manager.GetClient()
+	serviceManager.RetentionTag = controllerRetentionTag
+	serviceManager.DeleteTimeout = controllerDeleteTimeout
+	serviceManager.QuietWindow = controllerQuietWindow
+	serviceManager.DefaultTags = controllerDefaultTags
+	serviceManager.ProtectedTagNamespaces = controllerProtectedTagNamespaces
 	reconciler := &controllers.OciSubnetReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciSubnetServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciSubnet")), "OciSubnet", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciSubnet", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
 
 func setupInternetGatewayController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciInternetGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciInternetGateway"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciInternetGateway")
+	serviceManager.KubeClient = manager.GetClient()
 	reconciler := &controllers.OciInternetGatewayReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciInternetGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciInternetGateway")), "OciInternetGateway", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciInternetGateway", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
 
 func setupNatGatewayController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciNatGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciNatGateway"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciNatGateway")
+	serviceManager.KubeClient = manager.GetClient()
 	reconciler := &controllers.OciNatGatewayReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciNatGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciNatGateway")), "OciNatGateway", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciNatGateway", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
 
 func setupServiceGatewayController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciServiceGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciServiceGateway"))
+	serviceManager.KubeClient = manager.GetClient()
 	reconciler := &controllers.OciServiceGatewayReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciServiceGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciServiceGateway")), "OciServiceGateway", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciServiceGateway", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
@@ -321,8 +418,11 @@ func setupDRGController(manager ctrl.Manager, provider common.ConfigurationProvi
 }
 
 func setupSecurityListController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciSecurityListServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciSecurityList"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciSecurityList")
+	serviceManager.KubeClient = manager.GetClient()
 	reconciler := &controllers.OciSecurityListReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciSecurityListServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciSecurityList")), "OciSecurityList", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciSecurityList", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
@@ -335,8 +435,30 @@ func setupNetworkSecurityGroupController(manager ctrl.Manager, provider common.C
 }
 
 func setupRouteTableController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciRouteTableServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciRouteTable"))
+	serviceManager.Recorder = manager.GetEventRecorderFor("OciRouteTable")
 	reconciler := &controllers.OciRouteTableReconciler{
-		Reconciler: newBaseReconciler(manager, ocinetworking.NewOciRouteTableServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciRouteTable")), "OciRouteTable", metricsClient),
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciRouteTable", metricsClient),
+	}
+	return reconciler.SetupWithManager(manager)
+}
+
+func setupNetworkController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciNetworkServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciNetwork"))
+	serviceManager.RetentionTag = controllerRetentionTag
+	serviceManager.DefaultTags = controllerDefaultTags
+	serviceManager.ProtectedTagNamespaces = controllerProtectedTagNamespaces
+	reconciler := &controllers.OciNetworkReconciler{
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciNetwork", metricsClient),
+	}
+	return reconciler.SetupWithManager(manager)
+}
+
+func setupLocalPeeringGatewayController(manager ctrl.Manager, provider common.ConfigurationProvider, credentialClient credhelper.CredentialClient, metricsClient *metrics.Metrics) error {
+	serviceManager := ocinetworking.NewOciLocalPeeringGatewayServiceManager(provider, credentialClient, scheme, serviceManagerLogger("OciLocalPeeringGateway"))
+	serviceManager.KubeClient = manager.GetClient()
+	reconciler := &controllers.OciLocalPeeringGatewayReconciler{
+		Reconciler: newBaseReconciler(manager, serviceManager, "OciLocalPeeringGateway", metricsClient),
 	}
 	return reconciler.SetupWithManager(manager)
 }
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+func newTestManager(t *testing.T) ctrl.Manager {
+	t.Helper()
+	manager, err := ctrl.NewManager(&rest.Config{Host: "http://127.0.0.1:0"}, ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	assert.NoError(t, err)
+	return manager
+}
+
+func TestNewBaseReconcilerUsesConfiguredResyncPeriod(t *testing.T) {
+	previous := controllerResyncPeriods
+	t.Cleanup(func() { controllerResyncPeriods = previous })
+	controllerResyncPeriods = map[string]time.Duration{"OciVcn": 10 * time.Minute}
+	manager := newTestManager(t)
+
+	assert.Equal(t, 10*time.Minute, newBaseReconciler(manager, nil, "OciVcn", nil).ResyncPeriod)
+	assert.Zero(t, newBaseReconciler(manager, nil, "OciSubnet", nil).ResyncPeriod)
+}
+
+func TestNewBaseReconcilerUsesConfiguredFinalizerName(t *testing.T) {
+	previous := controllerFinalizerName
+	t.Cleanup(func() { controllerFinalizerName = previous })
+	controllerFinalizerName = "finalizers.example.com/team-a"
+	manager := newTestManager(t)
+
+	assert.Equal(t, "finalizers.example.com/team-a", newBaseReconciler(manager, nil, "OciVcn", nil).FinalizerName)
+}
@@ -7,7 +7,9 @@ package authhelper
 
 import (
 	"context"
+	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/common/auth"
@@ -18,44 +20,131 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 )
 
+// AuthMethod names the way the operator authenticates to OCI.
+type AuthMethod string
+
+const (
+	AuthMethodInstancePrincipal AuthMethod = "InstancePrincipal"
+	AuthMethodUserPrincipal     AuthMethod = "UserPrincipal"
+	AuthMethodConfigFile        AuthMethod = "ConfigFile"
+)
+
+// instanceMetadataURL is the OCI instance metadata endpoint, reachable only from OCI compute and OKE nodes.
+const instanceMetadataURL = "http://169.254.169.254/opc/v2/instance/"
+
+const instanceMetadataProbeTimeout = time.Second
+
 type AuthConfigProvider struct {
 	Log loggerutil.OSOKLogger
+	// Method is the auth method of the provider last returned by GetAuthProvider.
+	Method AuthMethod
+
+	// The hooks below default to the real OCI implementations and are replaced in tests.
+	detectOCICompute          func() bool
+	instancePrincipalProvider func() (common.ConfigurationProvider, error)
+	validateUserPrincipal     func(ctx context.Context, provider common.ConfigurationProvider, config configpkg.OsokConfig) bool
+	configFileProvider        func() common.ConfigurationProvider
 }
 
+// GetAuthProvider returns the provider the operator authenticates with. When running on OCI compute
+// or OKE, when instance principals are requested, or when no user principal is configured, instance
+// principals are tried first. If they are unavailable it falls back to the configured user principal
+// and then to the OCI config file. The method that succeeded is recorded in Method.
 func (configProvider *AuthConfigProvider) GetAuthProvider(osokConfig configpkg.OsokConfig) (common.ConfigurationProvider, error) {
-	var providerConfig common.ConfigurationProvider
-	var err error
-	if osokConfig != nil {
-		configProvider.Log.InfoLog("The OSOK config is present, validating config parameters")
-
-		//Check if user principals are present
-		if reflect.DeepEqual(osokConfig.Auth(), configpkg.UserAuthConfig{}) {
-			configProvider.Log.InfoLog("User Principals are not present, switching to Instance principals")
-			providerConfig, err = auth.InstancePrincipalConfigurationProvider()
-			if err != nil {
-				configProvider.Log.InfoLog("Failed to instantiate InstancePrincipals")
-			}
-		} else {
-			configProvider.Log.InfoLog("User principals available, validating user credentials")
-			providerConfig = common.NewRawConfigurationProvider(
-				osokConfig.Auth().Tenancy,
-				osokConfig.Auth().User,
-				osokConfig.Auth().Region,
-				osokConfig.Auth().Fingerprint,
-				osokConfig.Auth().PrivateKey,
-				common.String(osokConfig.Auth().Passphrase))
-
-			//If user principals failed to validate, setup will stop
-			if !configProvider.authValidate(context.Background(), providerConfig, osokConfig) {
-				configProvider.Log.InfoLog("User Principals are not valid. Setup will now terminate")
-				err = errors.New("Failed to instantiate User Principals")
-			}
-		}
-	} else {
+	if osokConfig == nil {
 		configProvider.Log.InfoLog("The OSOK config is not present. Using default Config provider")
-		providerConfig = common.DefaultConfigProvider()
+		configProvider.Method = AuthMethodConfigFile
+		return configProvider.newConfigFileProvider(), nil
+	}
+	configProvider.Log.InfoLog("The OSOK config is present, validating config parameters")
+
+	userPrincipalConfigured := !reflect.DeepEqual(osokConfig.Auth(), configpkg.UserAuthConfig{})
+	var instancePrincipalErr error
+	if !userPrincipalConfigured || osokConfig.UseInstancePrincipals() || configProvider.runningOnOCICompute() {
+		configProvider.Log.InfoLog("Trying Instance principals")
+		providerConfig, err := configProvider.newInstancePrincipalProvider()
+		if err == nil {
+			configProvider.Log.InfoLog("Authenticating with Instance principals")
+			configProvider.Method = AuthMethodInstancePrincipal
+			return providerConfig, nil
+		}
+		instancePrincipalErr = err
+		configProvider.Log.InfoLog("Failed to instantiate InstancePrincipals, falling back", "error", err.Error())
+	}
+
+	if userPrincipalConfigured {
+		configProvider.Log.InfoLog("User principals available, validating user credentials")
+		providerConfig := common.NewRawConfigurationProvider(
+			osokConfig.Auth().Tenancy,
+			osokConfig.Auth().User,
+			osokConfig.Auth().Region,
+			osokConfig.Auth().Fingerprint,
+			osokConfig.Auth().PrivateKey,
+			common.String(osokConfig.Auth().Passphrase))
+		configProvider.Method = AuthMethodUserPrincipal
+
+		//If user principals failed to validate, setup will stop
+		if !configProvider.userPrincipalValid(context.Background(), providerConfig, osokConfig) {
+			configProvider.Log.InfoLog("User Principals are not valid. Setup will now terminate")
+			return providerConfig, errors.New("Failed to instantiate User Principals")
+		}
+		configProvider.Log.InfoLog("Authenticating with User principals")
+		return providerConfig, nil
+	}
+
+	configProvider.Log.InfoLog("User Principals are not present, falling back to the OCI config file")
+	providerConfig := configProvider.newConfigFileProvider()
+	configProvider.Method = AuthMethodConfigFile
+	if _, err := providerConfig.TenancyOCID(); err != nil {
+		return providerConfig, errors.Wrapf(err, "no usable OCI auth method, instance principals failed with %v", instancePrincipalErr)
+	}
+	configProvider.Log.InfoLog("Authenticating with the OCI config file")
+	return providerConfig, nil
+}
+
+func (configProvider *AuthConfigProvider) runningOnOCICompute() bool {
+	if configProvider.detectOCICompute != nil {
+		return configProvider.detectOCICompute()
+	}
+	return instanceMetadataReachable()
+}
+
+func (configProvider *AuthConfigProvider) newInstancePrincipalProvider() (common.ConfigurationProvider, error) {
+	if configProvider.instancePrincipalProvider != nil {
+		return configProvider.instancePrincipalProvider()
+	}
+	return auth.InstancePrincipalConfigurationProvider()
+}
+
+func (configProvider *AuthConfigProvider) userPrincipalValid(ctx context.Context, provider common.ConfigurationProvider, config configpkg.OsokConfig) bool {
+	if configProvider.validateUserPrincipal != nil {
+		return configProvider.validateUserPrincipal(ctx, provider, config)
+	}
+	return configProvider.authValidate(ctx, provider, config)
+}
+
+func (configProvider *AuthConfigProvider) newConfigFileProvider() common.ConfigurationProvider {
+	if configProvider.configFileProvider != nil {
+		return configProvider.configFileProvider()
+	}
+	return common.DefaultConfigProvider()
+}
+
+// instanceMetadataReachable reports whether the OCI instance metadata service answers, which is only
+// the case on OCI compute instances and OKE nodes.
+func instanceMetadataReachable() bool {
+	request, err := http.NewRequest(http.MethodGet, instanceMetadataURL, nil)
+	if err != nil {
+		return false
+	}
+	request.Header.Set("Authorization", "Bearer Oracle")
+	client := http.Client{Timeout: instanceMetadataProbeTimeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return false
 	}
-	return providerConfig, err
+	defer response.Body.Close()
+	return response.StatusCode == http.StatusOK
 }
 
 func (configProvider *AuthConfigProvider) authValidate(ctx context.Context, provider common.ConfigurationProvider, config configpkg.OsokConfig) bool {
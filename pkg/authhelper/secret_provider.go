@@ -0,0 +1,77 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package authhelper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+)
+
+// Keys read from an auth secret. They mirror the environment variables used for the
+// operator-wide user principal configuration.
+const (
+	AuthSecretTenancyKey     = "tenancy"
+	AuthSecretUserKey        = "user"
+	AuthSecretRegionKey      = "region"
+	AuthSecretFingerprintKey = "fingerprint"
+	AuthSecretPrivateKeyKey  = "privatekey"
+	AuthSecretPassphraseKey  = "passphrase"
+)
+
+var requiredAuthSecretKeys = []string{
+	AuthSecretTenancyKey,
+	AuthSecretUserKey,
+	AuthSecretRegionKey,
+	AuthSecretFingerprintKey,
+	AuthSecretPrivateKeyKey,
+}
+
+// ProviderFromSecretData builds a user principal configuration provider from the
+// contents of an auth secret.
+func ProviderFromSecretData(data map[string][]byte) (common.ConfigurationProvider, error) {
+	var missing []string
+	for _, key := range requiredAuthSecretKeys {
+		if strings.TrimSpace(string(data[key])) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("auth secret is missing required keys: %s", strings.Join(missing, ", "))
+	}
+
+	up := UserPrincipal{
+		Tenancy:     string(data[AuthSecretTenancyKey]),
+		UserId:      string(data[AuthSecretUserKey]),
+		Region:      string(data[AuthSecretRegionKey]),
+		Fingerprint: string(data[AuthSecretFingerprintKey]),
+		PrivateKey:  string(data[AuthSecretPrivateKeyKey]),
+		Passphrase:  string(data[AuthSecretPassphraseKey]),
+	}
+	return up.GetAuthProvider(), nil
+}
+
+// ProviderFromSecret reads the named secret and builds a configuration provider from it.
+func ProviderFromSecret(ctx context.Context, credClient credhelper.CredentialClient, secretName, namespace string) (common.ConfigurationProvider, error) {
+	if credClient == nil {
+		return nil, fmt.Errorf("no credential client available to read auth secret %s/%s", namespace, secretName)
+	}
+
+	data, err := credClient.GetSecret(ctx, secretName, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get auth secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	provider, err := ProviderFromSecretData(data)
+	if err != nil {
+		return nil, fmt.Errorf("auth secret %s/%s: %w", namespace, secretName, err)
+	}
+	return provider, nil
+}
@@ -7,8 +7,12 @@ package core
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -28,8 +32,18 @@ import (
 )
 
 const (
-	OSOKFinalizerName  = "finalizers.oci.oracle.com/oci-resources"
-	defaultRequeueTime = time.Minute * 2
+	OSOKFinalizerName   = "finalizers.oci.oracle.com/oci-resources"
+	defaultRequeueTime  = time.Minute * 2
+	conflictRequeueTime = time.Second * 5
+
+	// LastAppliedHashAnnotation stores a hash of the spec that was last reconciled successfully. While it
+	// matches the current spec and the resource is Active, reconciles skip the OCI calls for service
+	// managers that opt in through servicemanager.UnchangedSpecSkipper.
+	LastAppliedHashAnnotation = "osok.oracle.com/last-applied-hash"
+
+	// PausedAnnotation set to "true" stops the reconciler from acting on the resource: no OCI calls are made,
+	// the status is left as it is, and deletion waits with the finalizer in place until it is removed.
+	PausedAnnotation = "osok.oracle.com/paused"
 )
 
 type BaseReconciler struct {
@@ -41,6 +55,16 @@ type BaseReconciler struct {
 	Recorder             record.EventRecorder
 	Scheme               *runtime.Scheme
 	AdditionalFinalizers []string
+	// FinalizerName is the finalizer this reconciler adds to its resources. Empty uses OSOKFinalizerName.
+	// Operators running side by side set distinct names; resources still carrying OSOKFinalizerName are
+	// migrated to FinalizerName on their next reconcile.
+	FinalizerName string
+	// ResyncPeriod requeues a successfully reconciled resource after this long so that changes made
+	// to the OCI resource outside the operator are detected and reconciled back. Zero disables resync.
+	ResyncPeriod time.Duration
+
+	// reconcileCount numbers the reconciles of this reconciler, so each gets a distinct correlation id.
+	reconcileCount atomic.Uint64
 }
 
 func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj client.Object) (result ctrl.Result, err error) {
@@ -49,6 +73,10 @@ func (r *BaseReconciler) Reconcile(ctx context.Context, req ctrl.Request, obj cl
 	if result, stop, err := r.fetchResource(ctx, req, obj); stop {
 		return result, err
 	}
+	ctx = r.withCorrelationID(ctx, obj)
+	if isPaused(obj) {
+		return r.pausedResult(ctx)
+	}
 	if result, stop, err := r.handleDeletion(ctx, req, obj); stop {
 		return result, err
 	}
@@ -75,8 +103,23 @@ func (r *BaseReconciler) fetchResource(ctx context.Context, req ctrl.Request, ob
 	return ctrl.Result{}, false, nil
 }
 
+// isPaused reports whether the resource carries PausedAnnotation set to "true".
+func isPaused(obj client.Object) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// pausedResult skips a paused resource. Removing the annotation does not change the generation, so the
+// resource is requeued to notice when it is resumed.
+func (r *BaseReconciler) pausedResult(ctx context.Context) (ctrl.Result, error) {
+	r.Log.InfoLogWithFixedMessage(ctx, "Reconcile is paused by annotation "+PausedAnnotation)
+	return ctrl.Result{RequeueAfter: defaultRequeueTime}, nil
+}
+
 func (r *BaseReconciler) handleDeletion(ctx context.Context, req ctrl.Request, obj client.Object) (ctrl.Result, bool, error) {
-	if obj.GetDeletionTimestamp() == nil || !controllerutil.ContainsFinalizer(obj, OSOKFinalizerName) {
+	if obj.GetDeletionTimestamp() == nil {
+		return ctrl.Result{}, false, nil
+	}
+	if !controllerutil.ContainsFinalizer(obj, r.finalizerName()) && !controllerutil.ContainsFinalizer(obj, OSOKFinalizerName) {
 		return ctrl.Result{}, false, nil
 	}
 
@@ -93,12 +136,16 @@ func (r *BaseReconciler) handleDeletion(ctx context.Context, req ctrl.Request, o
 }
 
 func (r *BaseReconciler) ensureFinalizers(ctx context.Context, req ctrl.Request, obj client.Object) (ctrl.Result, bool, error) {
-	if err := r.addFinalizer(ctx, obj, strings.Join(r.AdditionalFinalizers, " "), OSOKFinalizerName); err != nil {
+	if err := r.addFinalizer(ctx, obj, strings.Join(r.AdditionalFinalizers, " "), r.finalizerName()); err != nil {
+		if errors.IsConflict(err) {
+			result, requeueErr := r.conflictRequeueResult(ctx, "adding finalizer")
+			return result, true, requeueErr
+		}
 		r.Log.ErrorLogWithFixedMessage(ctx, err, "Error adding finalizer to Custom Resource.")
 		r.Metrics.AddReconcileFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
 			"Error adding finalizer to Custom Resource.", req.Name, req.Namespace)
 		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed", "Failed to add finalizer")
-		result, requeueErr := util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+		result, requeueErr := util.RequeueWithError(ctx, err, util.JitterDuration(defaultRequeueTime), r.Log)
 		return result, true, requeueErr
 	}
 
@@ -111,7 +158,7 @@ func (r *BaseReconciler) deleteFailureResult(ctx context.Context, req ctrl.Reque
 		"Requeuing object due to error during delete of CR", req.Name, req.Namespace)
 	r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
 		fmt.Sprintf("Failed to remove the finalizer: %s", err.Error()))
-	result, requeueErr := util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+	result, requeueErr := util.RequeueWithError(ctx, err, util.JitterDuration(defaultRequeueTime), r.Log)
 	return result, true, requeueErr
 }
 
@@ -120,16 +167,20 @@ func (r *BaseReconciler) deleteRetryResult(ctx context.Context, req ctrl.Request
 	r.Metrics.AddCRDeleteFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
 		"Re-queuing object as delete was unsuccessful", req.Name, req.Namespace)
 	r.Recorder.Event(obj, v1.EventTypeWarning, "Failed", "Failed Delete the resource")
-	result, err := util.RequeueWithoutError(ctx, defaultRequeueTime, r.Log)
+	result, err := util.RequeueWithoutError(ctx, util.JitterDuration(defaultRequeueTime), r.Log)
 	return result, true, err
 }
 
 func (r *BaseReconciler) deleteSuccessResult(ctx context.Context, req ctrl.Request, obj client.Object) (ctrl.Result, bool, error) {
-	if err := r.removeFinalizer(ctx, obj, strings.Join(r.AdditionalFinalizers, " "), OSOKFinalizerName); err != nil {
+	if err := r.removeFinalizer(ctx, obj, strings.Join(r.AdditionalFinalizers, " "), r.finalizerName(), OSOKFinalizerName); err != nil {
+		if errors.IsConflict(err) {
+			result, requeueErr := r.conflictRequeueResult(ctx, "removing finalizer")
+			return result, true, requeueErr
+		}
 		r.Log.ErrorLogWithFixedMessage(ctx, err, "Failed to remove the finalizer")
 		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
 			fmt.Sprintf("Failed to remove the finalizer: %s", err.Error()))
-		result, requeueErr := util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+		result, requeueErr := util.RequeueWithError(ctx, err, util.JitterDuration(defaultRequeueTime), r.Log)
 		return result, true, requeueErr
 	}
 
@@ -157,7 +208,16 @@ func (r *BaseReconciler) GetStatus(obj client.Object) (*v1beta1.OSOKStatus, erro
 
 func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Object, req ctrl.Request) (ctrl.Result, error) {
 	ctx = metrics.AddFixedLogMapEntries(ctx, req.Name, req.Namespace)
+	ctx = r.withCorrelationID(ctx, obj)
 
+	if result, skip := r.unchangedSpecResult(ctx, obj); skip {
+		return result, nil
+	}
+
+	// The status patch below reads the object back from the API server, including any spec change made
+	// since this reconcile started. Hash the spec that is actually applied now, so such a change is not
+	// recorded as applied and is reconciled next time.
+	appliedHash, hashErr := specHash(obj)
 	oldObj := obj.DeepCopyObject().(client.Object)
 	OSOKResponse, err := r.OSOKServiceManager.CreateOrUpdate(ctx, obj, req)
 	if err != nil {
@@ -167,18 +227,46 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
 			fmt.Sprintf("Failed to create or update resource: %s", err.Error()))
 	}
+	applied := OSOKResponse.IsSuccessful && !OSOKResponse.ShouldRequeue
+	if OSOKResponse.IsSuccessful {
+		if status, statusErr := r.OSOKServiceManager.GetCrdStatus(obj); statusErr == nil {
+			// ObservedGeneration tells users and GitOps tools that this generation of the spec was processed.
+			status.ObservedGeneration = obj.GetGeneration()
+			if applied {
+				now := metav1.Now()
+				status.UpdatedAt = &now
+			}
+		}
+	}
 
 	if err := r.Status().Patch(ctx, obj, client.MergeFrom(oldObj)); err != nil {
+		if errors.IsConflict(err) {
+			return r.conflictRequeueResult(ctx, "updating status")
+		}
 		r.Log.ErrorLogWithFixedMessage(ctx, err, "Error updating the status of the Object")
 		r.Metrics.AddReconcileFaultMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
 			"Error updating the status of the CR", req.Name, req.Namespace)
 		r.Recorder.Event(obj, v1.EventTypeWarning, "Failed",
 			fmt.Sprintf("Failed to create or update resource: %s", err.Error()))
-		return util.RequeueWithError(ctx, err, defaultRequeueTime, r.Log)
+		return util.RequeueWithError(ctx, err, util.JitterDuration(defaultRequeueTime), r.Log)
 	}
 	r.Metrics.AddCRCountMetrics(ctx, r.Metrics.ServiceName, "Created an Custom resource "+r.Metrics.ServiceName,
 		req.Name, req.Namespace)
 
+	if applied && r.skipsUnchangedSpec() {
+		err := hashErr
+		if err == nil {
+			err = r.recordAppliedSpecHash(ctx, obj, appliedHash)
+		}
+		if err != nil {
+			if errors.IsConflict(err) {
+				return r.conflictRequeueResult(ctx, "recording applied spec hash")
+			}
+			// The hash only lets later reconciles skip OCI calls, so failing to record it is not fatal.
+			r.Log.ErrorLogWithFixedMessage(ctx, err, "Error recording the applied spec hash")
+		}
+	}
+
 	if OSOKResponse.IsSuccessful {
 		r.Log.InfoLogWithFixedMessage(ctx, "Reconcile Completed")
 		r.Metrics.AddReconcileSuccessMetrics(ctx, obj.GetObjectKind().GroupVersionKind().Kind,
@@ -187,6 +275,9 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 		if OSOKResponse.ShouldRequeue {
 			return r.requeueResult(ctx, OSOKResponse, nil)
 		}
+		if r.ResyncPeriod > 0 {
+			return util.RequeueWithoutError(ctx, r.ResyncPeriod, r.Log)
+		}
 		return util.DoNotRequeue()
 	} else {
 		r.Log.InfoLogWithFixedMessage(ctx, "Reconcile Failed")
@@ -200,11 +291,133 @@ func (r *BaseReconciler) ReconcileResource(ctx context.Context, obj client.Objec
 	}
 }
 
+// withCorrelationID scopes ctx to a correlation id for this reconcile and adds it to the fixed log
+// entries. The id is the resource UID followed by a sequence number shared by all resources of this
+// reconciler, so it is unique per reconcile without keeping state per resource; service managers send it
+// to OCI as the opc-client-request-id header. An id already in ctx is kept.
+func (r *BaseReconciler) withCorrelationID(ctx context.Context, obj client.Object) context.Context {
+	id := servicemanager.CorrelationID(ctx)
+	if id == "" {
+		id = fmt.Sprintf("%s-%d", obj.GetUID(), r.reconcileCount.Add(1))
+	}
+
+	fixedLogMap := map[string]string{"correlationId": id}
+	if existing, ok := ctx.Value(loggerutil.FixedLogMapCtxKey).(map[string]string); ok {
+		for key, value := range existing {
+			fixedLogMap[key] = value
+		}
+	}
+	ctx = context.WithValue(ctx, loggerutil.FixedLogMapCtxKey, fixedLogMap)
+	return servicemanager.WithCorrelationID(ctx, id)
+}
+
+// skipsUnchangedSpec reports whether the service manager opts in to skipping reconciles of an unchanged spec.
+func (r *BaseReconciler) skipsUnchangedSpec() bool {
+	skipper, ok := r.OSOKServiceManager.(servicemanager.UnchangedSpecSkipper)
+	return ok && skipper.SkipUnchangedSpec()
+}
+
+// unchangedSpecResult reports whether the OCI calls can be skipped because the spec has not changed
+// since it was last applied and the resource is Active. Only service managers that opt in are skipped.
+// With a ResyncPeriod set, the skip only holds until a resync is due, so drift is still reconciled on
+// schedule. A pending action reported by the service manager is never skipped.
+func (r *BaseReconciler) unchangedSpecResult(ctx context.Context, obj client.Object) (ctrl.Result, bool) {
+	if !r.skipsUnchangedSpec() {
+		return ctrl.Result{}, false
+	}
+	lastApplied, ok := obj.GetAnnotations()[LastAppliedHashAnnotation]
+	if !ok {
+		return ctrl.Result{}, false
+	}
+	hash, err := specHash(obj)
+	if err != nil || hash != lastApplied {
+		return ctrl.Result{}, false
+	}
+	if reporter, ok := r.OSOKServiceManager.(servicemanager.PendingActionReporter); ok && reporter.HasPendingAction(ctx, obj) {
+		return ctrl.Result{}, false
+	}
+	status, err := r.OSOKServiceManager.GetCrdStatus(obj)
+	if err != nil || !isActive(status) {
+		return ctrl.Result{}, false
+	}
+
+	if r.ResyncPeriod <= 0 {
+		r.Log.InfoLogWithFixedMessage(ctx, "Spec unchanged since last apply, skipping reconcile")
+		result, _ := util.DoNotRequeue()
+		return result, true
+	}
+	if status.UpdatedAt == nil {
+		return ctrl.Result{}, false
+	}
+	remaining := r.ResyncPeriod - time.Since(status.UpdatedAt.Time)
+	if remaining <= 0 {
+		return ctrl.Result{}, false
+	}
+	r.Log.InfoLogWithFixedMessage(ctx, "Spec unchanged since last apply, skipping reconcile until resync")
+	return ctrl.Result{RequeueAfter: remaining}, true
+}
+
+// recordAppliedSpecHash stores the hash of the applied spec in the LastAppliedHashAnnotation.
+func (r *BaseReconciler) recordAppliedSpecHash(ctx context.Context, obj client.Object, hash string) error {
+	if obj.GetAnnotations()[LastAppliedHashAnnotation] == hash {
+		return nil
+	}
+
+	oldObj := obj.DeepCopyObject().(client.Object)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+	return r.Patch(ctx, obj, client.MergeFrom(oldObj))
+}
+
+// specHash returns a hex encoded SHA-256 of the object's spec. Map keys are marshalled in sorted
+// order, so the hash is stable for an unchanged spec.
+func specHash(obj client.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	spec, err := json.Marshal(content["spec"])
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(spec)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isActive reports whether the most recently transitioned condition is a true Active condition.
+func isActive(status *v1beta1.OSOKStatus) bool {
+	var latest *v1beta1.OSOKCondition
+	for i := range status.Conditions {
+		condition := &status.Conditions[i]
+		if condition.LastTransitionTime == nil {
+			continue
+		}
+		if latest == nil || !condition.LastTransitionTime.Before(latest.LastTransitionTime) {
+			latest = condition
+		}
+	}
+	return latest != nil && latest.Type == v1beta1.Active && latest.Status == v1.ConditionTrue
+}
+
+// conflictRequeueResult requeues after the API server rejects a write because the object
+// changed underneath us. Conflicts are expected during rollouts, so they are logged at info
+// level and not counted as reconcile faults.
+func (r *BaseReconciler) conflictRequeueResult(ctx context.Context, operation string) (ctrl.Result, error) {
+	r.Log.InfoLogWithFixedMessage(ctx, "Resource was modified concurrently, requeuing", "operation", operation)
+	return ctrl.Result{RequeueAfter: conflictRequeueTime}, nil
+}
+
 func (r *BaseReconciler) requeueResult(ctx context.Context, response servicemanager.OSOKResponse, err error) (ctrl.Result, error) {
 	duration := response.RequeueDuration
 	if duration <= 0 {
 		duration = defaultRequeueTime
 	}
+	// Resources that fail together would otherwise retry in lockstep.
+	duration = util.JitterDuration(duration)
 
 	if err != nil {
 		return util.RequeueWithError(ctx, err, duration, r.Log)
@@ -240,8 +453,29 @@ func (r *BaseReconciler) DeleteResource(ctx context.Context, obj client.Object,
 	return delSucc, nil
 }
 
+// finalizerName returns the configured finalizer, defaulting to OSOKFinalizerName.
+func (r *BaseReconciler) finalizerName() string {
+	if r.FinalizerName == "" {
+		return OSOKFinalizerName
+	}
+	return r.FinalizerName
+}
+
+// migrateLegacyFinalizer swaps OSOKFinalizerName for the configured finalizer in memory, so the
+// caller's single update replaces it without a window where the resource has neither.
+func (r *BaseReconciler) migrateLegacyFinalizer(ctx context.Context, obj client.Object) bool {
+	name := r.finalizerName()
+	if name == OSOKFinalizerName || !controllerutil.ContainsFinalizer(obj, OSOKFinalizerName) {
+		return false
+	}
+	controllerutil.RemoveFinalizer(obj, OSOKFinalizerName)
+	controllerutil.AddFinalizer(obj, name)
+	r.Log.InfoLogWithFixedMessage(ctx, "Migrating finalizer", "from", OSOKFinalizerName, "to", name)
+	return true
+}
+
 func (r *BaseReconciler) addFinalizer(ctx context.Context, obj client.Object, finalizers ...string) error {
-	needsUpdate := false
+	needsUpdate := r.migrateLegacyFinalizer(ctx, obj)
 	for _, finalizer := range finalizers {
 		if finalizer != "" && !controllerutil.ContainsFinalizer(obj, finalizer) {
 			controllerutil.AddFinalizer(obj, finalizer)
@@ -11,10 +11,22 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/oracle/oci-service-operator/api/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func newTestBaseReconciler() *BaseReconciler {
@@ -23,13 +35,20 @@ func newTestBaseReconciler() *BaseReconciler {
 	}
 }
 
+// assertJittered checks that got is want moved by no more than the requeue jitter.
+func assertJittered(t *testing.T, want, got time.Duration) {
+	t.Helper()
+	assert.GreaterOrEqual(t, got, want*8/10)
+	assert.LessOrEqual(t, got, want*12/10)
+}
+
 func TestRequeueResult_UsesDefaultBackoffWhenDurationMissing(t *testing.T) {
 	reconciler := newTestBaseReconciler()
 
 	result, err := reconciler.requeueResult(context.Background(), servicemanager.OSOKResponse{}, nil)
 	assert.NoError(t, err)
 	assert.False(t, result.Requeue)
-	assert.Equal(t, defaultRequeueTime, result.RequeueAfter)
+	assertJittered(t, defaultRequeueTime, result.RequeueAfter)
 }
 
 func TestRequeueResult_HonorsDurationWithoutError(t *testing.T) {
@@ -41,7 +60,7 @@ func TestRequeueResult_HonorsDurationWithoutError(t *testing.T) {
 	}, nil)
 	assert.NoError(t, err)
 	assert.False(t, result.Requeue)
-	assert.Equal(t, 30*time.Second, result.RequeueAfter)
+	assertJittered(t, 30*time.Second, result.RequeueAfter)
 }
 
 func TestRequeueResult_HonorsDurationWithError(t *testing.T) {
@@ -53,5 +72,495 @@ func TestRequeueResult_HonorsDurationWithError(t *testing.T) {
 	}, errors.New("boom"))
 	assert.NoError(t, err)
 	assert.False(t, result.Requeue)
-	assert.Equal(t, 45*time.Second, result.RequeueAfter)
+	assertJittered(t, 45*time.Second, result.RequeueAfter)
+}
+
+// errorCountingSink is a logr sink that counts Error calls.
+type errorCountingSink struct {
+	errors *int
+}
+
+func (s errorCountingSink) Init(logr.RuntimeInfo)                  {}
+func (s errorCountingSink) Enabled(int) bool                       { return true }
+func (s errorCountingSink) Info(int, string, ...interface{})       {}
+func (s errorCountingSink) Error(error, string, ...interface{})    { *s.errors++ }
+func (s errorCountingSink) WithValues(...interface{}) logr.LogSink { return s }
+func (s errorCountingSink) WithName(string) logr.LogSink           { return s }
+
+type staticServiceManager struct {
+	response servicemanager.OSOKResponse
+	// active marks the resource Active on every CreateOrUpdate.
+	active bool
+	// skipUnchanged opts the manager in to skipping reconciles of an unchanged spec.
+	skipUnchanged bool
+	calls         int
+	deletes       int
+	// correlationIDs records the correlation id of each CreateOrUpdate call.
+	correlationIDs []string
+}
+
+func (m *staticServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, _ ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.calls++
+	m.correlationIDs = append(m.correlationIDs, servicemanager.CorrelationID(ctx))
+	vcn := obj.(*v1beta1.OciVcn)
+	vcn.Status.OsokStatus.Ocid = "ocid1.vcn.oc1..reconciled"
+	if m.active {
+		now := metav1.Now()
+		vcn.Status.OsokStatus.Conditions = []v1beta1.OSOKCondition{
+			{Type: v1beta1.Active, Status: corev1.ConditionTrue, LastTransitionTime: &now},
+		}
+	}
+	return m.response, nil
+}
+
+func (m *staticServiceManager) SkipUnchangedSpec() bool {
+	return m.skipUnchanged
+}
+
+func (m *staticServiceManager) Delete(context.Context, runtime.Object) (bool, error) {
+	m.deletes++
+	return true, nil
+}
+
+func (m *staticServiceManager) GetCrdStatus(obj runtime.Object) (*v1beta1.OSOKStatus, error) {
+	return &obj.(*v1beta1.OciVcn).Status.OsokStatus, nil
+}
+
+func newConflictTestReconciler(t *testing.T, funcs interceptor.Funcs, errorCount *int) (*BaseReconciler, *v1beta1.OciVcn) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1beta1.AddToScheme(scheme))
+
+	vcn := &v1beta1.OciVcn{ObjectMeta: metav1.ObjectMeta{Name: "conflict-vcn", Namespace: "default", UID: "vcn-uid"}}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(vcn).
+		WithStatusSubresource(&v1beta1.OciVcn{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+
+	log := loggerutil.OSOKLogger{Logger: logr.New(errorCountingSink{errors: errorCount})}
+	return &BaseReconciler{
+		Client:             k8sClient,
+		OSOKServiceManager: &staticServiceManager{response: servicemanager.OSOKResponse{IsSuccessful: true}},
+		Log:                log,
+		Metrics:            &metrics.Metrics{ServiceName: "test", Logger: log},
+		Recorder:           record.NewFakeRecorder(20),
+		Scheme:             scheme,
+	}, vcn
+}
+
+func conflictError() error {
+	return apierrors.NewConflict(schema.GroupResource{Group: "oci.oracle.com", Resource: "ocivcns"}, "conflict-vcn",
+		errors.New("the object has been modified"))
+}
+
+func TestReconcile_StatusConflictRequeuesQuietlyThenSucceeds(t *testing.T) {
+	conflicts := 1
+	errorCount := 0
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if conflicts > 0 {
+				conflicts--
+				return conflictError()
+			}
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	}, &errorCount)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+	result, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, conflictRequeueTime, result.RequeueAfter)
+	assert.Zero(t, errorCount, "a conflict must not be logged as an error")
+
+	result, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Zero(t, errorCount)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, v1beta1.OCID("ocid1.vcn.oc1..reconciled"), stored.Status.OsokStatus.Ocid)
+}
+
+func TestReconcile_FinalizerConflictRequeuesQuietly(t *testing.T) {
+	errorCount := 0
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{
+		Update: func(context.Context, client.WithWatch, client.Object, ...client.UpdateOption) error {
+			return conflictError()
+		},
+	}, &errorCount)
+
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, conflictRequeueTime, result.RequeueAfter)
+	assert.Zero(t, errorCount, "a conflict must not be logged as an error")
+}
+
+func TestReconcile_ResyncPeriodRequeuesSuccessfulReconcile(t *testing.T) {
+	errorCount := 0
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{}, &errorCount)
+	reconciler.ResyncPeriod = 10 * time.Minute
+
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, result.RequeueAfter)
+}
+
+func TestReconcile_NoResyncPeriodDoesNotRequeue(t *testing.T) {
+	errorCount := 0
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{}, &errorCount)
+
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+}
+
+func newSpecHashTestReconciler(t *testing.T) (*BaseReconciler, *staticServiceManager, ctrl.Request) {
+	t.Helper()
+	errorCount := 0
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{}, &errorCount)
+	sm := &staticServiceManager{response: servicemanager.OSOKResponse{IsSuccessful: true}, active: true, skipUnchanged: true}
+	reconciler.OSOKServiceManager = sm
+	return reconciler, sm, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+}
+
+func TestReconcile_UnchangedSpecSkipsServiceManager(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sm.calls)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.NotEmpty(t, stored.Annotations[LastAppliedHashAnnotation])
+
+	result, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Equal(t, 1, sm.calls, "an unchanged Active resource must not reach the service manager")
+}
+
+// externalInputServiceManager applies an input read from outside the spec, as a manager reading a watched
+// secret or parent resource does, and does not opt in to skipping unchanged specs.
+type externalInputServiceManager struct {
+	*staticServiceManager
+	input   string
+	applied string
+}
+
+func (m *externalInputServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	m.applied = m.input
+	return m.staticServiceManager.CreateOrUpdate(ctx, obj, req)
+}
+
+func (m *externalInputServiceManager) SkipUnchangedSpec() bool {
+	return false
+}
+
+func TestReconcile_WatchedInputChangeReachesManagerWithoutOptIn(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	manager := &externalInputServiceManager{staticServiceManager: sm, input: "v1"}
+	reconciler.OSOKServiceManager = manager
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", manager.applied)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Empty(t, stored.Annotations[LastAppliedHashAnnotation], "no hash is recorded without the opt-in")
+
+	manager.input = "v2"
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", manager.applied, "a changed input outside the spec must be applied")
+}
+
+func TestReconcile_StaleHashIgnoredWithoutOptIn(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	sm.skipUnchanged = false
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls, "a hash left by an earlier opt-in must not skip the reconcile")
+}
+
+func TestReconcile_ChangedSpecCallsServiceManager(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	stored.Spec.DisplayName = "renamed"
+	assert.NoError(t, reconciler.Update(context.Background(), stored))
+
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls)
+}
+
+// TestReconcile_ConcurrentSpecChangeSurvivesStatusWrite verifies that a spec change made after the
+// reconcile read the resource is neither overwritten by the status write nor recorded as applied.
+func TestReconcile_ConcurrentSpecChangeSurvivesStatusWrite(t *testing.T) {
+	errorCount := 0
+	changed := false
+	reconciler, vcn := newConflictTestReconciler(t, interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+			patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if !changed {
+				changed = true
+				concurrent := &v1beta1.OciVcn{}
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent); err != nil {
+					return err
+				}
+				concurrent.Spec.DisplayName = "applied-concurrently"
+				if err := c.Update(ctx, concurrent); err != nil {
+					return err
+				}
+			}
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	}, &errorCount)
+	sm := &staticServiceManager{response: servicemanager.OSOKResponse{IsSuccessful: true}, active: true, skipUnchanged: true}
+	reconciler.OSOKServiceManager = sm
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, "applied-concurrently", stored.Spec.DisplayName)
+	assert.Equal(t, v1beta1.OCID("ocid1.vcn.oc1..reconciled"), stored.Status.OsokStatus.Ocid)
+
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls, "the concurrent spec change was never applied, so it must not be skipped")
+}
+
+func TestReconcile_PausedSkipsServiceManager(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	stored.Annotations = map[string]string{PausedAnnotation: "true"}
+	assert.NoError(t, reconciler.Update(context.Background(), stored))
+
+	result, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultRequeueTime, result.RequeueAfter)
+	assert.Zero(t, sm.calls)
+
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Empty(t, stored.Finalizers, "a paused resource is not changed")
+	assert.Empty(t, stored.Status.OsokStatus.Ocid)
+
+	delete(stored.Annotations, PausedAnnotation)
+	assert.NoError(t, reconciler.Update(context.Background(), stored))
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sm.calls, "a resumed resource is reconciled")
+}
+
+func TestReconcile_NotActiveDoesNotSkip(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	sm.active = false
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls)
+}
+
+func TestReconcile_ObservedGenerationUpdatedOnlyOnSuccess(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	stored.Generation = 2
+	assert.NoError(t, reconciler.Update(context.Background(), stored))
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, int64(2), stored.Status.OsokStatus.ObservedGeneration)
+
+	stored.Generation = 3
+	stored.Spec.DisplayName = "renamed"
+	assert.NoError(t, reconciler.Update(context.Background(), stored))
+	sm.response = servicemanager.OSOKResponse{IsSuccessful: false}
+
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls)
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, int64(2), stored.Status.OsokStatus.ObservedGeneration, "a failed reconcile must not advance it")
+}
+
+func TestReconcile_CorrelationIDIsUniquePerReconcile(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	sm.active = false
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"vcn-uid-1", "vcn-uid-2"}, sm.correlationIDs)
+}
+
+// pendingActionServiceManager reports a pending action while pending is set.
+type pendingActionServiceManager struct {
+	*staticServiceManager
+	pending bool
+}
+
+func (m *pendingActionServiceManager) HasPendingAction(context.Context, runtime.Object) bool {
+	return m.pending
+}
+
+func TestReconcile_PendingActionDoesNotSkip(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	reporter := &pendingActionServiceManager{staticServiceManager: sm}
+	reconciler.OSOKServiceManager = reporter
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sm.calls)
+
+	reporter.pending = true
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls, "a pending action must reach the service manager with an unchanged spec")
+}
+
+func TestReconcile_ResyncDueInvalidatesSpecHash(t *testing.T) {
+	reconciler, sm, req := newSpecHashTestReconciler(t)
+	reconciler.ResyncPeriod = 10 * time.Minute
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	result, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sm.calls, "the hash holds until the resync is due")
+	assert.Greater(t, result.RequeueAfter, time.Duration(0))
+	assert.LessOrEqual(t, result.RequeueAfter, 10*time.Minute)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	lastApplied := metav1.NewTime(time.Now().Add(-11 * time.Minute))
+	stored.Status.OsokStatus.UpdatedAt = &lastApplied
+	assert.NoError(t, reconciler.Status().Update(context.Background(), stored))
+
+	_, err = reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sm.calls)
+}
+
+func newFinalizerTestReconciler(t *testing.T, vcn *v1beta1.OciVcn, funcs interceptor.Funcs) (*BaseReconciler, *staticServiceManager) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1beta1.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(vcn).
+		WithStatusSubresource(&v1beta1.OciVcn{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+	sm := &staticServiceManager{response: servicemanager.OSOKResponse{IsSuccessful: true}}
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	return &BaseReconciler{
+		Client:             k8sClient,
+		OSOKServiceManager: sm,
+		Log:                log,
+		Metrics:            &metrics.Metrics{ServiceName: "test", Logger: log},
+		Recorder:           record.NewFakeRecorder(20),
+		Scheme:             scheme,
+		FinalizerName:      "finalizers.example.com/team-a",
+	}, sm
+}
+
+func TestReconcile_MigratesLegacyFinalizerInOneUpdate(t *testing.T) {
+	vcn := &v1beta1.OciVcn{ObjectMeta: metav1.ObjectMeta{Name: "legacy-vcn", Namespace: "default",
+		Finalizers: []string{OSOKFinalizerName}}}
+	updates := 0
+	reconciler, _ := newFinalizerTestReconciler(t, vcn, interceptor.Funcs{
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			updates++
+			return c.Update(ctx, obj, opts...)
+		},
+	})
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updates)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, []string{"finalizers.example.com/team-a"}, stored.Finalizers)
+}
+
+func TestReconcile_DefaultFinalizerNameIsUnchanged(t *testing.T) {
+	vcn := &v1beta1.OciVcn{ObjectMeta: metav1.ObjectMeta{Name: "default-vcn", Namespace: "default"}}
+	reconciler, _ := newFinalizerTestReconciler(t, vcn, interceptor.Funcs{})
+	reconciler.FinalizerName = ""
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, []string{OSOKFinalizerName}, stored.Finalizers)
+}
+
+func TestReconcile_PausedDeleteKeepsFinalizer(t *testing.T) {
+	now := metav1.Now()
+	vcn := &v1beta1.OciVcn{ObjectMeta: metav1.ObjectMeta{Name: "deleting-vcn", Namespace: "default",
+		Finalizers: []string{OSOKFinalizerName}, DeletionTimestamp: &now,
+		Annotations: map[string]string{PausedAnnotation: "true"}}}
+	reconciler, sm := newFinalizerTestReconciler(t, vcn, interceptor.Funcs{})
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+	_, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+	assert.NoError(t, err)
+	assert.Zero(t, sm.deletes)
+
+	stored := &v1beta1.OciVcn{}
+	assert.NoError(t, reconciler.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, []string{OSOKFinalizerName}, stored.Finalizers)
+}
+
+func TestReconcile_DeleteHonorsLegacyOrConfiguredFinalizer(t *testing.T) {
+	for _, finalizer := range []string{OSOKFinalizerName, "finalizers.example.com/team-a"} {
+		t.Run(finalizer, func(t *testing.T) {
+			now := metav1.Now()
+			vcn := &v1beta1.OciVcn{ObjectMeta: metav1.ObjectMeta{Name: "deleting-vcn", Namespace: "default",
+				Finalizers: []string{finalizer}, DeletionTimestamp: &now}}
+			reconciler, sm := newFinalizerTestReconciler(t, vcn, interceptor.Funcs{})
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vcn)}
+
+			result, err := reconciler.Reconcile(context.Background(), req, &v1beta1.OciVcn{})
+			assert.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+			assert.Equal(t, 1, sm.deletes)
+
+			stored := &v1beta1.OciVcn{}
+			err = reconciler.Get(context.Background(), req.NamespacedName, stored)
+			assert.True(t, apierrors.IsNotFound(err), "the object is gone once its finalizer is removed")
+		})
+	}
 }
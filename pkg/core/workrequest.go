@@ -0,0 +1,46 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+// WorkRequestClient reads the status of an OCI work request. Each service adapts its own work request
+// API to it, since the SDK models work requests separately per service.
+type WorkRequestClient interface {
+	GetWorkRequestStatus(ctx context.Context, workRequestID string) (string, error)
+}
+
+// WorkRequestStates covers the status vocabulary shared by the OCI work request APIs.
+var WorkRequestStates = servicemanager.LifecycleStates{
+	Ready:      []string{"SUCCEEDED"},
+	InProgress: []string{"ACCEPTED", "IN_PROGRESS", "WAITING", "CANCELING"},
+	Failed:     []string{"FAILED", "CANCELED"},
+}
+
+// PollWorkRequest reads the work request once and records its id and status on status, so users can
+// follow the asynchronous operation. Reconcilers requeue rather than block, so this never waits.
+// A work request that failed or was canceled is returned as servicemanager.LifecycleFailed with an error.
+func PollWorkRequest(ctx context.Context, client WorkRequestClient, status *v1beta1.OSOKStatus,
+	workRequestID string) (servicemanager.LifecycleDecision, error) {
+	state, err := client.GetWorkRequestStatus(ctx, workRequestID)
+	if err != nil {
+		return servicemanager.LifecycleUnknown, err
+	}
+
+	status.WorkRequestId = workRequestID
+	status.WorkRequestState = state
+	decision := WorkRequestStates.Evaluate(state)
+	if decision == servicemanager.LifecycleFailed {
+		return decision, fmt.Errorf("work request %s ended with status %s", workRequestID, state)
+	}
+	return decision, nil
+}
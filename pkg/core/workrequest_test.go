@@ -0,0 +1,81 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubWorkRequestClient returns the queued statuses one per read, repeating the last one.
+type stubWorkRequestClient struct {
+	statuses []string
+	err      error
+	reads    []string
+}
+
+func (s *stubWorkRequestClient) GetWorkRequestStatus(_ context.Context, workRequestID string) (string, error) {
+	s.reads = append(s.reads, workRequestID)
+	if s.err != nil {
+		return "", s.err
+	}
+	status := s.statuses[0]
+	if len(s.statuses) > 1 {
+		s.statuses = s.statuses[1:]
+	}
+	return status, nil
+}
+
+func TestPollWorkRequest_TracksProgressUntilSucceeded(t *testing.T) {
+	client := &stubWorkRequestClient{statuses: []string{"ACCEPTED", "IN_PROGRESS", "SUCCEEDED"}}
+	status := &v1beta1.OSOKStatus{}
+
+	want := []struct {
+		state    string
+		decision servicemanager.LifecycleDecision
+	}{
+		{"ACCEPTED", servicemanager.LifecycleInProgress},
+		{"IN_PROGRESS", servicemanager.LifecycleInProgress},
+		{"SUCCEEDED", servicemanager.LifecycleReady},
+	}
+	for _, step := range want {
+		decision, err := PollWorkRequest(context.Background(), client, status, "ocid1.workrequest.oc1..wr")
+		assert.NoError(t, err)
+		assert.Equal(t, step.decision, decision, "state %s", step.state)
+		assert.Equal(t, "ocid1.workrequest.oc1..wr", status.WorkRequestId)
+		assert.Equal(t, step.state, status.WorkRequestState)
+	}
+	assert.Len(t, client.reads, 3)
+}
+
+func TestPollWorkRequest_FailedAndCanceledReturnError(t *testing.T) {
+	for _, state := range []string{"FAILED", "CANCELED"} {
+		client := &stubWorkRequestClient{statuses: []string{state}}
+		status := &v1beta1.OSOKStatus{}
+
+		decision, err := PollWorkRequest(context.Background(), client, status, "ocid1.workrequest.oc1..wr")
+		assert.Error(t, err, "state %s", state)
+		assert.Contains(t, err.Error(), state)
+		assert.Equal(t, servicemanager.LifecycleFailed, decision)
+		assert.Equal(t, state, status.WorkRequestState, "the terminal state is still recorded")
+	}
+}
+
+func TestPollWorkRequest_ReadErrorLeavesStatusUntouched(t *testing.T) {
+	client := &stubWorkRequestClient{err: errors.New("throttled")}
+	status := &v1beta1.OSOKStatus{WorkRequestId: "ocid1.workrequest.oc1..old", WorkRequestState: "IN_PROGRESS"}
+
+	decision, err := PollWorkRequest(context.Background(), client, status, "ocid1.workrequest.oc1..wr")
+	assert.EqualError(t, err, "throttled")
+	assert.Equal(t, servicemanager.LifecycleUnknown, decision)
+	assert.Equal(t, "ocid1.workrequest.oc1..old", status.WorkRequestId)
+	assert.Equal(t, "IN_PROGRESS", status.WorkRequestState)
+}
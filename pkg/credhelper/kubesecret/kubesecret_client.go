@@ -7,6 +7,7 @@ package kubesecret
 
 import (
 	"context"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	"github.com/oracle/oci-service-operator/pkg/metrics"
 	v1 "k8s.io/api/core/v1"
@@ -43,6 +44,9 @@ func (c *KubeSecretClient) CreateSecret(ctx context.Context, secretName string,
 		},
 		Data: data,
 	}
+	if owner, ok := credhelper.OwnerReference(ctx); ok {
+		newSecret.OwnerReferences = []metav1.OwnerReference{owner}
+	}
 
 	currentSecret := &v1.Secret{}
 	err := c.Client.Get(ctx, types.NamespacedName{Name: newSecret.Name, Namespace: newSecret.Namespace}, currentSecret)
@@ -105,15 +109,18 @@ func (c *KubeSecretClient) GetSecret(ctx context.Context, secretName string, sec
 
 func (c *KubeSecretClient) UpdateSecret(ctx context.Context, secretName string, secretNamespace string, labels map[string]string,
 	updatedData map[string][]byte) (bool, error) {
-	existingSecret := &v1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: secretNamespace,
-			Labels:    labels,
-		},
-		Data: updatedData,
+	existingSecret := &v1.Secret{}
+	err := c.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: secretNamespace}, existingSecret)
+	if err != nil {
+		c.Log.ErrorLog(err, "Failed to get kubernetes secret for update", "Secret Name", secretName, "Secret Namespace", secretNamespace)
+		return false, err
+	}
+	// Update the fetched object so its resourceVersion and ownerReferences are kept.
+	if labels != nil {
+		existingSecret.Labels = labels
 	}
-	err := c.Client.Update(ctx, existingSecret)
+	existingSecret.Data = updatedData
+	err = c.Client.Update(ctx, existingSecret)
 	if err != nil {
 		c.Log.ErrorLog(err, "Failed to update kubernetes secret", "Secret Name", secretName, "Secret Namespace", secretNamespace)
 		return false, err
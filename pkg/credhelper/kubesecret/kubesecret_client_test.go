@@ -12,12 +12,14 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	"github.com/oracle/oci-service-operator/pkg/metrics"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -172,6 +174,33 @@ func TestCreateSecret_Success(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestCreateSecret_SetsOwnerReference(t *testing.T) {
+	k8s := newMockClient()
+	c := newTestClient(k8s)
+	owner := metav1.OwnerReference{
+		APIVersion: "oci.oracle.com/v1beta1",
+		Kind:       "AutonomousDatabases",
+		Name:       "my-adb",
+		UID:        types.UID("adb-uid"),
+	}
+	ctx := credhelper.WithOwnerReference(context.Background(), owner)
+
+	ok, err := c.CreateSecret(ctx, "my-adb-wallet", "default", nil, map[string][]byte{"key": []byte("value")})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []metav1.OwnerReference{owner}, k8s.secrets[secretKey("default", "my-adb-wallet")].OwnerReferences)
+}
+
+func TestCreateSecret_IgnoresOwnerReferenceWithoutUID(t *testing.T) {
+	k8s := newMockClient()
+	c := newTestClient(k8s)
+	ctx := credhelper.WithOwnerReference(context.Background(), metav1.OwnerReference{Kind: "AutonomousDatabases", Name: "my-adb"})
+
+	_, err := c.CreateSecret(ctx, "my-adb-wallet", "default", nil, map[string][]byte{"key": []byte("value")})
+	assert.NoError(t, err)
+	assert.Empty(t, k8s.secrets[secretKey("default", "my-adb-wallet")].OwnerReferences)
+}
+
 func TestCreateSecret_AlreadyExists(t *testing.T) {
 	mock := newMockClient()
 	c := newTestClient(mock)
@@ -257,6 +286,24 @@ func TestUpdateSecret_Success(t *testing.T) {
 	assert.Equal(t, updated, got)
 }
 
+func TestUpdateSecret_KeepsOwnerReferences(t *testing.T) {
+	mock := newMockClient()
+	c := newTestClient(mock)
+	ctx := context.Background()
+
+	owner := metav1.OwnerReference{APIVersion: "oci.oracle.com/v1beta1", Kind: "Redis", Name: "cache", UID: "uid-1"}
+	_, err := c.CreateSecret(credhelper.WithOwnerReference(ctx, owner), "mysecret", "default", nil,
+		map[string][]byte{"a": []byte("1")})
+	assert.NoError(t, err)
+
+	_, err = c.UpdateSecret(ctx, "mysecret", "default", nil, map[string][]byte{"a": []byte("2")})
+	assert.NoError(t, err)
+
+	stored := mock.secrets[secretKey("default", "mysecret")]
+	assert.Equal(t, []metav1.OwnerReference{owner}, stored.OwnerReferences)
+	assert.Equal(t, []byte("2"), stored.Data["a"])
+}
+
 func TestUpdateSecret_Fails(t *testing.T) {
 	mock := newMockClient()
 	mock.updateErr = fmt.Errorf("update failed")
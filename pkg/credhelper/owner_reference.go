@@ -0,0 +1,28 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package credhelper
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ownerReferenceKey struct{}
+
+// WithOwnerReference returns a context under which CreateSecret sets owner as the ownerReference of the
+// new secret, so Kubernetes garbage-collects the secret together with its owner. Clients that do not
+// store Kubernetes secrets ignore it.
+func WithOwnerReference(ctx context.Context, owner metav1.OwnerReference) context.Context {
+	return context.WithValue(ctx, ownerReferenceKey{}, owner)
+}
+
+// OwnerReference returns the owner set with WithOwnerReference. An owner without a UID is ignored,
+// since the API server rejects such a reference.
+func OwnerReference(ctx context.Context) (metav1.OwnerReference, bool) {
+	owner, ok := ctx.Value(ownerReferenceKey{}).(metav1.OwnerReference)
+	return owner, ok && owner.UID != ""
+}
@@ -55,6 +55,21 @@ func OciErrorTypeResponse(err error) (bool, error) {
 	)
 }
 
+// IsLimitExceeded reports whether err is an OCI service error for an exceeded tenancy service limit or
+// compartment quota. OCI returns these as 400 or 429 depending on the service.
+func IsLimitExceeded(err error) bool {
+	serviceErr, ok := asServiceError(err)
+	if !ok {
+		return false
+	}
+	switch serviceErr.GetCode() {
+	case LimitExceeded, QuotaExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
 func asServiceError(err error) (common.ServiceError, bool) {
 	var serviceErr common.ServiceError
 	if !errors.As(err, &serviceErr) {
@@ -191,11 +206,16 @@ func specific412Error(se ocierrors) (bool, error) {
 }
 
 func specific429Error(se ocierrors) (bool, error) {
-	if se.ErrorCode == TooManyRequests {
+	switch se.ErrorCode {
+	case TooManyRequests:
 		return false, TooManyRequestsResponse(se, "You have issued too many requests to the Oracle Cloud "+
 			"Infrastructure APIs in too short of an amount of time")
+	case LimitExceeded:
+		return false, TooManyRequestsResponse(se, "Fulfilling this request exceeds the Oracle-defined "+
+			"limit for this tenancy for this resource type")
+	default:
+		return false, se
 	}
-	return false, se
 }
 
 func specific500Error(se ocierrors) (bool, error) {
@@ -6,6 +6,8 @@
 package errorutil
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -279,6 +281,17 @@ func TestSpecificErrorMappings(t *testing.T) {
 				assert.NotNil(t, err)
 			},
 		},
+		{
+			name:      "limit exceeded 429",
+			code:      LimitExceeded,
+			status:    429,
+			requestID: "req",
+			message:   "msg",
+			check: func(t *testing.T, err error) {
+				tooManyRequests := assertErrorAs[TooManyRequestsOciError](t, err)
+				assert.Contains(t, tooManyRequests.Description, "limit")
+			},
+		},
 		{
 			name:      "unknown 429",
 			code:      "Unknown429",
@@ -371,3 +384,25 @@ func TestSpecificErrorMappings(t *testing.T) {
 		})
 	}
 }
+
+func TestIsLimitExceeded(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "limit exceeded 429", err: fakeServiceError{status: 429, code: LimitExceeded}, want: true},
+		{name: "limit exceeded 400", err: fakeServiceError{status: 400, code: LimitExceeded}, want: true},
+		{name: "quota exceeded", err: fakeServiceError{status: 400, code: QuotaExceeded}, want: true},
+		{name: "wrapped", err: fmt.Errorf("create: %w", fakeServiceError{status: 429, code: LimitExceeded}), want: true},
+		{name: "too many requests", err: fakeServiceError{status: 429, code: TooManyRequests}, want: false},
+		{name: "not a service error", err: errors.New(LimitExceeded), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsLimitExceeded(tc.err))
+		})
+	}
+}
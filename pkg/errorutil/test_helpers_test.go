@@ -29,3 +29,15 @@ func assertNotErrorAs[T error](t *testing.T, err error) {
 	var target T
 	assert.False(t, errors.As(err, &target))
 }
+
+// fakeServiceError implements common.ServiceError for tests.
+type fakeServiceError struct {
+	status int
+	code   string
+}
+
+func (e fakeServiceError) Error() string           { return e.code }
+func (e fakeServiceError) GetHTTPStatusCode() int  { return e.status }
+func (e fakeServiceError) GetMessage() string      { return e.code }
+func (e fakeServiceError) GetCode() string         { return e.code }
+func (e fakeServiceError) GetOpcRequestID() string { return "opc-request-id" }
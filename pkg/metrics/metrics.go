@@ -26,6 +26,7 @@ const (
 	CRCount          = "oci_service_operator_cr_count"
 	SecretCount      = "oci_service_operator_secret_count"
 	CRLatency        = "oci_service_operator_cr_latency"
+	DriftCorrections = "osok_drift_corrections_total"
 )
 
 var (
@@ -68,6 +69,13 @@ var (
 		Name: SecretCount,
 		Help: "Total Number of secret managed by the operators",
 	}, []string{"component", "resourcename", "namespace", "state", "message"})
+
+	// DriftCorrectionsTotal counts the fields a service manager updated in OCI because they no longer
+	// matched the spec, so operators can see how often out-of-band changes are reverted.
+	DriftCorrectionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DriftCorrections,
+		Help: "Total Number of fields updated in OCI to correct drift from the spec",
+	}, []string{"kind", "field"})
 )
 
 type Metrics struct {
@@ -86,6 +94,7 @@ func Init(serviceName string, log loggerutil.OSOKLogger) *Metrics {
 		crDeleteFaultCounter,
 		crDeleteSuccessCounter,
 		secretCounter,
+		DriftCorrectionsTotal,
 	)
 	return &Metrics{
 		Name:        defaultMetricsNamespace,
@@ -142,6 +151,12 @@ func (m *Metrics) AddSecretCountMetrics(ctx context.Context, component string, m
 	secretCounter.WithLabelValues(component, resourceName, namespace, "Success", msg).Inc()
 }
 
+// AddDriftCorrectionMetrics records that the given field of a resource of the given kind was updated to
+// correct drift.
+func AddDriftCorrectionMetrics(kind string, field string) {
+	DriftCorrectionsTotal.WithLabelValues(kind, field).Inc()
+}
+
 func AddFixedLogMapEntries(ctx context.Context, name string, namespace string) context.Context {
 	fixedLogMap := make(map[string]string)
 	fixedLogMap["name"] = name
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -99,6 +100,13 @@ func TestAddSecretCountMetrics_NoPanic(t *testing.T) {
 	})
 }
 
+func TestAddDriftCorrectionMetrics_Increments(t *testing.T) {
+	counter := DriftCorrectionsTotal.WithLabelValues("OciSubnet", "routeTableId")
+	before := testutil.ToFloat64(counter)
+	AddDriftCorrectionMetrics("OciSubnet", "routeTableId")
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
 func TestMetrics_Fields(t *testing.T) {
 	m := testMetrics()
 	assert.Equal(t, defaultMetricsNamespace, m.Name)
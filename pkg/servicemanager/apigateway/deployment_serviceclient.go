@@ -34,7 +34,12 @@ func (c *DeploymentServiceManager) getDeploymentClientOrCreate() (DeploymentClie
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return apigateway.NewDeploymentClientWithConfigurationProvider(c.Provider)
+	client, err := apigateway.NewDeploymentClientWithConfigurationProvider(c.Provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // buildApiSpecification converts CRD route specs into the OCI SDK ApiSpecification type.
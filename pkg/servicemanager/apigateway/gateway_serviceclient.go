@@ -34,7 +34,12 @@ func (c *GatewayServiceManager) getGatewayClientOrCreate() (GatewayClientInterfa
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return apigateway.NewGatewayClientWithConfigurationProvider(c.Provider)
+	client, err := apigateway.NewGatewayClientWithConfigurationProvider(c.Provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // CreateGateway calls the OCI API to create a new API Gateway.
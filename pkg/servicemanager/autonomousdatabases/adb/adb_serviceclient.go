@@ -39,19 +39,29 @@ type DatabaseClientInterface interface {
 }
 
 func getDbClient(provider common.ConfigurationProvider) (database.DatabaseClient, error) {
-	return database.NewDatabaseClientWithConfigurationProvider(provider)
+	client, err := database.NewDatabaseClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
+// newDatabaseClient builds an OCI database client for the given provider.
+var newDatabaseClient = func(provider common.ConfigurationProvider) (DatabaseClientInterface, error) {
+	return getDbClient(provider)
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *AdbServiceManager) getOCIClient() (DatabaseClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *AdbServiceManager) getOCIClient(ctx context.Context) (DatabaseClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getDbClient(c.Provider)
+	return newDatabaseClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
 func (c *AdbServiceManager) CreateAdb(ctx context.Context, adb ociv1beta1.AutonomousDatabases, adminPwd string) (database.CreateAutonomousDatabaseResponse, error) {
-	dbClient, err := c.getOCIClient()
+	dbClient, err := c.getOCIClient(ctx)
 	if err != nil {
 		return database.CreateAutonomousDatabaseResponse{}, err
 	}
@@ -100,7 +110,7 @@ func (c *AdbServiceManager) CreateAdb(ctx context.Context, adb ociv1beta1.Autono
 }
 
 func (c *AdbServiceManager) GetAdbOcid(ctx context.Context, adb ociv1beta1.AutonomousDatabases) (*ociv1beta1.OCID, error) {
-	dbClient, err := c.getOCIClient()
+	dbClient, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +151,7 @@ func (c *AdbServiceManager) DeleteAdb(ctx context.Context, adbId ociv1beta1.OCID
 }
 
 func (c *AdbServiceManager) submitDeleteAdb(ctx context.Context, adbId ociv1beta1.OCID) (*string, error) {
-	dbClient, err := c.getOCIClient()
+	dbClient, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +169,7 @@ func (c *AdbServiceManager) submitDeleteAdb(ctx context.Context, adbId ociv1beta
 
 // Sync the Autonomous Database details
 func (c *AdbServiceManager) GetAdb(ctx context.Context, adbId ociv1beta1.OCID, retryPolicy *common.RetryPolicy) (*database.AutonomousDatabase, error) {
-	dbClient, err := c.getOCIClient()
+	dbClient, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +191,7 @@ func (c *AdbServiceManager) GetAdb(ctx context.Context, adbId ociv1beta1.OCID, r
 }
 
 func (c *AdbServiceManager) UpdateAdb(ctx context.Context, adb *ociv1beta1.AutonomousDatabases) error {
-	dbClient, err := c.getOCIClient()
+	dbClient, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
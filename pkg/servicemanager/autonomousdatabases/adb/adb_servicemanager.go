@@ -50,6 +50,7 @@ func (c *AdbServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Obje
 		c.Log.ErrorLog(err, "Conversion of object failed")
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, autonomousDatabases.Spec.Region)
 
 	adbInstance, response, done, err := c.resolveAdbInstance(ctx, autonomousDatabases, req)
 	if err != nil || done {
@@ -63,6 +64,8 @@ func (c *AdbServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Obje
 
 	if autonomousDatabases.Spec.Wallet.WalletPassword.Secret.SecretName != "" {
 		c.Log.InfoLog(fmt.Sprintf("Wallet Password Secret Name provided for %s Autonomous Database", autonomousDatabases.Spec.DisplayName))
+		// The wallet secret is garbage-collected with the resource; the finalizer still deletes it explicitly.
+		ctx := credhelper.WithOwnerReference(ctx, servicemanager.SecretOwnerReference(autonomousDatabaseKindName, autonomousDatabases))
 		response, err := c.GenerateWallet(ctx, *adbInstance.Id, *adbInstance.DisplayName, autonomousDatabases.Spec.Wallet.WalletPassword.Secret.SecretName,
 			autonomousDatabases.Namespace, autonomousDatabases.Spec.Wallet.WalletName, autonomousDatabases.Name)
 		return servicemanager.OSOKResponse{IsSuccessful: response}, err
@@ -288,6 +291,7 @@ func (c *AdbServiceManager) Delete(ctx context.Context, obj runtime.Object) (boo
 	if err != nil {
 		return false, err
 	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, autonomousDatabases.Spec.Region)
 
 	adbID := resolveDeleteAdbID(autonomousDatabases)
 	if adbID == "" {
@@ -226,6 +226,64 @@ func TestCreateOrUpdate_BindExistingAdb_NothingToUpdate(t *testing.T) {
 	assert.Equal(t, ociv1beta1.OCID(adbId), adb.Status.OsokStatus.Ocid)
 }
 
+// TestCreateOrUpdate_UsesSpecRegion verifies the OCI client is built for Spec.Region instead of
+// the operator's configured region.
+func TestCreateOrUpdate_UsesSpecRegion(t *testing.T) {
+	adbId := "ocid1.autonomousdatabase.oc1.phx.xxx"
+	var usedRegion string
+	restore := ExportSetDatabaseClientFactoryForTest(func(provider common.ConfigurationProvider) (DatabaseClientInterface, error) {
+		usedRegion, _ = provider.Region()
+		return &mockOciDbClient{
+			getFn: func(_ context.Context, _ database.GetAutonomousDatabaseRequest) (database.GetAutonomousDatabaseResponse, error) {
+				return database.GetAutonomousDatabaseResponse{AutonomousDatabase: makeActiveAdb(adbId, "test-adb")}, nil
+			},
+		}, nil
+	})
+	defer restore()
+
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	mgr := NewAdbServiceManager(common.NewRawConfigurationProvider("", "", "us-ashburn-1", "", "", nil),
+		&fakeCredentialClient{}, nil, log)
+
+	adb := &ociv1beta1.AutonomousDatabases{}
+	adb.Spec.AdbId = ociv1beta1.OCID(adbId)
+	adb.Spec.DisplayName = "test-adb"
+	adb.Spec.Region = "us-phoenix-1"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), adb, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, "us-phoenix-1", usedRegion)
+}
+
+// TestCreateOrUpdate_NoSpecRegionUsesDefaultRegion verifies the configured region is used when
+// Spec.Region is empty.
+func TestCreateOrUpdate_NoSpecRegionUsesDefaultRegion(t *testing.T) {
+	adbId := "ocid1.autonomousdatabase.oc1.iad.xxx"
+	var usedRegion string
+	restore := ExportSetDatabaseClientFactoryForTest(func(provider common.ConfigurationProvider) (DatabaseClientInterface, error) {
+		usedRegion, _ = provider.Region()
+		return &mockOciDbClient{
+			getFn: func(_ context.Context, _ database.GetAutonomousDatabaseRequest) (database.GetAutonomousDatabaseResponse, error) {
+				return database.GetAutonomousDatabaseResponse{AutonomousDatabase: makeActiveAdb(adbId, "test-adb")}, nil
+			},
+		}, nil
+	})
+	defer restore()
+
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	mgr := NewAdbServiceManager(common.NewRawConfigurationProvider("", "", "us-ashburn-1", "", "", nil),
+		&fakeCredentialClient{}, nil, log)
+
+	adb := &ociv1beta1.AutonomousDatabases{}
+	adb.Spec.AdbId = ociv1beta1.OCID(adbId)
+	adb.Spec.DisplayName = "test-adb"
+
+	_, err := mgr.CreateOrUpdate(context.Background(), adb, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", usedRegion)
+}
+
 // TestCreateOrUpdate_BindExistingAdb_UpdateNeeded verifies that when the display name
 // differs from the spec, an update is issued.
 func TestCreateOrUpdate_BindExistingAdb_UpdateNeeded(t *testing.T) {
@@ -478,6 +536,49 @@ func TestCreateOrUpdate_WithWallet_AlreadyExists(t *testing.T) {
 	assert.True(t, resp.IsSuccessful)
 }
 
+// TestCreateOrUpdate_WithWallet_TamperedRegenerates verifies that a wallet secret whose content no
+// longer matches its recorded checksum is regenerated rather than treated as existing.
+func TestCreateOrUpdate_WithWallet_TamperedRegenerates(t *testing.T) {
+	adbId := "ocid1.autonomousdatabase.oc1..tampered"
+	callCount := 0
+
+	credClient := &fakeCredentialClient{
+		getSecretFn: func(_ context.Context, _, _ string) (map[string][]byte, error) {
+			callCount++
+			if callCount == 1 {
+				wallet := servicemanager.AddManagedSecretData(map[string][]byte{
+					"tnsnames.ora": []byte("operator-wallet"),
+				}, "AutonomousDatabases", "test-adb")
+				wallet["tnsnames.ora"] = []byte("edited-by-hand")
+				return wallet, nil
+			}
+			// Regeneration reads the wallet password next.
+			return nil, errors.New("wallet password secret not found")
+		},
+	}
+	mgr := newTestManager(credClient)
+
+	mockClient := &mockOciDbClient{
+		getFn: func(_ context.Context, _ database.GetAutonomousDatabaseRequest) (database.GetAutonomousDatabaseResponse, error) {
+			return database.GetAutonomousDatabaseResponse{
+				AutonomousDatabase: makeActiveAdb(adbId, "test-adb"),
+			}, nil
+		},
+	}
+	ExportSetClientForTest(mgr, mockClient)
+
+	adb := &ociv1beta1.AutonomousDatabases{}
+	adb.Name = "test-adb"
+	adb.Namespace = "default"
+	adb.Spec.AdbId = ociv1beta1.OCID(adbId)
+	adb.Spec.DisplayName = "test-adb"
+	adb.Spec.Wallet.WalletPassword.Secret.SecretName = "wallet-secret"
+
+	_, err := mgr.CreateOrUpdate(context.Background(), adb, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Equal(t, 2, callCount, "tampered wallet should be regenerated")
+}
+
 // TestCreateOrUpdate_WithWallet_PasswordSecretError verifies that when the wallet secret
 // does not exist and fetching the wallet password secret fails, the error propagates.
 func TestCreateOrUpdate_WithWallet_PasswordSecretError(t *testing.T) {
@@ -36,7 +36,7 @@ func (c *AdbServiceManager) GenerateWallet(ctx context.Context, adbId string, ad
 		return false, err
 	}
 
-	dbClient, err := getDbClient(c.Provider)
+	dbClient, err := getDbClient(servicemanager.RequestProvider(ctx, c.Provider))
 	if err != nil {
 		return false, err
 	}
@@ -71,6 +71,10 @@ func (c *AdbServiceManager) walletSecretExists(ctx context.Context, walletName s
 	existingSecret, err := c.CredentialClient.GetSecret(ctx, walletName, namespace)
 	if err == nil {
 		if servicemanager.SecretOwnedBy(existingSecret, autonomousDatabaseKindName, adbInstanceName) {
+			if servicemanager.SecretContentTampered(existingSecret) {
+				c.Log.InfoLog("Wallet secret was modified outside the operator. Regenerating wallet.")
+				return false, nil
+			}
 			c.Log.InfoLog("Wallet already exists. Not generating wallet.")
 			return true, nil
 		}
@@ -17,6 +17,14 @@ func ExportSetClientForTest(m *AdbServiceManager, c DatabaseClientInterface) {
 	m.ociClient = c
 }
 
+// ExportSetDatabaseClientFactoryForTest replaces the OCI client constructor used when no client is
+// injected. The returned func restores the previous constructor.
+func ExportSetDatabaseClientFactoryForTest(factory func(common.ConfigurationProvider) (DatabaseClientInterface, error)) func() {
+	previous := newDatabaseClient
+	newDatabaseClient = factory
+	return func() { newDatabaseClient = previous }
+}
+
 // ExportAdbRetryPredicate returns the shouldRetry predicate from getAdbRetryPolicy.
 func ExportAdbRetryPredicate(m *AdbServiceManager) func(common.OCIOperationResponse) bool {
 	return m.getAdbRetryPolicy(1).ShouldRetryOperation
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -28,7 +29,12 @@ type ComputeInstanceClientInterface interface {
 }
 
 func getComputeClient(provider common.ConfigurationProvider) (core.ComputeClient, error) {
-	return core.NewComputeClientWithConfigurationProvider(provider)
+	client, err := core.NewComputeClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
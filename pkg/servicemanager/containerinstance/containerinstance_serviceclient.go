@@ -8,13 +8,16 @@ package containerinstance
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/containerinstances"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -26,10 +29,18 @@ type ContainerInstanceClientInterface interface {
 	ChangeContainerInstanceCompartment(ctx context.Context, request containerinstances.ChangeContainerInstanceCompartmentRequest) (containerinstances.ChangeContainerInstanceCompartmentResponse, error)
 	UpdateContainerInstance(ctx context.Context, request containerinstances.UpdateContainerInstanceRequest) (containerinstances.UpdateContainerInstanceResponse, error)
 	DeleteContainerInstance(ctx context.Context, request containerinstances.DeleteContainerInstanceRequest) (containerinstances.DeleteContainerInstanceResponse, error)
+	GetContainer(ctx context.Context, request containerinstances.GetContainerRequest) (containerinstances.GetContainerResponse, error)
+	StartContainerInstance(ctx context.Context, request containerinstances.StartContainerInstanceRequest) (containerinstances.StartContainerInstanceResponse, error)
+	StopContainerInstance(ctx context.Context, request containerinstances.StopContainerInstanceRequest) (containerinstances.StopContainerInstanceResponse, error)
 }
 
 func getContainerInstanceClient(provider common.ConfigurationProvider) (containerinstances.ContainerInstanceClient, error) {
-	return containerinstances.NewContainerInstanceClientWithConfigurationProvider(provider)
+	client, err := containerinstances.NewContainerInstanceClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -47,6 +58,14 @@ func (c *ContainerInstanceServiceManager) CreateContainerInstance(ctx context.Co
 		return containerinstances.CreateContainerInstanceResponse{}, err
 	}
 
+	pullSecrets, err := c.readImagePullSecretRef(ctx, ci)
+	if err != nil {
+		return containerinstances.CreateContainerInstanceResponse{}, err
+	}
+	if len(pullSecrets) > 0 {
+		ci.Spec.ImagePullSecrets = append(append([]ociv1beta1.ContainerImagePullSecret(nil), ci.Spec.ImagePullSecrets...), pullSecrets...)
+	}
+
 	c.Log.DebugLog("Creating ContainerInstance", "name", ci.Spec.DisplayName)
 
 	return client.CreateContainerInstance(ctx, buildCreateContainerInstanceRequest(ci))
@@ -201,6 +220,20 @@ func applyOptionalCreateDetails(details *containerinstances.CreateContainerInsta
 	if len(ci.Spec.ImagePullSecrets) > 0 {
 		details.ImagePullSecrets = buildImagePullSecrets(ci.Spec.ImagePullSecrets)
 	}
+	if len(ci.Spec.Volumes) > 0 {
+		details.Volumes = buildVolumes(ci.Spec.Volumes)
+	}
+}
+
+func buildVolumes(volumes []ociv1beta1.ContainerVolume) []containerinstances.CreateContainerVolumeDetails {
+	result := make([]containerinstances.CreateContainerVolumeDetails, 0, len(volumes))
+	for _, volume := range volumes {
+		result = append(result, containerinstances.CreateContainerEmptyDirVolumeDetails{
+			Name:         common.String(volume.Name),
+			BackingStore: containerinstances.ContainerEmptyDirVolumeBackingStoreEnum(volume.BackingStore),
+		})
+	}
+	return result
 }
 
 func buildImagePullSecrets(secrets []ociv1beta1.ContainerImagePullSecret) []containerinstances.CreateImagePullSecretDetails {
@@ -293,6 +326,10 @@ func (c *ContainerInstanceServiceManager) UpdateContainerInstance(ctx context.Co
 		return err
 	}
 
+	if changedField := instanceFieldChange(ci, existing); changedField != "" {
+		return fmt.Errorf("%s cannot be updated in place", changedField)
+	}
+
 	if err := moveContainerInstanceCompartmentIfNeeded(ctx, client, ci, existing, targetID); err != nil {
 		return err
 	}
@@ -374,9 +411,6 @@ func validateContainerInstanceUnsupportedChanges(ci *ociv1beta1.ContainerInstanc
 	if err := validateContainerAvailabilityDomain(ci, existing); err != nil {
 		return err
 	}
-	if err := validateContainerShape(ci, existing); err != nil {
-		return err
-	}
 	if err := validateContainerFaultDomain(ci, existing); err != nil {
 		return err
 	}
@@ -393,11 +427,158 @@ func validateContainerAvailabilityDomain(ci *ociv1beta1.ContainerInstance, exist
 	return nil
 }
 
-func validateContainerShape(ci *ociv1beta1.ContainerInstance, existing *containerinstances.ContainerInstance) error {
+// instanceFieldChange reports the first instance-level field OCI cannot update in place that differs
+// from the live instance, or "" when there is none. It only uses the instance already fetched, so it
+// costs no extra OCI calls; container contents are compared by ImmutableFieldChange.
+func instanceFieldChange(ci *ociv1beta1.ContainerInstance, existing *containerinstances.ContainerInstance) string {
 	if ci.Spec.Shape != "" && existing.Shape != nil && *existing.Shape != ci.Spec.Shape {
-		return fmt.Errorf("shape cannot be updated in place")
+		return "shape"
 	}
-	return nil
+	if existing.Volumes != nil && !sameVolumeNames(existing.Volumes, ci.Spec.Volumes) {
+		return "volumes"
+	}
+	if len(existing.Containers) > 0 && len(existing.Containers) != len(ci.Spec.Containers) {
+		return "containers"
+	}
+	return ""
+}
+
+// ImmutableFieldChange reports the first spec field that differs from the live container instance and
+// can only be applied by recreating it, or "" when there is none. It fetches every container, so it is
+// only called when RecreateOnChange is set. Spec containers are matched to live containers by display
+// name; unnamed spec containers take the remaining live containers in order.
+func (c *ContainerInstanceServiceManager) ImmutableFieldChange(ctx context.Context, ci *ociv1beta1.ContainerInstance,
+	existing *containerinstances.ContainerInstance) (string, error) {
+	if changed := instanceFieldChange(ci, existing); changed != "" || len(existing.Containers) == 0 {
+		return changed, nil
+	}
+
+	client, err := c.getOCIClient()
+	if err != nil {
+		return "", err
+	}
+	live := make([]containerinstances.Container, 0, len(existing.Containers))
+	for _, summary := range existing.Containers {
+		resp, err := client.GetContainer(ctx, containerinstances.GetContainerRequest{ContainerId: summary.ContainerId})
+		if err != nil {
+			return "", err
+		}
+		live = append(live, resp.Container)
+	}
+
+	matched := matchLiveContainers(ci.Spec.Containers, live)
+	for i, desired := range ci.Spec.Containers {
+		if matched[i] == nil {
+			return fmt.Sprintf("containers[%d]", i), nil
+		}
+		if field := containerFieldChange(*matched[i], desired); field != "" {
+			return fmt.Sprintf("containers[%d].%s", i, field), nil
+		}
+	}
+	return "", nil
+}
+
+// matchLiveContainers returns, for each spec container, the live container with the same display
+// name. Spec containers without a display name take the unclaimed live containers in the order OCI
+// reports them. An entry is nil when no live container is left to match.
+func matchLiveContainers(desired []ociv1beta1.ContainerDetails, live []containerinstances.Container) []*containerinstances.Container {
+	matched := make([]*containerinstances.Container, len(desired))
+	claimed := make([]bool, len(live))
+	for i, ctr := range desired {
+		name := safeString(ctr.DisplayName)
+		if name == "" {
+			continue
+		}
+		for j := range live {
+			if !claimed[j] && safeString(live[j].DisplayName) == name {
+				matched[i], claimed[j] = &live[j], true
+				break
+			}
+		}
+	}
+	for i, ctr := range desired {
+		if safeString(ctr.DisplayName) != "" {
+			continue
+		}
+		for j := range live {
+			if !claimed[j] {
+				matched[i], claimed[j] = &live[j], true
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// containerFieldChange reports the first container field in the spec that differs from the live
+// container, or "" when they match. Optional fields left unset in the spec are not compared, since
+// OCI fills in its own defaults for them.
+func containerFieldChange(live containerinstances.Container, desired ociv1beta1.ContainerDetails) string {
+	switch {
+	case safeString(live.ImageUrl) != desired.ImageUrl:
+		return "imageUrl"
+	case !slices.Equal(live.Command, desired.Command):
+		return "command"
+	case !slices.Equal(live.Arguments, desired.Arguments):
+		return "arguments"
+	case desired.WorkingDirectory != nil && safeString(live.WorkingDirectory) != *desired.WorkingDirectory:
+		return "workingDirectory"
+	case !maps.Equal(live.EnvironmentVariables, desired.EnvironmentVariables):
+		return "environmentVariables"
+	case !sameResourceConfig(live.ResourceConfig, desired.ResourceConfig):
+		return "resourceConfig"
+	case !sameVolumeMounts(live.VolumeMounts, desired.VolumeMounts):
+		return "volumeMounts"
+	}
+	return ""
+}
+
+// sameResourceConfig compares the limits the spec sets against the live container's limits.
+func sameResourceConfig(live *containerinstances.ContainerResourceConfig, desired *ociv1beta1.ContainerResourceConfig) bool {
+	if desired == nil {
+		return true
+	}
+	if live == nil {
+		live = &containerinstances.ContainerResourceConfig{}
+	}
+	return sameOptionalFloat(live.VcpusLimit, desired.VcpusLimit) &&
+		sameOptionalFloat(live.MemoryLimitInGBs, desired.MemoryLimitInGBs)
+}
+
+func sameOptionalFloat(live, desired *float32) bool {
+	return desired == nil || (live != nil && *live == *desired)
+}
+
+// sameVolumeMounts reports whether the live container mounts the same volumes, in order, as the spec.
+func sameVolumeMounts(live []containerinstances.VolumeMount, desired []ociv1beta1.ContainerVolumeMount) bool {
+	if len(live) != len(desired) {
+		return false
+	}
+	for i, mount := range desired {
+		if safeString(live[i].MountPath) != mount.MountPath || safeString(live[i].VolumeName) != mount.VolumeName {
+			return false
+		}
+		if mount.SubPath != nil && safeString(live[i].SubPath) != *mount.SubPath {
+			return false
+		}
+		if mount.IsReadOnly != nil && (live[i].IsReadOnly == nil || *live[i].IsReadOnly != *mount.IsReadOnly) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameVolumeNames reports whether the live instance declares the same volumes, in order, as the spec.
+func sameVolumeNames(live []containerinstances.ContainerVolume, desired []ociv1beta1.ContainerVolume) bool {
+	if len(live) != len(desired) {
+		return false
+	}
+	for i, volume := range live {
+		if safeString(volume.GetName()) != desired[i].Name {
+			return false
+		}
+	}
+	return true
 }
 
 func validateContainerFaultDomain(ci *ociv1beta1.ContainerInstance, existing *containerinstances.ContainerInstance) error {
@@ -425,6 +606,48 @@ func validateContainerRestartPolicy(ci *ociv1beta1.ContainerInstance, existing *
 	return nil
 }
 
+// GetContainerStates fetches each container of the instance and returns its observed state.
+func (c *ContainerInstanceServiceManager) GetContainerStates(ctx context.Context,
+	instance *containerinstances.ContainerInstance) ([]ociv1beta1.ContainerState, error) {
+	if len(instance.Containers) == 0 {
+		return nil, nil
+	}
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]ociv1beta1.ContainerState, 0, len(instance.Containers))
+	for _, live := range instance.Containers {
+		resp, err := client.GetContainer(ctx, containerinstances.GetContainerRequest{ContainerId: live.ContainerId})
+		if err != nil {
+			return nil, err
+		}
+		name := safeString(resp.DisplayName)
+		if name == "" {
+			name = safeString(live.DisplayName)
+		}
+		states = append(states, ociv1beta1.ContainerState{
+			Name:           name,
+			ContainerId:    ociv1beta1.OCID(safeString(live.ContainerId)),
+			LifecycleState: string(resp.LifecycleState),
+			ExitCode:       resp.ExitCode,
+		})
+	}
+	return states, nil
+}
+
+// containerReadiness summarizes container states as "<active>/<total>".
+func containerReadiness(states []ociv1beta1.ContainerState) string {
+	active := 0
+	for _, state := range states {
+		if state.LifecycleState == string(containerinstances.ContainerLifecycleStateActive) {
+			active++
+		}
+	}
+	return fmt.Sprintf("%d/%d", active, len(states))
+}
+
 // DeleteContainerInstance deletes the container instance for the given OCID.
 func (c *ContainerInstanceServiceManager) DeleteContainerInstance(ctx context.Context, ciId ociv1beta1.OCID) error {
 	client, err := c.getOCIClient()
@@ -440,6 +663,32 @@ func (c *ContainerInstanceServiceManager) DeleteContainerInstance(ctx context.Co
 	return err
 }
 
+// StartContainerInstance starts the INACTIVE container instance for the given OCID.
+func (c *ContainerInstanceServiceManager) StartContainerInstance(ctx context.Context, ciId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.StartContainerInstance(ctx, containerinstances.StartContainerInstanceRequest{
+		ContainerInstanceId: common.String(string(ciId)),
+	})
+	return err
+}
+
+// StopContainerInstance stops the ACTIVE container instance for the given OCID.
+func (c *ContainerInstanceServiceManager) StopContainerInstance(ctx context.Context, ciId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.StopContainerInstance(ctx, containerinstances.StopContainerInstanceRequest{
+		ContainerInstanceId: common.String(string(ciId)),
+	})
+	return err
+}
+
 // ListAllContainerInstances returns all non-DELETED container instances matching
 // the CR's DisplayName, CompartmentId, and AvailabilityDomain, sorted by
 // TimeCreated ascending (oldest first). Returns an empty slice if DisplayName is nil.
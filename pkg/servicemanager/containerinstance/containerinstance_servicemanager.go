@@ -33,6 +33,7 @@ type ContainerInstanceServiceManager struct {
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
 	ociClient        ContainerInstanceClientInterface
+	networkClient    ContainerInstanceNetworkClientInterface
 }
 
 // NewContainerInstanceServiceManager creates a new ContainerInstanceServiceManager.
@@ -54,14 +55,58 @@ func (c *ContainerInstanceServiceManager) CreateOrUpdate(ctx context.Context, ob
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	if err := validateContainerInstanceSpec(&ci.Spec); err != nil {
+		ci.Status.OsokStatus = util.UpdateOSOKStatusCondition(ci.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Invalid ContainerInstance spec")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
 	ciInstance, response, err := c.resolveContainerInstance(ctx, ci)
 	if err != nil || ciInstance == nil {
 		return response, err
 	}
 
+	transition, err := c.reconcileRunState(ctx, ci, ciInstance)
+	if err != nil {
+		ci.Status.OsokStatus = util.UpdateOSOKStatusCondition(ci.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Error while changing the ContainerInstance run state")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if transition != "" {
+		ci.Status.OsokStatus = util.UpdateOSOKStatusCondition(ci.Status.OsokStatus, ociv1beta1.Updating, v1.ConditionTrue, "",
+			fmt.Sprintf("%s ContainerInstance %s", transition, safeString(ciInstance.DisplayName)), c.Log)
+		return servicemanager.OSOKResponse{
+			IsSuccessful:    false,
+			ShouldRequeue:   true,
+			RequeueDuration: containerInstanceRequeueDuration,
+		}, nil
+	}
+
 	return c.finalizeCreateOrUpdate(ctx, ci, ciInstance), nil
 }
 
+// reconcileRunState starts or stops the instance when Spec.State differs from its live state. It returns
+// "Starting" or "Stopping" when it issued a request, or an empty string when the state already matches,
+// the instance is still changing state, or Spec.State is unset.
+func (c *ContainerInstanceServiceManager) reconcileRunState(ctx context.Context, ci *ociv1beta1.ContainerInstance,
+	ciInstance *containerinstances.ContainerInstance) (string, error) {
+	targetID := ociv1beta1.OCID(safeString(ciInstance.Id))
+	switch {
+	case ci.Spec.State == containerInstanceStateRunning &&
+		ciInstance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateInactive:
+		c.Log.InfoLog(fmt.Sprintf("Starting ContainerInstance %s", targetID))
+		return "Starting", c.StartContainerInstance(ctx, targetID)
+	case ci.Spec.State == containerInstanceStateInactive &&
+		ciInstance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateActive:
+		c.Log.InfoLog(fmt.Sprintf("Stopping ContainerInstance %s", targetID))
+		return "Stopping", c.StopContainerInstance(ctx, targetID)
+	default:
+		return "", nil
+	}
+}
+
 func (c *ContainerInstanceServiceManager) resolveContainerInstance(ctx context.Context, ci *ociv1beta1.ContainerInstance) (*containerinstances.ContainerInstance, servicemanager.OSOKResponse, error) {
 	if hasContainerInstanceID(ci) {
 		return c.bindContainerInstance(ctx, ci)
@@ -75,16 +120,52 @@ func (c *ContainerInstanceServiceManager) resolveContainerInstance(ctx context.C
 			}
 			ci.Status.OsokStatus.Ocid = ""
 		} else {
-			if err := c.UpdateContainerInstance(ctx, ci); err != nil {
-				c.Log.ErrorLog(err, "Error while updating ContainerInstance from status OCID")
-				return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
-			}
-			return ciInstance, servicemanager.OSOKResponse{}, nil
+			return c.updateOrRecreateContainerInstance(ctx, ci, ciInstance, "Error while updating ContainerInstance from status OCID")
 		}
 	}
 	return c.lookupOrCreateContainerInstance(ctx, ci)
 }
 
+// updateOrRecreateContainerInstance applies in-place updates to an instance the operator tracks. When
+// RecreateOnChange is set and a field OCI cannot update has changed, the instance is replaced instead.
+func (c *ContainerInstanceServiceManager) updateOrRecreateContainerInstance(ctx context.Context, ci *ociv1beta1.ContainerInstance,
+	ciInstance *containerinstances.ContainerInstance, updateErrMsg string) (*containerinstances.ContainerInstance, servicemanager.OSOKResponse, error) {
+	if ci.Spec.RecreateOnChange {
+		changedField, err := c.ImmutableFieldChange(ctx, ci, ciInstance)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error while comparing ContainerInstance with spec")
+			return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		if changedField != "" {
+			return c.recreateContainerInstance(ctx, ci, ciInstance, changedField)
+		}
+	}
+
+	if err := c.UpdateContainerInstance(ctx, ci); err != nil {
+		c.Log.ErrorLog(err, updateErrMsg)
+		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	return ciInstance, servicemanager.OSOKResponse{}, nil
+}
+
+func (c *ContainerInstanceServiceManager) recreateContainerInstance(ctx context.Context, ci *ociv1beta1.ContainerInstance,
+	ciInstance *containerinstances.ContainerInstance, changedField string) (*containerinstances.ContainerInstance, servicemanager.OSOKResponse, error) {
+	if err := c.validateVnicNetworking(ctx, ci); err != nil {
+		return nil, c.invalidNetworkingResponse(ci, err), err
+	}
+
+	oldID := ociv1beta1.OCID(safeString(ciInstance.Id))
+	c.Log.InfoLog(fmt.Sprintf("ContainerInstance %s changed %s, recreating it", oldID, changedField))
+	if err := c.DeleteContainerInstance(ctx, oldID); err != nil && !isNotFoundServiceError(err) {
+		c.Log.ErrorLog(err, "Error while deleting ContainerInstance for recreate")
+		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	ci.Status.OsokStatus.Ocid = ""
+	ci.Status.OsokStatus.CreatedAt = nil
+	return c.createNewContainerInstance(ctx, ci)
+}
+
 func hasContainerInstanceID(ci *ociv1beta1.ContainerInstance) bool {
 	return strings.TrimSpace(string(ci.Spec.ContainerInstanceId)) != ""
 }
@@ -95,6 +176,9 @@ func (c *ContainerInstanceServiceManager) lookupOrCreateContainerInstance(ctx co
 		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 	if ciOcid == nil {
+		if err := c.validateVnicNetworking(ctx, ci); err != nil {
+			return nil, c.invalidNetworkingResponse(ci, err), err
+		}
 		return c.createNewContainerInstance(ctx, ci)
 	}
 
@@ -105,11 +189,7 @@ func (c *ContainerInstanceServiceManager) lookupOrCreateContainerInstance(ctx co
 		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 	ci.Status.OsokStatus.Ocid = *ciOcid
-	if err := c.UpdateContainerInstance(ctx, ci); err != nil {
-		c.Log.ErrorLog(err, "Error while updating ContainerInstance by resolved OCID")
-		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, err
-	}
-	return ciInstance, servicemanager.OSOKResponse{}, nil
+	return c.updateOrRecreateContainerInstance(ctx, ci, ciInstance, "Error while updating ContainerInstance by resolved OCID")
 }
 
 func (c *ContainerInstanceServiceManager) createNewContainerInstance(ctx context.Context, ci *ociv1beta1.ContainerInstance) (*containerinstances.ContainerInstance, servicemanager.OSOKResponse, error) {
@@ -134,6 +214,14 @@ func (c *ContainerInstanceServiceManager) createNewContainerInstance(ctx context
 	return ciInstance, servicemanager.OSOKResponse{}, nil
 }
 
+// invalidNetworkingResponse marks the resource Failed with the problems validateVnicNetworking found.
+func (c *ContainerInstanceServiceManager) invalidNetworkingResponse(ci *ociv1beta1.ContainerInstance, err error) servicemanager.OSOKResponse {
+	ci.Status.OsokStatus = util.UpdateOSOKStatusCondition(ci.Status.OsokStatus,
+		ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+	c.Log.ErrorLog(err, "Invalid ContainerInstance networking")
+	return servicemanager.OSOKResponse{IsSuccessful: false}
+}
+
 func (c *ContainerInstanceServiceManager) handleCreateError(ctx context.Context, ci *ociv1beta1.ContainerInstance, err error) (servicemanager.OSOKResponse, error) {
 	c.runGarbageCollect(ctx, *ci)
 	ci.Status.OsokStatus = util.UpdateOSOKStatusCondition(ci.Status.OsokStatus,
@@ -165,11 +253,25 @@ func (c *ContainerInstanceServiceManager) bindContainerInstance(ctx context.Cont
 }
 
 func (c *ContainerInstanceServiceManager) finalizeCreateOrUpdate(ctx context.Context, ci *ociv1beta1.ContainerInstance, ciInstance *containerinstances.ContainerInstance) servicemanager.OSOKResponse {
-	response := reconcileLifecycleStatus(&ci.Status.OsokStatus, ciInstance, c.Log)
+	response := reconcileLifecycleStatus(&ci.Status.OsokStatus, ciInstance, ci.Spec.State, c.Log)
+	c.refreshContainerStates(ctx, ci, ciInstance)
 	c.runGarbageCollect(ctx, *ci)
 	return response
 }
 
+// refreshContainerStates records the per-container states in status. Failures keep the previous
+// states, since the instance itself reconciled.
+func (c *ContainerInstanceServiceManager) refreshContainerStates(ctx context.Context, ci *ociv1beta1.ContainerInstance,
+	ciInstance *containerinstances.ContainerInstance) {
+	states, err := c.GetContainerStates(ctx, ciInstance)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while getting ContainerInstance container states (non-fatal)")
+		return
+	}
+	ci.Status.ContainerStates = states
+	ci.Status.Ready = containerReadiness(states)
+}
+
 func (c *ContainerInstanceServiceManager) runGarbageCollect(ctx context.Context, ci ociv1beta1.ContainerInstance) {
 	if err := c.GarbageCollect(ctx, ci); err != nil {
 		c.Log.ErrorLog(err, "ContainerInstance GC failed (non-fatal)")
@@ -189,6 +291,18 @@ func (c *ContainerInstanceServiceManager) Delete(ctx context.Context, obj runtim
 		return true, nil
 	}
 
+	// A delete already in progress is only waited on. Issuing it again while the instance is DELETING
+	// is rejected by OCI, and the finalizer must stay until the instance is DELETED.
+	if existing, err := c.GetContainerInstance(ctx, targetID, nil); err == nil {
+		switch existing.LifecycleState {
+		case containerinstances.ContainerInstanceLifecycleStateDeleted:
+			return true, nil
+		case containerinstances.ContainerInstanceLifecycleStateDeleting:
+			c.Log.InfoLog(fmt.Sprintf("ContainerInstance %s is still DELETING", targetID))
+			return false, nil
+		}
+	}
+
 	c.Log.InfoLog(fmt.Sprintf("Deleting ContainerInstance %s", targetID))
 	if err := c.DeleteContainerInstance(ctx, targetID); err != nil {
 		if isNotFoundServiceError(err) {
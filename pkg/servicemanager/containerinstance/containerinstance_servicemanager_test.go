@@ -14,6 +14,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ocicontainerinstances "github.com/oracle/oci-go-sdk/v65/containerinstances"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	. "github.com/oracle/oci-service-operator/pkg/servicemanager/containerinstance"
@@ -39,6 +40,7 @@ func (f *fakeServiceError) Error() string {
 type fakeCredentialClient struct {
 	createCalled bool
 	deleteCalled bool
+	secrets      map[string]map[string][]byte
 }
 
 func (f *fakeCredentialClient) CreateSecret(ctx context.Context, name, ns string, labels map[string]string, data map[string][]byte) (bool, error) {
@@ -52,7 +54,14 @@ func (f *fakeCredentialClient) DeleteSecret(ctx context.Context, name, ns string
 }
 
 func (f *fakeCredentialClient) GetSecret(ctx context.Context, name, ns string) (map[string][]byte, error) {
-	return nil, nil
+	if f.secrets == nil {
+		return nil, nil
+	}
+	data, ok := f.secrets[ns+"/"+name]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s not found", ns, name)
+	}
+	return data, nil
 }
 
 func (f *fakeCredentialClient) UpdateSecret(ctx context.Context, name, ns string, labels map[string]string, data map[string][]byte) (bool, error) {
@@ -67,8 +76,13 @@ type fakeOciClient struct {
 	changeCompartmentFn func(ctx context.Context, req ocicontainerinstances.ChangeContainerInstanceCompartmentRequest) (ocicontainerinstances.ChangeContainerInstanceCompartmentResponse, error)
 	updateFn            func(ctx context.Context, req ocicontainerinstances.UpdateContainerInstanceRequest) (ocicontainerinstances.UpdateContainerInstanceResponse, error)
 	deleteFn            func(ctx context.Context, req ocicontainerinstances.DeleteContainerInstanceRequest) (ocicontainerinstances.DeleteContainerInstanceResponse, error)
+	getContainerFn      func(ctx context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error)
+	startFn             func(ctx context.Context, req ocicontainerinstances.StartContainerInstanceRequest) (ocicontainerinstances.StartContainerInstanceResponse, error)
+	stopFn              func(ctx context.Context, req ocicontainerinstances.StopContainerInstanceRequest) (ocicontainerinstances.StopContainerInstanceResponse, error)
 	createCalled        bool
 	deleteCalled        bool
+	startCalled         bool
+	stopCalled          bool
 	createRequest       *ocicontainerinstances.CreateContainerInstanceRequest
 }
 
@@ -135,6 +149,59 @@ func (f *fakeOciClient) DeleteContainerInstance(ctx context.Context, req ocicont
 	return ocicontainerinstances.DeleteContainerInstanceResponse{}, nil
 }
 
+func (f *fakeOciClient) GetContainer(ctx context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+	if f.getContainerFn != nil {
+		return f.getContainerFn(ctx, req)
+	}
+	return ocicontainerinstances.GetContainerResponse{
+		Container: ocicontainerinstances.Container{Id: req.ContainerId},
+	}, nil
+}
+
+func (f *fakeOciClient) StartContainerInstance(ctx context.Context, req ocicontainerinstances.StartContainerInstanceRequest) (ocicontainerinstances.StartContainerInstanceResponse, error) {
+	f.startCalled = true
+	if f.startFn != nil {
+		return f.startFn(ctx, req)
+	}
+	return ocicontainerinstances.StartContainerInstanceResponse{}, nil
+}
+
+func (f *fakeOciClient) StopContainerInstance(ctx context.Context, req ocicontainerinstances.StopContainerInstanceRequest) (ocicontainerinstances.StopContainerInstanceResponse, error) {
+	f.stopCalled = true
+	if f.stopFn != nil {
+		return f.stopFn(ctx, req)
+	}
+	return ocicontainerinstances.StopContainerInstanceResponse{}, nil
+}
+
+// fakeNetworkClient implements ContainerInstanceNetworkClientInterface for testing. Subnets and NSGs
+// not in the maps are found in the test compartment and VCN.
+type fakeNetworkClient struct {
+	subnets map[string]ocicore.Subnet
+	nsgs    map[string]ocicore.NetworkSecurityGroup
+}
+
+func (f *fakeNetworkClient) GetSubnet(_ context.Context, req ocicore.GetSubnetRequest) (ocicore.GetSubnetResponse, error) {
+	if subnet, ok := f.subnets[*req.SubnetId]; ok {
+		return ocicore.GetSubnetResponse{Subnet: subnet}, nil
+	}
+	return ocicore.GetSubnetResponse{Subnet: ocicore.Subnet{
+		Id:            req.SubnetId,
+		CompartmentId: common.String("ocid1.compartment.oc1..xxx"),
+		VcnId:         common.String("ocid1.vcn.oc1..xxx"),
+	}}, nil
+}
+
+func (f *fakeNetworkClient) GetNetworkSecurityGroup(_ context.Context, req ocicore.GetNetworkSecurityGroupRequest) (ocicore.GetNetworkSecurityGroupResponse, error) {
+	if nsg, ok := f.nsgs[*req.NetworkSecurityGroupId]; ok {
+		return ocicore.GetNetworkSecurityGroupResponse{NetworkSecurityGroup: nsg}, nil
+	}
+	return ocicore.GetNetworkSecurityGroupResponse{NetworkSecurityGroup: ocicore.NetworkSecurityGroup{
+		Id:    req.NetworkSecurityGroupId,
+		VcnId: common.String("ocid1.vcn.oc1..xxx"),
+	}}, nil
+}
+
 // newTestManager creates a manager with a fake OCI client injected.
 func newTestManager(ociClient *fakeOciClient) *ContainerInstanceServiceManager {
 	credClient := &fakeCredentialClient{}
@@ -143,6 +210,7 @@ func newTestManager(ociClient *fakeOciClient) *ContainerInstanceServiceManager {
 		common.NewRawConfigurationProvider("", "", "", "", "", nil),
 		credClient, nil, log)
 	ExportSetClientForTest(mgr, ociClient)
+	ExportSetNetworkClientForTest(mgr, &fakeNetworkClient{})
 	return mgr
 }
 
@@ -426,6 +494,56 @@ func TestDelete_WithOcid(t *testing.T) {
 	assert.True(t, ociClient.deleteCalled)
 }
 
+// TestDelete_WaitsForDeletedState verifies that the finalizer is kept while the instance is DELETING,
+// that the delete is not issued again, and that deletion completes once OCI reports DELETED.
+func TestDelete_WaitsForDeletedState(t *testing.T) {
+	states := []ocicontainerinstances.ContainerInstanceLifecycleStateEnum{
+		ocicontainerinstances.ContainerInstanceLifecycleStateActive,
+		ocicontainerinstances.ContainerInstanceLifecycleStateDeleting,
+		ocicontainerinstances.ContainerInstanceLifecycleStateDeleting,
+		ocicontainerinstances.ContainerInstanceLifecycleStateDeleted,
+	}
+	deleteCalls := 0
+	ociClient := &fakeOciClient{
+		getFn: func(_ context.Context, req ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+			state := states[0]
+			if len(states) > 1 {
+				states = states[1:]
+			}
+			return ocicontainerinstances.GetContainerInstanceResponse{
+				ContainerInstance: ocicontainerinstances.ContainerInstance{
+					Id:             req.ContainerInstanceId,
+					LifecycleState: state,
+				},
+			}, nil
+		},
+		deleteFn: func(_ context.Context, _ ocicontainerinstances.DeleteContainerInstanceRequest) (ocicontainerinstances.DeleteContainerInstanceResponse, error) {
+			deleteCalls++
+			return ocicontainerinstances.DeleteContainerInstanceResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+
+	ci := &ociv1beta1.ContainerInstance{}
+	ci.Name = "test-ci"
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..del"
+
+	// ACTIVE, so the delete is issued and the instance moves to DELETING.
+	done, err := mgr.Delete(context.Background(), ci)
+	assert.NoError(t, err)
+	assert.False(t, done, "finalizer must stay while the instance is DELETING")
+
+	// Still DELETING, so the delete is not issued again.
+	done, err = mgr.Delete(context.Background(), ci)
+	assert.NoError(t, err)
+	assert.False(t, done, "finalizer must stay while the instance is DELETING")
+
+	done, err = mgr.Delete(context.Background(), ci)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 1, deleteCalls)
+}
+
 // TestDelete_Error verifies Delete propagates errors from the OCI API.
 func TestDelete_Error(t *testing.T) {
 	ociClient := &fakeOciClient{
@@ -551,6 +669,7 @@ func TestCreateContainerInstance_WithVolumeMounts(t *testing.T) {
 	subPath := "data"
 	isReadOnly := true
 	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.Volumes = []ociv1beta1.ContainerVolume{{Name: "my-volume", BackingStore: "MEMORY"}}
 	ci.Spec.Containers[0].VolumeMounts = []ociv1beta1.ContainerVolumeMount{
 		{
 			MountPath:  "/data",
@@ -573,6 +692,170 @@ func TestCreateContainerInstance_WithVolumeMounts(t *testing.T) {
 	assert.Equal(t, "my-volume", *vm.VolumeName)
 	assert.Equal(t, "data", *vm.SubPath)
 	assert.Equal(t, true, *vm.IsReadOnly)
+	if assert.Len(t, req.Volumes, 1) {
+		volume, ok := req.Volumes[0].(ocicontainerinstances.CreateContainerEmptyDirVolumeDetails)
+		assert.True(t, ok)
+		assert.Equal(t, "my-volume", *volume.Name)
+		assert.Equal(t, ocicontainerinstances.ContainerEmptyDirVolumeBackingStoreMemory, volume.BackingStore)
+	}
+}
+
+// TestValidateContainerInstanceSpec covers each spec problem caught before calling OCI.
+func TestValidateContainerInstanceSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(spec *ociv1beta1.ContainerInstanceSpec)
+		wantErr []string
+	}{
+		{
+			name: "valid spec",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Volumes = []ociv1beta1.ContainerVolume{{Name: "data"}}
+				spec.Containers = []ociv1beta1.ContainerDetails{
+					{ImageUrl: "app:1", DisplayName: common.String("app"), EnvironmentVariables: map[string]string{"MODE": "prod"},
+						VolumeMounts: []ociv1beta1.ContainerVolumeMount{{MountPath: "/data", VolumeName: "data"}}},
+					{ImageUrl: "sidecar:1", DisplayName: common.String("sidecar")},
+				}
+			},
+		},
+		{
+			name: "duplicate container names",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Containers = []ociv1beta1.ContainerDetails{
+					{ImageUrl: "app:1", DisplayName: common.String("app")},
+					{ImageUrl: "app:2", DisplayName: common.String("app")},
+				}
+			},
+			wantErr: []string{`containers[1]: duplicate container name "app"`},
+		},
+		{
+			name: "undeclared volume",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Containers[0].VolumeMounts = []ociv1beta1.ContainerVolumeMount{{MountPath: "/cache", VolumeName: "cache"}}
+			},
+			wantErr: []string{`containers[0].volumeMounts[0]: volume "cache" is not declared in volumes`},
+		},
+		{
+			name: "duplicate volume names",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Volumes = []ociv1beta1.ContainerVolume{{Name: "data"}, {Name: "data"}}
+			},
+			wantErr: []string{`volumes[1]: duplicate volume name "data"`},
+		},
+		{
+			name: "empty environment variable name",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Containers[0].EnvironmentVariables = map[string]string{" ": "x", "OK": "y"}
+			},
+			wantErr: []string{"containers[0].environmentVariables: variable names cannot be empty"},
+		},
+		{
+			name: "errors are aggregated",
+			mutate: func(spec *ociv1beta1.ContainerInstanceSpec) {
+				spec.Containers = []ociv1beta1.ContainerDetails{
+					{ImageUrl: "app:1", DisplayName: common.String("app"), EnvironmentVariables: map[string]string{"": "x"}},
+					{ImageUrl: "app:2", DisplayName: common.String("app"),
+						VolumeMounts: []ociv1beta1.ContainerVolumeMount{{MountPath: "/logs", VolumeName: "logs"}}},
+				}
+			},
+			wantErr: []string{
+				"containers[0].environmentVariables: variable names cannot be empty",
+				`containers[1]: duplicate container name "app"`,
+				`containers[1].volumeMounts[0]: volume "logs" is not declared in volumes`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ci := makeContainerInstanceSpec("test-ci")
+			tt.mutate(&ci.Spec)
+
+			err := ExportValidateContainerInstanceSpec(&ci.Spec)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			}
+		})
+	}
+}
+
+// TestCreateOrUpdate_InvalidSpecSkipsOCI verifies that an invalid spec fails before any OCI call.
+func TestCreateOrUpdate_InvalidSpecSkipsOCI(t *testing.T) {
+	var listCalled bool
+	ociClient := &fakeOciClient{
+		listFn: func(_ context.Context, _ ocicontainerinstances.ListContainerInstancesRequest) (ocicontainerinstances.ListContainerInstancesResponse, error) {
+			listCalled = true
+			return ocicontainerinstances.ListContainerInstancesResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.Containers[0].VolumeMounts = []ociv1beta1.ContainerVolumeMount{{MountPath: "/data", VolumeName: "data"}}
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.Error(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.False(t, listCalled)
+	assert.False(t, ociClient.createCalled)
+	conditions := ci.Status.OsokStatus.Conditions
+	if assert.NotEmpty(t, conditions) {
+		assert.Equal(t, ociv1beta1.Failed, conditions[len(conditions)-1].Type)
+	}
+}
+
+// TestCreateOrUpdate_SubnetInWrongCompartmentFails verifies that a VNIC whose subnet is in another
+// compartment, or whose NSG is in another VCN, fails before the create with every problem named.
+func TestCreateOrUpdate_SubnetInWrongCompartmentFails(t *testing.T) {
+	ociClient := &fakeOciClient{}
+	mgr := newTestManager(ociClient)
+	ExportSetNetworkClientForTest(mgr, &fakeNetworkClient{
+		subnets: map[string]ocicore.Subnet{"ocid1.subnet.oc1..other": {
+			Id:            common.String("ocid1.subnet.oc1..other"),
+			CompartmentId: common.String("ocid1.compartment.oc1..other"),
+			VcnId:         common.String("ocid1.vcn.oc1..xxx"),
+		}},
+		nsgs: map[string]ocicore.NetworkSecurityGroup{"ocid1.nsg.oc1..othervcn": {
+			Id:    common.String("ocid1.nsg.oc1..othervcn"),
+			VcnId: common.String("ocid1.vcn.oc1..other"),
+		}},
+	})
+
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.Vnics = []ociv1beta1.ContainerVnicDetails{
+		{SubnetId: "ocid1.subnet.oc1..other", NsgIds: []ociv1beta1.OCID{"ocid1.nsg.oc1..othervcn"}},
+	}
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.False(t, resp.IsSuccessful)
+	assert.ErrorContains(t, err, "vnics[0]: subnet ocid1.subnet.oc1..other is in compartment ocid1.compartment.oc1..other")
+	assert.ErrorContains(t, err, "vnics[0].nsgIds[0]: network security group ocid1.nsg.oc1..othervcn is in VCN ocid1.vcn.oc1..other")
+	assert.False(t, ociClient.createCalled)
+	conditions := ci.Status.OsokStatus.Conditions
+	if assert.NotEmpty(t, conditions) {
+		assert.Equal(t, ociv1beta1.Failed, conditions[len(conditions)-1].Type)
+	}
+}
+
+// TestCreateOrUpdate_ValidVnicNetworkingCreates verifies that a subnet in the instance's compartment with
+// an NSG in the same VCN passes the check and the instance is created.
+func TestCreateOrUpdate_ValidVnicNetworkingCreates(t *testing.T) {
+	ociClient := &fakeOciClient{}
+	mgr := newTestManager(ociClient)
+
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.Vnics[0].NsgIds = []ociv1beta1.OCID{"ocid1.nsg.oc1..xxx"}
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.True(t, ociClient.createCalled)
 }
 
 // TestCreateContainerInstance_WithImagePullSecrets verifies that image pull secret
@@ -604,6 +887,64 @@ func TestCreateContainerInstance_WithImagePullSecrets(t *testing.T) {
 	assert.Equal(t, "mypassword", *secret.Password)
 }
 
+// TestCreateContainerInstance_WithImagePullSecretRef verifies that registry credentials are read from
+// the referenced dockerconfigjson secret and sent after the inline image pull secrets.
+func TestCreateContainerInstance_WithImagePullSecretRef(t *testing.T) {
+	ociClient := &fakeOciClient{}
+	mgr := newTestManager(ociClient)
+	mgr.CredentialClient = &fakeCredentialClient{secrets: map[string]map[string][]byte{
+		"default/registry-creds": {".dockerconfigjson": []byte(`{"auths":{` +
+			`"https://ocir.example.com/v2/":{"auth":"b2N1c2VyOm9jaXBhc3M="},` +
+			`"registry.example.com":{"username":"refuser","password":"refpassword"}}}`)},
+	}}
+
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.ImagePullSecrets = []ociv1beta1.ContainerImagePullSecret{
+		{RegistryEndpoint: "inline.example.com", Username: "inline", Password: "inlinepassword"},
+	}
+	ci.Spec.ImagePullSecretRef.SecretName = "registry-creds"
+
+	_, err := mgr.CreateContainerInstance(context.Background(), *ci)
+	assert.NoError(t, err)
+	if !assert.NotNil(t, ociClient.createRequest) || !assert.Len(t, ociClient.createRequest.ImagePullSecrets, 3) {
+		return
+	}
+	var endpoints, usernames, passwords []string
+	for _, pullSecret := range ociClient.createRequest.ImagePullSecrets {
+		basic, ok := pullSecret.(ocicontainerinstances.CreateBasicImagePullSecretDetails)
+		if !assert.True(t, ok) {
+			return
+		}
+		endpoints = append(endpoints, *basic.RegistryEndpoint)
+		usernames = append(usernames, *basic.Username)
+		passwords = append(passwords, *basic.Password)
+	}
+	assert.Equal(t, []string{"inline.example.com", "ocir.example.com", "registry.example.com"}, endpoints)
+	assert.Equal(t, []string{"inline", "ocuser", "refuser"}, usernames)
+	assert.Equal(t, []string{"inlinepassword", "ocipass", "refpassword"}, passwords)
+	assert.Len(t, ci.Spec.ImagePullSecrets, 1)
+}
+
+// TestCreateOrUpdate_MissingImagePullSecretFails verifies that a missing image pull secret fails the
+// create with an error naming the secret, without calling OCI.
+func TestCreateOrUpdate_MissingImagePullSecretFails(t *testing.T) {
+	ociClient := &fakeOciClient{}
+	mgr := newTestManager(ociClient)
+	mgr.CredentialClient = &fakeCredentialClient{secrets: map[string]map[string][]byte{}}
+
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.ImagePullSecretRef.SecretName = "absent"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.ErrorContains(t, err, "reading image pull secret default/absent")
+	assert.False(t, resp.IsSuccessful)
+	assert.False(t, ociClient.createCalled)
+	conditions := ci.Status.OsokStatus.Conditions
+	if assert.NotEmpty(t, conditions) {
+		assert.Equal(t, ociv1beta1.Failed, conditions[len(conditions)-1].Type)
+	}
+}
+
 // TestGetRetryPolicy_CreatingState verifies the retry policy retries when the container
 // instance is in CREATING state.
 func TestGetRetryPolicy_CreatingState(t *testing.T) {
@@ -829,3 +1170,376 @@ func TestCreateContainerInstance_ContainerList(t *testing.T) {
 	assert.Equal(t, "redis:7", *req.Containers[1].ImageUrl)
 	assert.Equal(t, "cache", *req.Containers[1].DisplayName)
 }
+
+// liveContainerInstanceFn returns a getFn that reports an ACTIVE instance running a single container.
+func liveContainerInstanceFn(shape string) func(context.Context, ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+	return func(_ context.Context, req ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+		return ocicontainerinstances.GetContainerInstanceResponse{
+			ContainerInstance: ocicontainerinstances.ContainerInstance{
+				Id:             req.ContainerInstanceId,
+				DisplayName:    common.String("recreate-ci"),
+				CompartmentId:  common.String("ocid1.compartment.oc1..xxx"),
+				Shape:          common.String(shape),
+				Containers:     []ocicontainerinstances.ContainerInstanceContainer{{ContainerId: common.String("ocid1.container.oc1..c1")}},
+				LifecycleState: ocicontainerinstances.ContainerInstanceLifecycleStateActive,
+			},
+		}, nil
+	}
+}
+
+func containerImageFn(image string) func(context.Context, ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+	return func(_ context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+		return ocicontainerinstances.GetContainerResponse{
+			Container: ocicontainerinstances.Container{Id: req.ContainerId, ImageUrl: common.String(image)},
+		}, nil
+	}
+}
+
+// TestCreateOrUpdate_RecreateOnImageChange verifies that an image change deletes the tracked
+// instance and creates a replacement when RecreateOnChange is set.
+func TestCreateOrUpdate_RecreateOnImageChange(t *testing.T) {
+	var deletedID string
+	ociClient := &fakeOciClient{
+		getFn:          liveContainerInstanceFn("CI.Standard.E4.Flex"),
+		getContainerFn: containerImageFn("busybox:1.36"),
+		deleteFn: func(_ context.Context, req ocicontainerinstances.DeleteContainerInstanceRequest) (ocicontainerinstances.DeleteContainerInstanceResponse, error) {
+			deletedID = *req.ContainerInstanceId
+			return ocicontainerinstances.DeleteContainerInstanceResponse{}, nil
+		},
+		updateFn: func(_ context.Context, _ ocicontainerinstances.UpdateContainerInstanceRequest) (ocicontainerinstances.UpdateContainerInstanceResponse, error) {
+			t.Fatal("update must not be called when the instance is recreated")
+			return ocicontainerinstances.UpdateContainerInstanceResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..old"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, "ocid1.containerinstance.oc1..old", deletedID)
+	assert.True(t, ociClient.createCalled)
+	assert.Equal(t, "busybox:latest", *ociClient.createRequest.Containers[0].ImageUrl)
+	assert.Equal(t, ociv1beta1.OCID("ocid1.containerinstance.oc1..new"), ci.Status.OsokStatus.Ocid)
+}
+
+// TestCreateOrUpdate_RecreateOnShapeChange verifies that a shape change is applied by recreate.
+func TestCreateOrUpdate_RecreateOnShapeChange(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn:          liveContainerInstanceFn("CI.Standard.A1.Flex"),
+		getContainerFn: containerImageFn("busybox:latest"),
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..old"
+
+	_, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, ociClient.deleteCalled)
+	assert.True(t, ociClient.createCalled)
+	assert.Equal(t, "CI.Standard.E4.Flex", *ociClient.createRequest.Shape)
+}
+
+// TestCreateOrUpdate_ShapeChangeWithoutRecreateFails verifies that a shape change is rejected
+// when RecreateOnChange is not set.
+func TestCreateOrUpdate_ShapeChangeWithoutRecreateFails(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: liveContainerInstanceFn("CI.Standard.A1.Flex"),
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..old"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "shape cannot be updated in place")
+	assert.False(t, resp.IsSuccessful)
+	assert.False(t, ociClient.deleteCalled)
+	assert.False(t, ociClient.createCalled)
+}
+
+// TestCreateOrUpdate_WithoutRecreateSkipsContainerCompare verifies that containers are only read
+// for their states when RecreateOnChange is not set.
+func TestCreateOrUpdate_WithoutRecreateSkipsContainerCompare(t *testing.T) {
+	getContainerCalls := 0
+	ociClient := &fakeOciClient{
+		getFn: liveContainerInstanceFn("CI.Standard.E4.Flex"),
+		getContainerFn: func(ctx context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+			getContainerCalls++
+			return containerImageFn("busybox:1.36")(ctx, req)
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..tracked"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, 1, getContainerCalls, "only the container state refresh should read the container")
+	assert.False(t, ociClient.deleteCalled)
+}
+
+func namedLiveContainersFn(containers map[string]ocicontainerinstances.Container) func(context.Context, ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+	return func(_ context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+		return ocicontainerinstances.GetContainerResponse{Container: containers[*req.ContainerId]}, nil
+	}
+}
+
+// TestCreateOrUpdate_RecreateMatchesContainersByName verifies that live containers reported in a
+// different order than the spec are matched by display name and do not trigger a recreate.
+func TestCreateOrUpdate_RecreateMatchesContainersByName(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: func(_ context.Context, req ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+			return ocicontainerinstances.GetContainerInstanceResponse{
+				ContainerInstance: ocicontainerinstances.ContainerInstance{
+					Id:            req.ContainerInstanceId,
+					CompartmentId: common.String("ocid1.compartment.oc1..xxx"),
+					Shape:         common.String("CI.Standard.E4.Flex"),
+					Containers: []ocicontainerinstances.ContainerInstanceContainer{
+						{ContainerId: common.String("ocid1.container.oc1..sidecar")},
+						{ContainerId: common.String("ocid1.container.oc1..app")},
+					},
+					LifecycleState: ocicontainerinstances.ContainerInstanceLifecycleStateActive,
+				},
+			}, nil
+		},
+		getContainerFn: namedLiveContainersFn(map[string]ocicontainerinstances.Container{
+			"ocid1.container.oc1..sidecar": {DisplayName: common.String("sidecar"), ImageUrl: common.String("envoy:1.29")},
+			"ocid1.container.oc1..app":     {DisplayName: common.String("app"), ImageUrl: common.String("busybox:latest")},
+		}),
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Spec.Containers = []ociv1beta1.ContainerDetails{
+		{DisplayName: common.String("app"), ImageUrl: "busybox:latest"},
+		{DisplayName: common.String("sidecar"), ImageUrl: "envoy:1.29"},
+	}
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..tracked"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.False(t, ociClient.deleteCalled)
+	assert.False(t, ociClient.createCalled)
+}
+
+// TestCreateOrUpdate_RecreateOnEnvironmentChange verifies that a container change other than the
+// image also recreates the instance when RecreateOnChange is set.
+func TestCreateOrUpdate_RecreateOnEnvironmentChange(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: liveContainerInstanceFn("CI.Standard.E4.Flex"),
+		getContainerFn: namedLiveContainersFn(map[string]ocicontainerinstances.Container{
+			"ocid1.container.oc1..c1": {
+				ImageUrl:             common.String("busybox:latest"),
+				EnvironmentVariables: map[string]string{"LOG_LEVEL": "info"},
+			},
+		}),
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Spec.Containers[0].EnvironmentVariables = map[string]string{"LOG_LEVEL": "debug"}
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..old"
+
+	_, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, ociClient.deleteCalled)
+	assert.True(t, ociClient.createCalled)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, ociClient.createRequest.Containers[0].EnvironmentVariables)
+}
+
+// TestCreateOrUpdate_RecreateOnChangeWithIDFails verifies that a bound instance cannot ask for
+// recreates, since the operator never deletes an instance it was only bound to.
+func TestCreateOrUpdate_RecreateOnChangeWithIDFails(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: liveContainerInstanceFn("CI.Standard.A1.Flex"),
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Spec.ContainerInstanceId = "ocid1.containerinstance.oc1..bound"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "recreateOnChange cannot be set on an instance bound through id")
+	assert.False(t, resp.IsSuccessful)
+	assert.False(t, ociClient.deleteCalled)
+	assert.False(t, ociClient.createCalled)
+}
+
+// TestCreateOrUpdate_TagOnlyChangeUpdatesInPlace verifies that a tag change is applied in place
+// even when RecreateOnChange is set.
+func TestCreateOrUpdate_TagOnlyChangeUpdatesInPlace(t *testing.T) {
+	var updated ocicontainerinstances.UpdateContainerInstanceRequest
+	ociClient := &fakeOciClient{
+		getFn:          liveContainerInstanceFn("CI.Standard.E4.Flex"),
+		getContainerFn: containerImageFn("busybox:latest"),
+		updateFn: func(_ context.Context, req ocicontainerinstances.UpdateContainerInstanceRequest) (ocicontainerinstances.UpdateContainerInstanceResponse, error) {
+			updated = req
+			return ocicontainerinstances.UpdateContainerInstanceResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.RecreateOnChange = true
+	ci.Spec.FreeFormTags = map[string]string{"team": "platform"}
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..tracked"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.False(t, ociClient.deleteCalled)
+	assert.False(t, ociClient.createCalled)
+	assert.Equal(t, "ocid1.containerinstance.oc1..tracked", *updated.ContainerInstanceId)
+	assert.Equal(t, map[string]string{"team": "platform"}, updated.FreeformTags)
+}
+
+// TestCreateOrUpdate_PopulatesContainerStates verifies that per-container states and the
+// readiness summary are copied into status from GetContainer.
+func TestCreateOrUpdate_PopulatesContainerStates(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: func(_ context.Context, req ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+			return ocicontainerinstances.GetContainerInstanceResponse{
+				ContainerInstance: ocicontainerinstances.ContainerInstance{
+					Id:          req.ContainerInstanceId,
+					DisplayName: common.String("states-ci"),
+					Containers: []ocicontainerinstances.ContainerInstanceContainer{
+						{ContainerId: common.String("ocid1.container.oc1..web")},
+						{ContainerId: common.String("ocid1.container.oc1..job")},
+					},
+					LifecycleState: ocicontainerinstances.ContainerInstanceLifecycleStateActive,
+				},
+			}, nil
+		},
+		getContainerFn: func(_ context.Context, req ocicontainerinstances.GetContainerRequest) (ocicontainerinstances.GetContainerResponse, error) {
+			if *req.ContainerId == "ocid1.container.oc1..web" {
+				return ocicontainerinstances.GetContainerResponse{Container: ocicontainerinstances.Container{
+					Id:             req.ContainerId,
+					DisplayName:    common.String("web"),
+					ImageUrl:       common.String("busybox:latest"),
+					LifecycleState: ocicontainerinstances.ContainerLifecycleStateActive,
+				}}, nil
+			}
+			return ocicontainerinstances.GetContainerResponse{Container: ocicontainerinstances.Container{
+				Id:             req.ContainerId,
+				DisplayName:    common.String("job"),
+				ImageUrl:       common.String("busybox:latest"),
+				LifecycleState: ocicontainerinstances.ContainerLifecycleStateInactive,
+				ExitCode:       common.Int(1),
+			}}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("")
+	ci.Spec.Containers = append(ci.Spec.Containers, ociv1beta1.ContainerDetails{ImageUrl: "busybox:latest"})
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..tracked"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, []ociv1beta1.ContainerState{
+		{Name: "web", ContainerId: "ocid1.container.oc1..web", LifecycleState: "ACTIVE"},
+		{Name: "job", ContainerId: "ocid1.container.oc1..job", LifecycleState: "INACTIVE", ExitCode: common.Int(1)},
+	}, ci.Status.ContainerStates)
+	assert.Equal(t, "1/2", ci.Status.Ready)
+}
+
+// liveStateFn returns a getFn that reports an instance in the given lifecycle state.
+func liveStateFn(state ocicontainerinstances.ContainerInstanceLifecycleStateEnum) func(context.Context, ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+	return func(_ context.Context, req ocicontainerinstances.GetContainerInstanceRequest) (ocicontainerinstances.GetContainerInstanceResponse, error) {
+		return ocicontainerinstances.GetContainerInstanceResponse{
+			ContainerInstance: ocicontainerinstances.ContainerInstance{
+				Id:             req.ContainerInstanceId,
+				DisplayName:    common.String("test-ci"),
+				LifecycleState: state,
+			},
+		}, nil
+	}
+}
+
+// TestCreateOrUpdate_StopsRunningInstance verifies that State INACTIVE stops an ACTIVE instance.
+func TestCreateOrUpdate_StopsRunningInstance(t *testing.T) {
+	var stoppedID string
+	ociClient := &fakeOciClient{
+		getFn: liveStateFn(ocicontainerinstances.ContainerInstanceLifecycleStateActive),
+		stopFn: func(_ context.Context, req ocicontainerinstances.StopContainerInstanceRequest) (ocicontainerinstances.StopContainerInstanceResponse, error) {
+			stoppedID = *req.ContainerInstanceId
+			return ocicontainerinstances.StopContainerInstanceResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.State = "INACTIVE"
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..running"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue)
+	assert.False(t, ociClient.startCalled)
+	assert.Equal(t, "ocid1.containerinstance.oc1..running", stoppedID)
+}
+
+// TestCreateOrUpdate_StartsStoppedInstance verifies that State RUNNING starts an INACTIVE instance.
+func TestCreateOrUpdate_StartsStoppedInstance(t *testing.T) {
+	var startedID string
+	ociClient := &fakeOciClient{
+		getFn: liveStateFn(ocicontainerinstances.ContainerInstanceLifecycleStateInactive),
+		startFn: func(_ context.Context, req ocicontainerinstances.StartContainerInstanceRequest) (ocicontainerinstances.StartContainerInstanceResponse, error) {
+			startedID = *req.ContainerInstanceId
+			return ocicontainerinstances.StartContainerInstanceResponse{}, nil
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.State = "RUNNING"
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..stopped"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue)
+	assert.False(t, ociClient.stopCalled)
+	assert.Equal(t, "ocid1.containerinstance.oc1..stopped", startedID)
+}
+
+// TestCreateOrUpdate_StoppedInstanceMatchingStateIsActive verifies that an INACTIVE instance whose spec
+// asks for INACTIVE is reported as successfully reconciled without a start or stop call.
+func TestCreateOrUpdate_StoppedInstanceMatchingStateIsActive(t *testing.T) {
+	ociClient := &fakeOciClient{getFn: liveStateFn(ocicontainerinstances.ContainerInstanceLifecycleStateInactive)}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.State = "INACTIVE"
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..stopped"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.False(t, ociClient.startCalled)
+	assert.False(t, ociClient.stopCalled)
+}
+
+// TestCreateOrUpdate_StopError verifies that a failed stop call marks the resource as failed.
+func TestCreateOrUpdate_StopError(t *testing.T) {
+	ociClient := &fakeOciClient{
+		getFn: liveStateFn(ocicontainerinstances.ContainerInstanceLifecycleStateActive),
+		stopFn: func(_ context.Context, _ ocicontainerinstances.StopContainerInstanceRequest) (ocicontainerinstances.StopContainerInstanceResponse, error) {
+			return ocicontainerinstances.StopContainerInstanceResponse{}, errors.New("stop failed")
+		},
+	}
+	mgr := newTestManager(ociClient)
+	ci := makeContainerInstanceSpec("test-ci")
+	ci.Spec.State = "INACTIVE"
+	ci.Status.OsokStatus.Ocid = "ocid1.containerinstance.oc1..running"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), ci, ctrl.Request{})
+	assert.Error(t, err)
+	assert.False(t, resp.IsSuccessful)
+	conditions := ci.Status.OsokStatus.Conditions
+	assert.Equal(t, ociv1beta1.Failed, conditions[len(conditions)-1].Type)
+}
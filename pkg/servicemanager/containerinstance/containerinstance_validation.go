@@ -0,0 +1,56 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package containerinstance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+)
+
+// validateContainerInstanceSpec checks the containers and volumes locally and returns every problem it
+// finds at once, so a spec OCI would reject is fixed in one edit instead of one rejected create at a time.
+func validateContainerInstanceSpec(spec *ociv1beta1.ContainerInstanceSpec) error {
+	var errs []error
+
+	if spec.RecreateOnChange && strings.TrimSpace(string(spec.ContainerInstanceId)) != "" {
+		errs = append(errs, errors.New("recreateOnChange cannot be set on an instance bound through id"))
+	}
+
+	volumes := make(map[string]bool, len(spec.Volumes))
+	for i, volume := range spec.Volumes {
+		if volumes[volume.Name] {
+			errs = append(errs, fmt.Errorf("volumes[%d]: duplicate volume name %q", i, volume.Name))
+		}
+		volumes[volume.Name] = true
+	}
+
+	containerNames := make(map[string]bool, len(spec.Containers))
+	for i, ctr := range spec.Containers {
+		if name := safeString(ctr.DisplayName); name != "" {
+			if containerNames[name] {
+				errs = append(errs, fmt.Errorf("containers[%d]: duplicate container name %q", i, name))
+			}
+			containerNames[name] = true
+		}
+		for j, mount := range ctr.VolumeMounts {
+			if !volumes[mount.VolumeName] {
+				errs = append(errs, fmt.Errorf("containers[%d].volumeMounts[%d]: volume %q is not declared in volumes",
+					i, j, mount.VolumeName))
+			}
+		}
+		for key := range ctr.EnvironmentVariables {
+			if strings.TrimSpace(key) == "" {
+				errs = append(errs, fmt.Errorf("containers[%d].environmentVariables: variable names cannot be empty", i))
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -18,6 +18,16 @@ func ExportSetClientForTest(m *ContainerInstanceServiceManager, c ContainerInsta
 	m.ociClient = c
 }
 
+// ExportSetNetworkClientForTest sets the networking client on the service manager for unit testing.
+func ExportSetNetworkClientForTest(m *ContainerInstanceServiceManager, c ContainerInstanceNetworkClientInterface) {
+	m.networkClient = c
+}
+
+// ExportValidateContainerInstanceSpec exports validateContainerInstanceSpec for unit testing.
+func ExportValidateContainerInstanceSpec(spec *ociv1beta1.ContainerInstanceSpec) error {
+	return validateContainerInstanceSpec(spec)
+}
+
 // GetRetryPolicyForTest exports getRetryPolicy for unit testing.
 func GetRetryPolicyForTest(c *ContainerInstanceServiceManager, attempts uint) common.RetryPolicy {
 	return c.getRetryPolicy(attempts)
@@ -0,0 +1,97 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package containerinstance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// dockerConfig is the registry credentials document held in a kubernetes.io/dockerconfigjson secret.
+type dockerConfig struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// readImagePullSecretRef reads registry credentials from Spec.ImagePullSecretRef, one per registry in
+// the secret's .dockerconfigjson. It returns nil when no secret is referenced.
+func (c *ContainerInstanceServiceManager) readImagePullSecretRef(ctx context.Context,
+	ci ociv1beta1.ContainerInstance) ([]ociv1beta1.ContainerImagePullSecret, error) {
+	secretName := ci.Spec.ImagePullSecretRef.SecretName
+	if secretName == "" {
+		return nil, nil
+	}
+
+	c.Log.DebugLog("Getting ContainerInstance image pull credentials from Secret")
+	data, err := c.CredentialClient.GetSecret(ctx, secretName, ci.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("reading image pull secret %s/%s: %w", ci.Namespace, secretName, err)
+	}
+	raw, ok := data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("%s key in image pull secret %s/%s is not found", corev1.DockerConfigJsonKey, ci.Namespace, secretName)
+	}
+	config := dockerConfig{}
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("parsing %s in image pull secret %s/%s: %w", corev1.DockerConfigJsonKey, ci.Namespace, secretName, err)
+	}
+
+	registries := make([]string, 0, len(config.Auths))
+	for registry := range config.Auths {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	secrets := make([]ociv1beta1.ContainerImagePullSecret, 0, len(registries))
+	for _, registry := range registries {
+		username, password, err := dockerConfigCredentials(config.Auths[registry])
+		if err != nil {
+			return nil, fmt.Errorf("registry %s in image pull secret %s/%s: %w", registry, ci.Namespace, secretName, err)
+		}
+		secrets = append(secrets, ociv1beta1.ContainerImagePullSecret{
+			RegistryEndpoint: registryHost(registry),
+			Username:         username,
+			Password:         password,
+		})
+	}
+	return secrets, nil
+}
+
+// dockerConfigCredentials returns the entry's username and password, decoding them from auth when they
+// are not set directly.
+func dockerConfigCredentials(entry dockerConfigEntry) (string, string, error) {
+	if entry.Username != "" || entry.Password != "" {
+		return entry.Username, entry.Password, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding auth: %w", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", fmt.Errorf("auth is not in username:password form")
+	}
+	return username, password, nil
+}
+
+// registryHost strips the scheme and path docker config keys may carry, since OCI expects a hostname.
+func registryHost(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	host, _, _ := strings.Cut(registry, "/")
+	return host
+}
@@ -21,6 +21,12 @@ import (
 
 const containerInstanceRequeueDuration = 30 * time.Second
 
+// Run states accepted in Spec.State.
+const (
+	containerInstanceStateRunning  = "RUNNING"
+	containerInstanceStateInactive = "INACTIVE"
+)
+
 func safeString(s *string) string {
 	if s == nil {
 		return ""
@@ -52,17 +58,19 @@ func isNotFoundServiceError(err error) bool {
 }
 
 func reconcileLifecycleStatus(status *ociv1beta1.OSOKStatus, instance *containerinstances.ContainerInstance,
-	log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
+	desiredState string, log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
 	status.Ocid = ociv1beta1.OCID(safeString(instance.Id))
 
-	switch instance.LifecycleState {
-	case containerinstances.ContainerInstanceLifecycleStateActive:
+	stoppedAsRequested := desiredState == containerInstanceStateInactive &&
+		instance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateInactive
+	switch {
+	case instance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateActive || stoppedAsRequested:
 		setCreatedAtIfUnset(status)
 		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Active, v1.ConditionTrue, "",
 			fmt.Sprintf("ContainerInstance %s is %s", safeString(instance.DisplayName), instance.LifecycleState), log)
 		return servicemanager.OSOKResponse{IsSuccessful: true}
-	case containerinstances.ContainerInstanceLifecycleStateCreating,
-		containerinstances.ContainerInstanceLifecycleStateUpdating:
+	case instance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateCreating,
+		instance.LifecycleState == containerinstances.ContainerInstanceLifecycleStateUpdating:
 		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "",
 			fmt.Sprintf("ContainerInstance %s is %s", safeString(instance.DisplayName), instance.LifecycleState), log)
 		return servicemanager.OSOKResponse{
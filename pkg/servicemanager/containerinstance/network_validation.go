@@ -0,0 +1,82 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package containerinstance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+// ContainerInstanceNetworkClientInterface defines the networking reads used to check the VNICs of a
+// container instance before it is created.
+type ContainerInstanceNetworkClientInterface interface {
+	GetSubnet(ctx context.Context, request core.GetSubnetRequest) (core.GetSubnetResponse, error)
+	GetNetworkSecurityGroup(ctx context.Context, request core.GetNetworkSecurityGroupRequest) (core.GetNetworkSecurityGroupResponse, error)
+}
+
+// getNetworkClient returns the injected networking client if set, otherwise creates one from the provider.
+func (c *ContainerInstanceServiceManager) getNetworkClient() (ContainerInstanceNetworkClientInterface, error) {
+	if c.networkClient != nil {
+		return c.networkClient, nil
+	}
+	client, err := core.NewVirtualNetworkClientWithConfigurationProvider(c.Provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
+// validateVnicNetworking checks that the subnet of each VNIC exists and is in the compartment of the
+// instance, and that each of its NSGs exists and is in the VCN of that subnet. Every problem is returned
+// at once, so a misconfigured VNIC fails before the create instead of in a rejected work request.
+func (c *ContainerInstanceServiceManager) validateVnicNetworking(ctx context.Context, ci *ociv1beta1.ContainerInstance) error {
+	client, err := c.getNetworkClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, vnic := range ci.Spec.Vnics {
+		subnetResp, err := client.GetSubnet(ctx, core.GetSubnetRequest{SubnetId: common.String(string(vnic.SubnetId))})
+		if err != nil {
+			if !isNotFoundServiceError(err) {
+				return err
+			}
+			errs = append(errs, fmt.Errorf("vnics[%d]: subnet %s not found", i, vnic.SubnetId))
+			continue
+		}
+		subnet := subnetResp.Subnet
+		if compartment := safeString(subnet.CompartmentId); compartment != string(ci.Spec.CompartmentId) {
+			errs = append(errs, fmt.Errorf("vnics[%d]: subnet %s is in compartment %s, not in compartment %s of the container instance",
+				i, vnic.SubnetId, compartment, ci.Spec.CompartmentId))
+		}
+
+		for j, nsgID := range vnic.NsgIds {
+			nsgResp, err := client.GetNetworkSecurityGroup(ctx, core.GetNetworkSecurityGroupRequest{
+				NetworkSecurityGroupId: common.String(string(nsgID)),
+			})
+			if err != nil {
+				if !isNotFoundServiceError(err) {
+					return err
+				}
+				errs = append(errs, fmt.Errorf("vnics[%d].nsgIds[%d]: network security group %s not found", i, j, nsgID))
+				continue
+			}
+			if vcn := safeString(nsgResp.VcnId); vcn != safeString(subnet.VcnId) {
+				errs = append(errs, fmt.Errorf("vnics[%d].nsgIds[%d]: network security group %s is in VCN %s, not in VCN %s of subnet %s",
+					i, j, nsgID, vcn, safeString(subnet.VcnId), vnic.SubnetId))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
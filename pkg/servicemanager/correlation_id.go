@@ -0,0 +1,51 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// opcClientRequestIDHeader is the OCI header that carries a caller-chosen request id. OCI records it in
+// the audit log, so every call made for one reconcile can be found by its correlation id.
+const opcClientRequestIDHeader = "opc-client-request-id"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context carrying the correlation id of the current reconcile.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation id stored in ctx, or "" when none has been set.
+func CorrelationID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// CorrelationIDInterceptor is an OCI client request interceptor that sends the correlation id of the
+// request's context as the opc-client-request-id header. Requests without one are left unchanged.
+func CorrelationIDInterceptor(request *http.Request) error {
+	if id := CorrelationID(request.Context()); id != "" {
+		request.Header.Set(opcClientRequestIDHeader, id)
+	}
+	return nil
+}
+
+// TraceRequests installs CorrelationIDInterceptor on a new OCI client, given its embedded BaseClient, so
+// every call the client makes during a reconcile can be found by the reconcile's correlation id.
+func TraceRequests(client *common.BaseClient) {
+	client.Interceptor = CorrelationIDInterceptor
+}
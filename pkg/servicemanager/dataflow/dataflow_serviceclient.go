@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ocidataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -27,7 +28,12 @@ type DataFlowClientInterface interface {
 }
 
 func getDataFlowClient(provider common.ConfigurationProvider) (ocidataflow.DataFlowClient, error) {
-	return ocidataflow.NewDataFlowClientWithConfigurationProvider(provider)
+	client, err := ocidataflow.NewDataFlowClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -33,7 +33,12 @@ type FunctionsManagementClientInterface interface {
 }
 
 func getFunctionsManagementClient(provider common.ConfigurationProvider) (ocifunctions.FunctionsManagementClient, error) {
-	return ocifunctions.NewFunctionsManagementClientWithConfigurationProvider(provider)
+	client, err := ocifunctions.NewFunctionsManagementClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
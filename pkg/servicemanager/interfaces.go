@@ -28,3 +28,18 @@ type OSOKServiceManager interface {
 
 	GetCrdStatus(obj runtime.Object) (*v1beta1.OSOKStatus, error)
 }
+
+// PendingActionReporter is implemented by service managers whose resources can request a one-time OCI
+// action outside the spec, such as through an annotation. BaseReconciler does not skip reconciling a
+// resource with a pending action even when its spec is unchanged.
+type PendingActionReporter interface {
+	HasPendingAction(ctx context.Context, obj runtime.Object) bool
+}
+
+// UnchangedSpecSkipper is implemented by service managers that opt in to BaseReconciler skipping the OCI
+// calls for an Active resource whose spec is unchanged since it was last applied. Opt in only when the spec
+// holds every input of the reconcile; a manager that also reads a referenced secret or watched resource
+// must implement PendingActionReporter to report when that input changes.
+type UnchangedSpecSkipper interface {
+	SkipUnchangedSpec() bool
+}
@@ -53,6 +53,77 @@ func IsSecretNotFoundError(err error) bool {
 	return k8serrors.IsNotFound(err) || IsNotFoundErrorString(err)
 }
 
+// LifecycleDecision is the reconcile outcome implied by an OCI lifecycle state.
+type LifecycleDecision int
+
+const (
+	// LifecycleUnknown means the state is not in any of the service's known sets.
+	LifecycleUnknown LifecycleDecision = iota
+	// LifecycleReady means the resource is usable and the reconcile can succeed.
+	LifecycleReady
+	// LifecycleInProgress means OCI is still working on the resource and the reconcile should requeue.
+	LifecycleInProgress
+	// LifecycleTerminating means the resource is being or has been deleted in OCI.
+	LifecycleTerminating
+	// LifecycleFailed means the resource will not become ready without intervention.
+	LifecycleFailed
+)
+
+func (d LifecycleDecision) String() string {
+	switch d {
+	case LifecycleReady:
+		return "Ready"
+	case LifecycleInProgress:
+		return "InProgress"
+	case LifecycleTerminating:
+		return "Terminating"
+	case LifecycleFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// LifecycleStates groups the OCI lifecycle states a service treats as ready, in progress, terminating
+// and failed.
+type LifecycleStates struct {
+	Ready       []string
+	InProgress  []string
+	Terminating []string
+	Failed      []string
+}
+
+// DefaultLifecycleStates covers the lifecycle vocabulary shared by most OCI services.
+var DefaultLifecycleStates = LifecycleStates{
+	Ready:       []string{"ACTIVE", "AVAILABLE"},
+	InProgress:  []string{"CREATING", "PROVISIONING", "UPDATING", "STARTING"},
+	Terminating: []string{"DELETING", "DELETED", "TERMINATING", "TERMINATED"},
+	Failed:      []string{"FAILED"},
+}
+
+// Evaluate maps an OCI lifecycle state onto a decision using these state sets.
+// Matching ignores case and surrounding whitespace.
+func (s LifecycleStates) Evaluate(state string) LifecycleDecision {
+	normalized := strings.ToUpper(strings.TrimSpace(state))
+	switch {
+	case containsLifecycleState(normalized, s.Ready):
+		return LifecycleReady
+	case containsLifecycleState(normalized, s.InProgress):
+		return LifecycleInProgress
+	case containsLifecycleState(normalized, s.Terminating):
+		return LifecycleTerminating
+	case containsLifecycleState(normalized, s.Failed):
+		return LifecycleFailed
+	default:
+		return LifecycleUnknown
+	}
+}
+
+// EvaluateLifecycle maps an OCI lifecycle state onto a decision using DefaultLifecycleStates.
+func EvaluateLifecycle(state string) LifecycleDecision {
+	return DefaultLifecycleStates.Evaluate(state)
+}
+
 func containsLifecycleState(target string, states []string) bool {
 	for _, state := range states {
 		if state == target {
@@ -64,21 +135,31 @@ func containsLifecycleState(target string, states []string) bool {
 
 func ReconcileLifecycleStatus(status *ociv1beta1.OSOKStatus, kind, displayName, lifecycleState string,
 	ocid ociv1beta1.OCID, log loggerutil.OSOKLogger, activeStates, retryableStates []string) OSOKResponse {
+	states := LifecycleStates{Ready: activeStates, InProgress: retryableStates}
+	return states.ReconcileStatus(status, kind, displayName, lifecycleState, ocid, log)
+}
+
+// ReconcileStatus records the OCID and the condition implied by lifecycleState on status. A ready resource
+// is Active, one in progress is Provisioning and requeued, and one being deleted in OCI is Terminating.
+// Any other state is Failed.
+func (s LifecycleStates) ReconcileStatus(status *ociv1beta1.OSOKStatus, kind, displayName, lifecycleState string,
+	ocid ociv1beta1.OCID, log loggerutil.OSOKLogger) OSOKResponse {
 	status.Ocid = ocid
+	message := fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState)
 
-	switch {
-	case containsLifecycleState(lifecycleState, activeStates):
+	switch s.Evaluate(lifecycleState) {
+	case LifecycleReady:
 		SetCreatedAtIfUnset(status)
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Active, v1.ConditionTrue, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
+		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Active, v1.ConditionTrue, "", message, log)
 		return OSOKResponse{IsSuccessful: true}
-	case containsLifecycleState(lifecycleState, retryableStates):
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
+	case LifecycleInProgress:
+		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "", message, log)
 		return OSOKResponse{IsSuccessful: false, ShouldRequeue: true}
+	case LifecycleTerminating:
+		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Terminating, v1.ConditionTrue, "", message, log)
+		return OSOKResponse{IsSuccessful: false}
 	default:
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Failed, v1.ConditionFalse, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
+		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Failed, v1.ConditionFalse, "", message, log)
 		return OSOKResponse{IsSuccessful: false}
 	}
 }
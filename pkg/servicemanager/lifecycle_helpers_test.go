@@ -0,0 +1,80 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager_test
+
+import (
+	"testing"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestEvaluateLifecycle_MapsEveryKnownState(t *testing.T) {
+	cases := map[string]servicemanager.LifecycleDecision{
+		"ACTIVE":          servicemanager.LifecycleReady,
+		"AVAILABLE":       servicemanager.LifecycleReady,
+		"CREATING":        servicemanager.LifecycleInProgress,
+		"PROVISIONING":    servicemanager.LifecycleInProgress,
+		"UPDATING":        servicemanager.LifecycleInProgress,
+		"STARTING":        servicemanager.LifecycleInProgress,
+		"DELETING":        servicemanager.LifecycleTerminating,
+		"DELETED":         servicemanager.LifecycleTerminating,
+		"TERMINATING":     servicemanager.LifecycleTerminating,
+		"TERMINATED":      servicemanager.LifecycleTerminating,
+		"FAILED":          servicemanager.LifecycleFailed,
+		"INACTIVE":        servicemanager.LifecycleUnknown,
+		"NEEDS_ATTENTION": servicemanager.LifecycleUnknown,
+		"":                servicemanager.LifecycleUnknown,
+	}
+	for state, want := range cases {
+		assert.Equal(t, want, servicemanager.EvaluateLifecycle(state), "state %q", state)
+	}
+}
+
+func TestEvaluateLifecycle_IgnoresCaseAndWhitespace(t *testing.T) {
+	assert.Equal(t, servicemanager.LifecycleReady, servicemanager.EvaluateLifecycle(" active "))
+	assert.Equal(t, servicemanager.LifecycleInProgress, servicemanager.EvaluateLifecycle("Provisioning"))
+}
+
+func TestLifecycleDecision_String(t *testing.T) {
+	assert.Equal(t, "Ready", servicemanager.LifecycleReady.String())
+	assert.Equal(t, "InProgress", servicemanager.LifecycleInProgress.String())
+	assert.Equal(t, "Terminating", servicemanager.LifecycleTerminating.String())
+	assert.Equal(t, "Failed", servicemanager.LifecycleFailed.String())
+	assert.Equal(t, "Unknown", servicemanager.LifecycleUnknown.String())
+}
+
+func TestLifecycleStates_ReconcileStatus_SetsConditionForEachDecision(t *testing.T) {
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	tests := []struct {
+		state         string
+		wantCondition ociv1beta1.OSOKConditionType
+		wantResponse  servicemanager.OSOKResponse
+	}{
+		{"ACTIVE", ociv1beta1.Active, servicemanager.OSOKResponse{IsSuccessful: true}},
+		{"CREATING", ociv1beta1.Provisioning, servicemanager.OSOKResponse{ShouldRequeue: true}},
+		{"DELETED", ociv1beta1.Terminating, servicemanager.OSOKResponse{}},
+		{"FAILED", ociv1beta1.Failed, servicemanager.OSOKResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			status := &ociv1beta1.OSOKStatus{}
+
+			response := servicemanager.DefaultLifecycleStates.ReconcileStatus(status, "Queue", "orders", tt.state,
+				"ocid1.queue.oc1..orders", log)
+			assert.Equal(t, tt.wantResponse, response)
+			assert.Equal(t, ociv1beta1.OCID("ocid1.queue.oc1..orders"), status.Ocid)
+			if assert.Len(t, status.Conditions, 1) {
+				assert.Equal(t, tt.wantCondition, status.Conditions[0].Type)
+				assert.Equal(t, tt.wantCondition != ociv1beta1.Failed, status.Conditions[0].Status == v1.ConditionTrue)
+			}
+		})
+	}
+}
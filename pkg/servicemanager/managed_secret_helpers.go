@@ -7,11 +7,16 @@ package servicemanager
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"reflect"
+	"sort"
 
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -23,6 +28,7 @@ const (
 	managedSecretDataKey   = "_osok_managed"
 	managedSecretOwnerKind = "_osok_owner_kind"
 	managedSecretOwnerName = "_osok_owner_name"
+	managedSecretChecksum  = "_osok_checksum"
 )
 
 func ManagedSecretLabels(ownerKind, ownerName string) map[string]string {
@@ -34,7 +40,7 @@ func ManagedSecretLabels(ownerKind, ownerName string) map[string]string {
 }
 
 func AddManagedSecretData(data map[string][]byte, ownerKind, ownerName string) map[string][]byte {
-	managed := make(map[string][]byte, len(data)+3)
+	managed := make(map[string][]byte, len(data)+4)
 	for key, value := range data {
 		copyValue := make([]byte, len(value))
 		copy(copyValue, value)
@@ -43,6 +49,7 @@ func AddManagedSecretData(data map[string][]byte, ownerKind, ownerName string) m
 	managed[managedSecretDataKey] = []byte(ManagedSecretLabelValue)
 	managed[managedSecretOwnerKind] = []byte(ownerKind)
 	managed[managedSecretOwnerName] = []byte(ownerName)
+	managed[managedSecretChecksum] = []byte(secretContentChecksum(data))
 	return managed
 }
 
@@ -56,7 +63,7 @@ func stripManagedSecretData(data map[string][]byte) map[string][]byte {
 	stripped := make(map[string][]byte, len(data))
 	for key, value := range data {
 		switch key {
-		case managedSecretDataKey, managedSecretOwnerKind, managedSecretOwnerName:
+		case managedSecretDataKey, managedSecretOwnerKind, managedSecretOwnerName, managedSecretChecksum:
 			continue
 		default:
 			copyValue := make([]byte, len(value))
@@ -67,10 +74,48 @@ func stripManagedSecretData(data map[string][]byte) map[string][]byte {
 	return stripped
 }
 
+// secretContentChecksum returns a SHA-256 of the secret content, taken over the keys in sorted order so
+// the result does not depend on map iteration.
+func secretContentChecksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(hash, "%d:%s%d:", len(key), key, len(data[key]))
+		hash.Write(data[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SecretContentTampered reports whether the content of a managed secret no longer matches the checksum
+// recorded when the operator wrote it. Secrets written before checksums were recorded are not reported.
+func SecretContentTampered(data map[string][]byte) bool {
+	checksum, ok := data[managedSecretChecksum]
+	if !ok {
+		return false
+	}
+	return string(checksum) != secretContentChecksum(stripManagedSecretData(data))
+}
+
 func SecretMatchesExpectedData(existing, expected map[string][]byte) bool {
 	return reflect.DeepEqual(stripManagedSecretData(existing), stripManagedSecretData(expected))
 }
 
+// SecretOwnerReference builds the ownerReference that lets Kubernetes garbage-collect a secret created
+// for the given resource. Pass it to credhelper.WithOwnerReference before creating the secret.
+func SecretOwnerReference(ownerKind string, owner metav1.Object) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: ociv1beta1.GroupVersion.String(),
+		Kind:       ownerKind,
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+	}
+}
+
 func EnsureOwnedSecret(ctx context.Context, client credhelper.CredentialClient, secretName, secretNamespace, ownerKind, ownerName string,
 	data map[string][]byte) (bool, error) {
 	managedData := AddManagedSecretData(data, ownerKind, ownerName)
@@ -89,6 +134,10 @@ func EnsureOwnedSecret(ctx context.Context, client credhelper.CredentialClient,
 		return false, getErr
 	}
 	if SecretOwnedBy(existing, ownerKind, ownerName) {
+		if SecretContentTampered(existing) {
+			// The secret was modified outside the operator; write the operator's content back.
+			return client.UpdateSecret(ctx, secretName, secretNamespace, labels, managedData)
+		}
 		return true, nil
 	}
 
@@ -0,0 +1,92 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// secretStore is an in-memory credhelper.CredentialClient keyed by namespace/name.
+type secretStore struct {
+	secrets     map[string]map[string][]byte
+	updateCalls int
+}
+
+func (s *secretStore) CreateSecret(_ context.Context, name, ns string, _ map[string]string, data map[string][]byte) (bool, error) {
+	if _, ok := s.secrets[ns+"/"+name]; ok {
+		return false, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	s.secrets[ns+"/"+name] = data
+	return true, nil
+}
+
+func (s *secretStore) DeleteSecret(_ context.Context, name, ns string) (bool, error) {
+	delete(s.secrets, ns+"/"+name)
+	return true, nil
+}
+
+func (s *secretStore) GetSecret(_ context.Context, name, ns string) (map[string][]byte, error) {
+	data, ok := s.secrets[ns+"/"+name]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	return data, nil
+}
+
+func (s *secretStore) UpdateSecret(_ context.Context, name, ns string, _ map[string]string, data map[string][]byte) (bool, error) {
+	s.updateCalls++
+	s.secrets[ns+"/"+name] = data
+	return true, nil
+}
+
+func TestSecretContentTampered(t *testing.T) {
+	data := servicemanager.AddManagedSecretData(map[string][]byte{"password": []byte("s3cr3t")}, "Redis", "cache")
+	assert.False(t, servicemanager.SecretContentTampered(data))
+
+	data["password"] = []byte("changed")
+	assert.True(t, servicemanager.SecretContentTampered(data))
+
+	// Secrets written before checksums were recorded are not reported.
+	legacy := map[string][]byte{"_osok_managed": []byte("true"), "password": []byte("changed")}
+	assert.False(t, servicemanager.SecretContentTampered(legacy))
+}
+
+func TestEnsureOwnedSecret_RefreshesTamperedSecret(t *testing.T) {
+	store := &secretStore{secrets: map[string]map[string][]byte{}}
+	expected := map[string][]byte{"password": []byte("s3cr3t")}
+
+	ok, err := servicemanager.EnsureOwnedSecret(context.Background(), store, "cache-creds", "default", "Redis", "cache", expected)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	store.secrets["default/cache-creds"]["password"] = []byte("changed")
+	store.secrets["default/cache-creds"]["extra"] = []byte("added")
+
+	ok, err = servicemanager.EnsureOwnedSecret(context.Background(), store, "cache-creds", "default", "Redis", "cache", expected)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, store.updateCalls)
+	assert.True(t, servicemanager.SecretMatchesExpectedData(store.secrets["default/cache-creds"], expected))
+	assert.False(t, servicemanager.SecretContentTampered(store.secrets["default/cache-creds"]))
+}
+
+func TestEnsureOwnedSecret_LeavesUntamperedSecret(t *testing.T) {
+	store := &secretStore{secrets: map[string]map[string][]byte{}}
+	expected := map[string][]byte{"password": []byte("s3cr3t")}
+
+	for i := 0; i < 2; i++ {
+		ok, err := servicemanager.EnsureOwnedSecret(context.Background(), store, "cache-creds", "default", "Redis", "cache", expected)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	}
+	assert.Zero(t, store.updateCalls)
+}
@@ -155,6 +155,8 @@ func TestPropertyDeleteUsesMySQLWorkRequestProgress(t *testing.T) {
 		done, err := mgr.Delete(context.Background(), dbSystem)
 		assert.NoError(t, err)
 		assert.False(t, done)
+		assert.Equal(t, "ocid1.mysqlworkrequest.oc1..inprogress", dbSystem.Status.OsokStatus.WorkRequestId)
+		assert.Equal(t, "IN_PROGRESS", dbSystem.Status.OsokStatus.WorkRequestState)
 	})
 
 	t.Run("succeeded work request completes delete", func(t *testing.T) {
@@ -49,10 +49,12 @@ func getDbSystemClient(provider common.ConfigurationProvider) (MySQLDbSystemClie
 	if err != nil {
 		return nil, err
 	}
+	servicemanager.TraceRequests(&dbSystemClient.BaseClient)
 	workRequestsClient, err := mysql.NewWorkRequestsClientWithConfigurationProvider(provider)
 	if err != nil {
 		return nil, err
 	}
+	servicemanager.TraceRequests(&workRequestsClient.BaseClient)
 	return mySQLClientSet{dbSystemClient: dbSystemClient, workRequestsClient: workRequestsClient}, nil
 }
 
@@ -355,6 +357,19 @@ func (c *DbSystemServiceManager) getMySQLWorkRequest(ctx context.Context, workRe
 	return &resp.WorkRequest, nil
 }
 
+// mySQLWorkRequests adapts the MySQL work request API to core.WorkRequestClient.
+type mySQLWorkRequests struct {
+	manager *DbSystemServiceManager
+}
+
+func (w mySQLWorkRequests) GetWorkRequestStatus(ctx context.Context, workRequestID string) (string, error) {
+	workRequest, err := w.manager.getMySQLWorkRequest(ctx, workRequestID)
+	if err != nil {
+		return "", err
+	}
+	return string(workRequest.Status), nil
+}
+
 // GetMySqlDbSystem Sync the MySqlDbSystem details
 func (c *DbSystemServiceManager) GetMySqlDbSystem(ctx context.Context, dbSystemId ociv1beta1.OCID, retryPolicy *common.RetryPolicy) (*mysql.DbSystem, error) {
 	dbClient, err := c.getOCIClient()
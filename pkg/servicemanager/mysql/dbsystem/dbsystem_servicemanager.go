@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/mysql"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/core"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/errorutil"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
@@ -161,7 +162,7 @@ func (c *DbSystemServiceManager) handleExistingDeleteMySQLWorkRequest(
 		return false, false, nil
 	}
 
-	completed, inProgress, err := c.handleDeleteMySQLWorkRequest(ctx, *workRequestID)
+	completed, inProgress, err := c.handleDeleteMySQLWorkRequest(ctx, &mysqlDbSystem.Status.OsokStatus, *workRequestID)
 	if err != nil {
 		if isRetryableReadServiceError(err) {
 			c.Log.ErrorLog(err, "Transient MySqlDbSystem work request read failure during delete; requeueing")
@@ -448,22 +449,20 @@ func mySQLMaintenanceUpdated(dbSystem ociv1beta1.MySqlDbSystem, mySqlDbInstance
 		dbSystem.Spec.Maintenance.WindowStartTime != safeMySQLString(mySqlDbInstance.Maintenance.WindowStartTime)
 }
 
-func (c *DbSystemServiceManager) handleDeleteMySQLWorkRequest(ctx context.Context, workRequestID string) (bool, bool, error) {
-	workRequest, err := c.getMySQLWorkRequest(ctx, workRequestID)
+func (c *DbSystemServiceManager) handleDeleteMySQLWorkRequest(ctx context.Context, status *ociv1beta1.OSOKStatus, workRequestID string) (bool, bool, error) {
+	decision, err := core.PollWorkRequest(ctx, mySQLWorkRequests{c}, status, workRequestID)
 	if err != nil {
+		if decision == servicemanager.LifecycleFailed {
+			return false, false, fmt.Errorf("MySqlDbSystem delete %w", err)
+		}
 		return false, false, err
 	}
 
-	switch workRequest.Status {
-	case mysql.WorkRequestOperationStatusAccepted,
-		mysql.WorkRequestOperationStatusInProgress,
-		mysql.WorkRequestOperationStatusCanceling:
+	switch decision {
+	case servicemanager.LifecycleInProgress:
 		return false, true, nil
-	case mysql.WorkRequestOperationStatusSucceeded:
+	case servicemanager.LifecycleReady:
 		return true, false, nil
-	case mysql.WorkRequestOperationStatusFailed,
-		mysql.WorkRequestOperationStatusCanceled:
-		return false, false, fmt.Errorf("MySqlDbSystem delete work request %s ended with status %s", workRequestID, workRequest.Status)
 	default:
 		return false, false, nil
 	}
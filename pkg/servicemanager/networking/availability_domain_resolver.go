@@ -0,0 +1,119 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+// AvailabilityDomainClientInterface defines the identity operation used to resolve short availability domain names.
+type AvailabilityDomainClientInterface interface {
+	ListAvailabilityDomains(ctx context.Context, request identity.ListAvailabilityDomainsRequest) (identity.ListAvailabilityDomainsResponse, error)
+}
+
+// newAvailabilityDomainClient builds an OCI identity client for the given provider.
+var newAvailabilityDomainClient = func(provider common.ConfigurationProvider) (AvailabilityDomainClientInterface, error) {
+	client, err := identity.NewIdentityClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
+// shortAvailabilityDomain matches the short forms users write for an availability domain, "AD-1" or "1".
+var shortAvailabilityDomain = regexp.MustCompile(`(?i)^(?:ad-)?([0-9]+)$`)
+
+// availabilityDomainResolver maps short availability domain names to the full, tenancy-prefixed names
+// OCI expects, caching the availability domains of each tenancy and region.
+type availabilityDomainResolver struct {
+	client AvailabilityDomainClientInterface
+	mu     sync.Mutex
+	cache  map[string][]string
+}
+
+// resolveSpecAvailabilityDomain replaces a short availability domain such as "AD-1" with its full name
+// for the rest of the reconcile. Full names are left alone. The returned func restores the original
+// value, so the resolved name never ends up in the spec hash recorded after the reconcile.
+func (r *availabilityDomainResolver) resolveSpecAvailabilityDomain(ctx context.Context, provider common.ConfigurationProvider,
+	availabilityDomain *string) (func(), error) {
+	match := shortAvailabilityDomain.FindStringSubmatch(strings.TrimSpace(*availabilityDomain))
+	if match == nil {
+		return func() {}, nil
+	}
+	resolved, err := r.resolve(ctx, servicemanager.RequestProvider(ctx, provider), *availabilityDomain, match[1])
+	if err != nil {
+		return func() {}, err
+	}
+	original := *availabilityDomain
+	*availabilityDomain = resolved
+	return func() { *availabilityDomain = original }, nil
+}
+
+// resolve returns the full name of the availability domain with the given index in the provider's region.
+func (r *availabilityDomainResolver) resolve(ctx context.Context, provider common.ConfigurationProvider,
+	name, index string) (string, error) {
+	domains, err := r.availabilityDomains(ctx, provider)
+	if err != nil {
+		return "", err
+	}
+	suffix := "-AD-" + strings.TrimLeft(index, "0")
+	for _, domain := range domains {
+		if strings.HasSuffix(domain, suffix) {
+			return domain, nil
+		}
+	}
+	return "", fmt.Errorf("availability domain %q is out of range, the region has %d: %s",
+		name, len(domains), strings.Join(domains, ", "))
+}
+
+func (r *availabilityDomainResolver) availabilityDomains(ctx context.Context, provider common.ConfigurationProvider) ([]string, error) {
+	tenancy, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+	region, err := provider.Region()
+	if err != nil {
+		return nil, err
+	}
+	key := tenancy + "|" + region
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if domains, ok := r.cache[key]; ok {
+		return domains, nil
+	}
+
+	client := r.client
+	if client == nil {
+		if client, err = newAvailabilityDomainClient(provider); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := client.ListAvailabilityDomains(ctx, identity.ListAvailabilityDomainsRequest{
+		CompartmentId: common.String(tenancy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	domains := make([]string, 0, len(resp.Items))
+	for _, domain := range resp.Items {
+		domains = append(domains, safeString(domain.Name))
+	}
+	if r.cache == nil {
+		r.cache = map[string][]string{}
+	}
+	r.cache[key] = domains
+	return domains, nil
+}
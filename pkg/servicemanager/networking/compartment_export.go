@@ -0,0 +1,186 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+// exportedManifest is a custom resource as written by ExportCompartment: the spec holds only the fields
+// that are set, and there is no status.
+type exportedManifest struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   map[string]string      `json:"metadata"`
+	Spec       map[string]interface{} `json:"spec"`
+}
+
+// invalidResourceNameChars matches the runs of characters a Kubernetes resource name cannot hold.
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ExportCompartment writes to w an OciVcn manifest for each VCN in the compartment and an OciSubnet
+// manifest for each of its subnets, as a multi-document YAML stream. Each manifest binds to the live
+// resource through its OCID, and each OciSubnet names its OciVcn in vcnRef, so applying the stream
+// brings existing infrastructure under the operator without creating anything.
+func ExportCompartment(ctx context.Context, provider common.ConfigurationProvider, compartmentID string, w io.Writer) error {
+	client, err := newVirtualNetworkClient(provider)
+	if err != nil {
+		return err
+	}
+
+	vcns, err := listCompartmentVcns(ctx, client, compartmentID)
+	if err != nil {
+		return fmt.Errorf("listing VCNs in compartment %s: %w", compartmentID, err)
+	}
+
+	vcnNames, subnetNames := map[string]bool{}, map[string]bool{}
+	var manifests []exportedManifest
+	for _, vcn := range vcns {
+		vcnName := uniqueResourceName(vcnNames, "vcn", vcn.DisplayName, vcn.Id)
+		manifests = append(manifests, vcnManifest(vcnName, vcn))
+
+		subnets, err := listVcnSubnets(ctx, client, compartmentID, safeString(vcn.Id))
+		if err != nil {
+			return fmt.Errorf("listing subnets of VCN %s: %w", safeString(vcn.Id), err)
+		}
+		for _, subnet := range subnets {
+			manifests = append(manifests, subnetManifest(uniqueResourceName(subnetNames, "subnet", subnet.DisplayName, subnet.Id), vcnName, subnet))
+		}
+	}
+
+	for _, manifest := range manifests {
+		data, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listCompartmentVcns(ctx context.Context, client VirtualNetworkClientInterface, compartmentID string) ([]ocicore.Vcn, error) {
+	req := ocicore.ListVcnsRequest{
+		CompartmentId: common.String(compartmentID),
+		Limit:         common.Int(100),
+	}
+	var vcns []ocicore.Vcn
+	for {
+		resp, err := client.ListVcns(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if networkingLookupStateMatches(string(item.LifecycleState)) {
+				vcns = append(vcns, item)
+			}
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return vcns, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+func listVcnSubnets(ctx context.Context, client VirtualNetworkClientInterface, compartmentID, vcnID string) ([]ocicore.Subnet, error) {
+	req := ocicore.ListSubnetsRequest{
+		CompartmentId: common.String(compartmentID),
+		VcnId:         common.String(vcnID),
+		Limit:         common.Int(100),
+	}
+	var subnets []ocicore.Subnet
+	for {
+		resp, err := client.ListSubnets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if networkingLookupStateMatches(string(item.LifecycleState)) {
+				subnets = append(subnets, item)
+			}
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return subnets, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+func vcnManifest(name string, vcn ocicore.Vcn) exportedManifest {
+	spec := map[string]interface{}{
+		"id":            safeString(vcn.Id),
+		"compartmentId": safeString(vcn.CompartmentId),
+		"displayName":   safeString(vcn.DisplayName),
+		"cidrBlock":     safeString(vcn.CidrBlock),
+	}
+	if len(vcn.CidrBlocks) > 0 {
+		spec["cidrBlock"] = vcn.CidrBlocks[0]
+	}
+	if vcn.DnsLabel != nil {
+		spec["dnsLabel"] = *vcn.DnsLabel
+	}
+	return exportedManifest{
+		APIVersion: ociv1beta1.GroupVersion.String(),
+		Kind:       "OciVcn",
+		Metadata:   map[string]string{"name": name},
+		Spec:       spec,
+	}
+}
+
+func subnetManifest(name, vcnName string, subnet ocicore.Subnet) exportedManifest {
+	spec := map[string]interface{}{
+		"id":            safeString(subnet.Id),
+		"compartmentId": safeString(subnet.CompartmentId),
+		"displayName":   safeString(subnet.DisplayName),
+		"cidrBlock":     safeString(subnet.CidrBlock),
+		"vcnRef":        map[string]string{"name": vcnName},
+	}
+	if subnet.DnsLabel != nil {
+		spec["dnsLabel"] = *subnet.DnsLabel
+	}
+	if subnet.AvailabilityDomain != nil {
+		spec["availabilityDomain"] = *subnet.AvailabilityDomain
+	}
+	return exportedManifest{
+		APIVersion: ociv1beta1.GroupVersion.String(),
+		Kind:       "OciSubnet",
+		Metadata:   map[string]string{"name": name},
+		Spec:       spec,
+	}
+}
+
+// uniqueResourceName turns a display name into a Kubernetes resource name, falling back to the prefix
+// and the end of the OCID when nothing is left of it, and appends a number when the name is taken.
+func uniqueResourceName(taken map[string]bool, prefix string, displayName, ocid *string) string {
+	base := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(safeString(displayName)), "-"), "-")
+	if base == "" {
+		id := safeString(ocid)
+		if len(id) > 8 {
+			id = id[len(id)-8:]
+		}
+		base = strings.Trim(prefix+"-"+invalidResourceNameChars.ReplaceAllString(strings.ToLower(id), "-"), "-")
+	}
+	if len(base) > 60 {
+		base = strings.TrimRight(base[:60], "-")
+	}
+
+	name := base
+	for i := 2; taken[name]; i++ {
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+	taken[name] = true
+	return name
+}
@@ -0,0 +1,226 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+// CompartmentClientInterface defines the identity operations used to resolve compartment names.
+type CompartmentClientInterface interface {
+	ListCompartments(ctx context.Context, request identity.ListCompartmentsRequest) (identity.ListCompartmentsResponse, error)
+	GetCompartment(ctx context.Context, request identity.GetCompartmentRequest) (identity.GetCompartmentResponse, error)
+}
+
+// newCompartmentClient builds an OCI identity client for the given provider.
+var newCompartmentClient = func(provider common.ConfigurationProvider) (CompartmentClientInterface, error) {
+	client, err := identity.NewIdentityClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
+// compartmentNameResolver maps compartment names or paths to OCIDs, caching each resolution per tenancy.
+type compartmentNameResolver struct {
+	client       CompartmentClientInterface
+	mu           sync.Mutex
+	cache        map[string]ociv1beta1.OCID
+	tenancyNames map[string]string
+}
+
+// rootSegment is the path segment that stands for the root compartment of the tenancy.
+const rootSegment = "root"
+
+// resolveSpecCompartment fills an empty compartmentId from compartmentName for the rest of the
+// reconcile. The returned func restores the original value, so the resolved OCID never ends up in
+// the spec hash recorded after the reconcile.
+func (r *compartmentNameResolver) resolveSpecCompartment(ctx context.Context, provider common.ConfigurationProvider,
+	compartmentID *ociv1beta1.OCID, compartmentName string) (func(), error) {
+	if *compartmentID != "" || strings.TrimSpace(compartmentName) == "" {
+		return func() {}, nil
+	}
+	resolved, err := r.resolve(ctx, servicemanager.RequestProvider(ctx, provider), compartmentName)
+	if err != nil {
+		return func() {}, err
+	}
+	original := *compartmentID
+	*compartmentID = resolved
+	return func() { *compartmentID = original }, nil
+}
+
+// resolve returns the OCID of the compartment with the given name. A plain name may match a
+// compartment at any depth in the tenancy; a path such as "platform/networking" is resolved from the
+// root one level at a time, and may start with "root" or the tenancy's name.
+func (r *compartmentNameResolver) resolve(ctx context.Context, provider common.ConfigurationProvider, name string) (ociv1beta1.OCID, error) {
+	tenancy, err := provider.TenancyOCID()
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	segments := strings.Split(strings.Trim(strings.TrimSpace(name), "/"), "/")
+	if len(segments) > 1 {
+		return r.resolvePath(ctx, provider, tenancy, name, segments)
+	}
+
+	key := tenancy + "|" + segments[0]
+	if id, ok := r.cache[key]; ok {
+		return id, nil
+	}
+	compartments, err := r.listCompartments(ctx, provider, tenancy, true)
+	if err != nil {
+		return "", err
+	}
+	id, err := matchCompartmentName(compartments, tenancy, segments[0])
+	if err != nil {
+		return "", err
+	}
+	r.store(key, id)
+	return id, nil
+}
+
+// resolvePath walks the path from the tenancy down, listing the children of each compartment to find
+// the next segment. Each level is cached under its path from the root, so paths sharing a prefix list
+// it only once.
+func (r *compartmentNameResolver) resolvePath(ctx context.Context, provider common.ConfigurationProvider,
+	tenancy, name string, segments []string) (ociv1beta1.OCID, error) {
+	isTenancy, err := r.isTenancySegment(ctx, provider, tenancy, segments[0])
+	if err != nil {
+		return "", err
+	}
+	if isTenancy {
+		segments = segments[1:]
+	}
+
+	parent := ociv1beta1.OCID(tenancy)
+	for i, segment := range segments {
+		key := tenancy + "|/" + strings.Join(segments[:i+1], "/")
+		if id, ok := r.cache[key]; ok {
+			parent = id
+			continue
+		}
+
+		children, err := r.listCompartments(ctx, provider, string(parent), false)
+		if err != nil {
+			return "", err
+		}
+		found := ociv1beta1.OCID("")
+		for _, child := range children {
+			if safeString(child.Name) == segment {
+				found = ociv1beta1.OCID(safeString(child.Id))
+				break
+			}
+		}
+		if found == "" {
+			return "", fmt.Errorf("compartment %q not found under %s while resolving compartment path %q", segment, parent, name)
+		}
+		r.store(key, found)
+		parent = found
+	}
+	return parent, nil
+}
+
+// isTenancySegment reports whether the first segment of a path names the root compartment itself, either
+// as "root" or by the tenancy's name, as compartment paths are shown in the OCI console.
+func (r *compartmentNameResolver) isTenancySegment(ctx context.Context, provider common.ConfigurationProvider,
+	tenancy, segment string) (bool, error) {
+	if segment == rootSegment {
+		return true, nil
+	}
+
+	name, ok := r.tenancyNames[tenancy]
+	if !ok {
+		client, err := r.identityClient(provider)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.GetCompartment(ctx, identity.GetCompartmentRequest{CompartmentId: common.String(tenancy)})
+		if err != nil {
+			return false, err
+		}
+		name = safeString(resp.Name)
+		if r.tenancyNames == nil {
+			r.tenancyNames = map[string]string{}
+		}
+		r.tenancyNames[tenancy] = name
+	}
+	return name != "" && segment == name, nil
+}
+
+func (r *compartmentNameResolver) store(key string, id ociv1beta1.OCID) {
+	if r.cache == nil {
+		r.cache = map[string]ociv1beta1.OCID{}
+	}
+	r.cache[key] = id
+}
+
+// listCompartments lists the active compartments directly under parent, or anywhere below it when
+// inSubtree is set.
+func (r *compartmentNameResolver) listCompartments(ctx context.Context, provider common.ConfigurationProvider,
+	parent string, inSubtree bool) ([]identity.Compartment, error) {
+	client, err := r.identityClient(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var compartments []identity.Compartment
+	var page *string
+	for {
+		resp, err := client.ListCompartments(ctx, identity.ListCompartmentsRequest{
+			CompartmentId:          common.String(parent),
+			CompartmentIdInSubtree: common.Bool(inSubtree),
+			AccessLevel:            identity.ListCompartmentsAccessLevelAny,
+			LifecycleState:         identity.CompartmentLifecycleStateActive,
+			Page:                   page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		compartments = append(compartments, resp.Items...)
+		if resp.OpcNextPage == nil {
+			return compartments, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+// identityClient returns the client set on the resolver, or a new identity client for provider.
+func (r *compartmentNameResolver) identityClient(provider common.ConfigurationProvider) (CompartmentClientInterface, error) {
+	if r.client != nil {
+		return r.client, nil
+	}
+	return newCompartmentClient(provider)
+}
+
+// matchCompartmentName finds the single compartment in the tenancy with the given name.
+func matchCompartmentName(compartments []identity.Compartment, tenancy, name string) (ociv1beta1.OCID, error) {
+	var matches []string
+	for _, compartment := range compartments {
+		if safeString(compartment.Name) == name {
+			matches = append(matches, safeString(compartment.Id))
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("compartment %q not found in tenancy %s", name, tenancy)
+	case 1:
+		return ociv1beta1.OCID(matches[0]), nil
+	default:
+		return "", fmt.Errorf("compartment name %q is ambiguous, it matches %d compartments (%s); use its full path",
+			name, len(matches), strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,57 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"time"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ForceRemoveFinalizerAnnotation lets the finalizer of a VCN or subnet go once its delete has run past
+// the delete timeout, leaving the OCI resource behind.
+const ForceRemoveFinalizerAnnotation = "osok.oracle.com/force-remove-finalizer"
+
+// deleteTimedOutReason is the event reason used when an OCI resource outlives the delete timeout.
+const deleteTimedOutReason = "DeleteTimedOut"
+
+// finalizerForceRemovedReason is the event reason used when the finalizer is released over a live resource.
+const finalizerForceRemovedReason = "FinalizerForceRemoved"
+
+// releaseAfterDeleteTimeout reports whether the finalizer of obj should be removed although its OCI
+// resource still exists or its delete keeps failing. Once the resource has been deleting for longer than
+// timeout, a warning event is emitted; the finalizer is released only when obj also carries
+// ForceRemoveFinalizerAnnotation set to "true". A zero timeout disables the check.
+func releaseAfterDeleteTimeout(recorder record.EventRecorder, obj client.Object, log loggerutil.OSOKLogger,
+	kind string, resourceID ociv1beta1.OCID, timeout time.Duration) bool {
+	deletedAt := obj.GetDeletionTimestamp()
+	if timeout <= 0 || deletedAt == nil || time.Since(deletedAt.Time) < timeout {
+		return false
+	}
+
+	if obj.GetAnnotations()[ForceRemoveFinalizerAnnotation] != "true" {
+		message := fmt.Sprintf("%s %s is still not deleted after %s; set the %s annotation to \"true\" to remove the finalizer "+
+			"and leave the resource in OCI", kind, resourceID, timeout, ForceRemoveFinalizerAnnotation)
+		log.InfoLog(message)
+		if recorder != nil {
+			recorder.Event(obj, v1.EventTypeWarning, deleteTimedOutReason, message)
+		}
+		return false
+	}
+
+	message := fmt.Sprintf("%s %s is still not deleted after %s; removing the finalizer as requested. "+
+		"The resource is orphaned in OCI and must be cleaned up by hand", kind, resourceID, timeout)
+	log.InfoLog(message)
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeWarning, finalizerForceRemovedReason, message)
+	}
+	return true
+}
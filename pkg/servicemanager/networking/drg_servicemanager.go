@@ -52,6 +52,14 @@ func (c *OciDrgServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, drg.Namespace, drg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, drg.Spec.Region)
+
+	created := false
 	drgInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.Drg]{
 		SpecID: drg.Spec.DrgId,
 		Status: &drg.Status.OsokStatus,
@@ -65,6 +73,7 @@ func (c *OciDrgServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 			return c.GetDrgOcid(ctx, *drg)
 		},
 		Create: func() (*ocicore.Drg, error) {
+			created = true
 			return c.CreateDrg(ctx, *drg)
 		},
 		OnCreateError: func(err error) {
@@ -82,8 +91,14 @@ func (c *OciDrgServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	return reconcileLifecycleStatus(&drg.Status.OsokStatus, "OciDrg", safeString(drgInstance.DisplayName),
-		string(drgInstance.LifecycleState), ociv1beta1.OCID(*drgInstance.Id), c.Log), nil
+	drg.Status.DrgVersion = drgVersion(drgInstance)
+	response := reconcileLifecycleStatus(&drg.Status.OsokStatus, "OciDrg", safeString(drgInstance.DisplayName),
+		string(drgInstance.LifecycleState), ociv1beta1.OCID(*drgInstance.Id), c.Log)
+	if response.IsSuccessful && created && drg.Spec.RouteDistribution != nil {
+		// Route distribution statements can only be managed once the DRG exists, so requeue to apply them.
+		response.ShouldRequeue = true
+	}
+	return response, nil
 }
 
 // Delete handles deletion of the DRG (called by the finalizer).
@@ -93,6 +108,13 @@ func (c *OciDrgServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, drg.Namespace, drg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, drg.Spec.Region)
+
 	resourceID := drg.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = drg.Spec.DrgId
@@ -118,6 +140,12 @@ func (c *OciDrgServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 	return done, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec, which holds the DRG and its
+// route distributions.
+func (c *OciDrgServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciDrgServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertDRG(obj)
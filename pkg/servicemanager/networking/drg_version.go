@@ -0,0 +1,38 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+const (
+	drgVersionV1 = "V1"
+	drgVersionV2 = "V2"
+)
+
+// drgVersion reports whether the live DRG is a legacy V1 DRG or a DRGv2. Only DRGv2 has default DRG route
+// tables and a default export route distribution, so a DRG without either has not been upgraded.
+func drgVersion(live *ocicore.Drg) string {
+	if live == nil {
+		return ""
+	}
+	if live.DefaultDrgRouteTables != nil || live.DefaultExportDrgRouteDistributionId != nil {
+		return drgVersionV2
+	}
+	return drgVersionV1
+}
+
+// requireDrgV2 rejects a feature that only a DRGv2 supports when the DRG is a legacy V1 DRG.
+func requireDrgV2(live *ocicore.Drg, feature string) error {
+	if drgVersion(live) != drgVersionV1 {
+		return nil
+	}
+	return fmt.Errorf("%s needs a DRGv2, but DRG %s is a legacy V1 DRG; upgrade it in OCI before setting %s",
+		feature, safeString(live.Id), feature)
+}
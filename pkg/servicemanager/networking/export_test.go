@@ -5,6 +5,11 @@
 
 package networking
 
+import (
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
 // ExportSetVcnClientForTest sets the OCI client on VcnServiceManager for unit testing.
 func ExportSetVcnClientForTest(m *OciVcnServiceManager, c VirtualNetworkClientInterface) {
 	m.ociClient = c
@@ -25,6 +30,11 @@ func ExportSetNatGatewayClientForTest(m *OciNatGatewayServiceManager, c VirtualN
 	m.ociClient = c
 }
 
+// ExportSetLocalPeeringGatewayClientForTest sets the OCI client on LocalPeeringGatewayServiceManager for unit testing.
+func ExportSetLocalPeeringGatewayClientForTest(m *OciLocalPeeringGatewayServiceManager, c VirtualNetworkClientInterface) {
+	m.ociClient = c
+}
+
 // ExportSetServiceGatewayClientForTest sets the OCI client on ServiceGatewayServiceManager for unit testing.
 func ExportSetServiceGatewayClientForTest(m *OciServiceGatewayServiceManager, c VirtualNetworkClientInterface) {
 	m.ociClient = c
@@ -49,3 +59,59 @@ func ExportSetNSGClientForTest(m *OciNetworkSecurityGroupServiceManager, c Virtu
 func ExportSetRouteTableClientForTest(m *OciRouteTableServiceManager, c VirtualNetworkClientInterface) {
 	m.ociClient = c
 }
+
+// ExportSetVirtualNetworkClientFactoryForTest replaces the OCI client constructor used when no
+// client is injected. The returned func restores the previous constructor.
+func ExportSetVirtualNetworkClientFactoryForTest(factory func(common.ConfigurationProvider) (VirtualNetworkClientInterface, error)) func() {
+	previous := newVirtualNetworkClient
+	newVirtualNetworkClient = factory
+	return func() { newVirtualNetworkClient = previous }
+}
+
+// ExportNetworkingLifecycleDecisionForTest evaluates a lifecycle state with the networking state sets.
+func ExportNetworkingLifecycleDecisionForTest(state string) servicemanager.LifecycleDecision {
+	return networkingLifecycleStates.Evaluate(state)
+}
+
+// MaxSecurityListRulesPerDirectionForTest exposes the per-direction security list rule limit for unit testing.
+const MaxSecurityListRulesPerDirectionForTest = maxSecurityListRulesPerDirection
+
+// ExportSetSubnetFlowLogsClientForTest sets the flow logs client on SubnetServiceManager for unit testing.
+func ExportSetSubnetFlowLogsClientForTest(m *OciSubnetServiceManager, c FlowLogsClientInterface) {
+	m.flowLogsClient = c
+}
+
+// ExportSetSubnetPrivateDnsClientForTest sets the private DNS client on SubnetServiceManager for unit testing.
+func ExportSetSubnetPrivateDnsClientForTest(m *OciSubnetServiceManager, c PrivateDnsClientInterface) {
+	m.privateDnsClient = c
+}
+
+// ExportSetVcnCompartmentClientForTest sets the identity client used to resolve compartment names on VcnServiceManager.
+func ExportSetVcnCompartmentClientForTest(m *OciVcnServiceManager, c CompartmentClientInterface) {
+	m.compartments.client = c
+}
+
+// ExportSetSubnetCompartmentClientForTest sets the identity client used to resolve compartment names on SubnetServiceManager.
+func ExportSetSubnetCompartmentClientForTest(m *OciSubnetServiceManager, c CompartmentClientInterface) {
+	m.compartments.client = c
+}
+
+// ExportSetSubnetAvailabilityDomainClientForTest sets the identity client used to resolve short availability domain names on SubnetServiceManager.
+func ExportSetSubnetAvailabilityDomainClientForTest(m *OciSubnetServiceManager, c AvailabilityDomainClientInterface) {
+	m.adResolver.client = c
+}
+
+// ExportSetNSGCompartmentClientForTest sets the identity client used to resolve compartment names on NetworkSecurityGroupServiceManager.
+func ExportSetNSGCompartmentClientForTest(m *OciNetworkSecurityGroupServiceManager, c CompartmentClientInterface) {
+	m.compartments.client = c
+}
+
+// ExportSetNetworkClientForTest sets the OCI client on every child manager of NetworkServiceManager for unit testing.
+func ExportSetNetworkClientForTest(m *OciNetworkServiceManager, c VirtualNetworkClientInterface) {
+	m.vcns.ociClient = c
+	m.internetGateways.ociClient = c
+	m.natGateways.ociClient = c
+	m.routeTables.ociClient = c
+	m.securityLists.ociClient = c
+	m.subnets.ociClient = c
+}
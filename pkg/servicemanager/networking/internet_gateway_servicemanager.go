@@ -18,7 +18,9 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Compile-time check that OciInternetGatewayServiceManager implements OSOKServiceManager.
@@ -30,7 +32,10 @@ type OciInternetGatewayServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
-	ociClient        VirtualNetworkClientInterface
+	Recorder         record.EventRecorder
+	// KubeClient reads the OciVcn named in Spec.VcnRef.
+	KubeClient client.Reader
+	ociClient  VirtualNetworkClientInterface
 }
 
 // NewOciInternetGatewayServiceManager creates a new OciInternetGatewayServiceManager.
@@ -52,6 +57,33 @@ func (c *OciInternetGatewayServiceManager) CreateOrUpdate(ctx context.Context, o
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, igw.Namespace, igw.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, igw.Spec.Region)
+
+	restoreVcn, ready, err := resolveVcnRef(ctx, c.KubeClient, igw.Namespace, igw.Spec.VcnRef, &igw.Spec.VcnId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving vcnRef failed")
+		igw.Status.OsokStatus = util.UpdateOSOKStatusCondition(igw.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if !ready {
+		return waitForVcnRef(&igw.Status.OsokStatus, "OciInternetGateway", igw.Namespace, igw.Spec.VcnRef, c.Log), nil
+	}
+	defer restoreVcn()
+
+	vcnGeneration, err := parentVcnGeneration(ctx, c.KubeClient, igw.Namespace, igw.Spec.VcnId, igw.Spec.VcnRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading parent OciVcn failed")
+		igw.Status.OsokStatus = util.UpdateOSOKStatusCondition(igw.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
 	igwInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.InternetGateway]{
 		SpecID: igw.Spec.InternetGatewayId,
 		Status: &igw.Status.OsokStatus,
@@ -65,6 +97,9 @@ func (c *OciInternetGatewayServiceManager) CreateOrUpdate(ctx context.Context, o
 			return c.GetInternetGatewayOcid(ctx, *igw)
 		},
 		Create: func() (*ocicore.InternetGateway, error) {
+			if err := ensureSameCompartmentAsVcn(ctx, c.getOCIClient, "OciInternetGateway", igw.Spec.CompartmentId, igw.Spec.VcnId); err != nil {
+				return nil, err
+			}
 			return c.CreateInternetGateway(ctx, *igw)
 		},
 		OnCreateError: func(err error) {
@@ -82,8 +117,12 @@ func (c *OciInternetGatewayServiceManager) CreateOrUpdate(ctx context.Context, o
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	return reconcileLifecycleStatus(&igw.Status.OsokStatus, "OciInternetGateway", safeString(igwInstance.DisplayName),
-		string(igwInstance.LifecycleState), ociv1beta1.OCID(*igwInstance.Id), c.Log), nil
+	response := reconcileLifecycleStatus(&igw.Status.OsokStatus, "OciInternetGateway", safeString(igwInstance.DisplayName),
+		string(igwInstance.LifecycleState), ociv1beta1.OCID(*igwInstance.Id), c.Log)
+	disabled := igwInstance.IsEnabled != nil && !*igwInstance.IsEnabled
+	response = reconcileTrafficBlocked(c.Recorder, igw, &igw.Status.OsokStatus, response, disabled,
+		fmt.Sprintf("OciInternetGateway %s is disabled and does not pass traffic", safeString(igwInstance.DisplayName)), c.Log)
+	return recordParentVcnGeneration(response, &igw.Status.VcnGeneration, vcnGeneration), nil
 }
 
 // Delete handles deletion of the Internet Gateway (called by the finalizer).
@@ -93,6 +132,13 @@ func (c *OciInternetGatewayServiceManager) Delete(ctx context.Context, obj runti
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, igw.Namespace, igw.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, igw.Spec.Region)
+
 	resourceID := igw.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = igw.Spec.InternetGatewayId
@@ -118,6 +164,21 @@ func (c *OciInternetGatewayServiceManager) Delete(ctx context.Context, obj runti
 	return done, nil
 }
 
+// HasPendingAction reports whether the parent OciVcn spec changed since the OciInternetGateway last settled, so a
+// requeue from the OciVcn watch is not skipped as an unchanged spec.
+func (c *OciInternetGatewayServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	igw, err := c.convertIGW(obj)
+	if err != nil {
+		return false
+	}
+	return parentVcnChanged(ctx, c.KubeClient, igw.Namespace, igw.Spec.VcnId, igw.Spec.VcnRef, igw.Status.VcnGeneration)
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec once the parent OciVcn can be read.
+func (c *OciInternetGatewayServiceManager) SkipUnchangedSpec() bool {
+	return c.KubeClient != nil
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciInternetGatewayServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertIGW(obj)
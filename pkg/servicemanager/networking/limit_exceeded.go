@@ -0,0 +1,58 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/errorutil"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// quotaExceededReason is the condition and event reason used when a service limit or quota stops a create.
+const quotaExceededReason = "QuotaExceeded"
+
+// quotaExceededRequeueDuration is how long to wait before retrying a create refused by a service limit or
+// quota. Limits are raised by a support request or an administrator, so retrying sooner only adds load.
+const quotaExceededRequeueDuration = 30 * time.Minute
+
+// reconcileLimitExceeded marks a resource whose create OCI refused because of a tenancy service limit or
+// compartment quota as failed with the QuotaExceeded reason, emits a warning event with OCI's message,
+// which names the limit, and asks for a long requeue. It reports false for any other error.
+func reconcileLimitExceeded(recorder record.EventRecorder, obj runtime.Object, status *ociv1beta1.OSOKStatus,
+	kind, displayName string, err error, log loggerutil.OSOKLogger) (servicemanager.OSOKResponse, bool) {
+	if !errorutil.IsLimitExceeded(err) {
+		return servicemanager.OSOKResponse{}, false
+	}
+
+	limit := err.Error()
+	var serviceErr common.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.GetMessage() != "" {
+		limit = serviceErr.GetMessage()
+	}
+	message := fmt.Sprintf("%s %s cannot be created because a service limit or quota was exceeded: %s",
+		kind, displayName, limit)
+	*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Failed, v1.ConditionFalse, quotaExceededReason,
+		message, log)
+	log.InfoLog(message)
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeWarning, quotaExceededReason, message)
+	}
+	return servicemanager.OSOKResponse{
+		IsSuccessful:    false,
+		ShouldRequeue:   true,
+		RequeueDuration: quotaExceededRequeueDuration,
+	}, true
+}
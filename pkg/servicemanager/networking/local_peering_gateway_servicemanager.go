@@ -0,0 +1,238 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Compile-time check that OciLocalPeeringGatewayServiceManager implements OSOKServiceManager.
+var _ servicemanager.OSOKServiceManager = &OciLocalPeeringGatewayServiceManager{}
+
+// OciLocalPeeringGatewayServiceManager implements OSOKServiceManager for OCI Local Peering Gateway.
+type OciLocalPeeringGatewayServiceManager struct {
+	Provider         common.ConfigurationProvider
+	CredentialClient credhelper.CredentialClient
+	Scheme           *runtime.Scheme
+	Log              loggerutil.OSOKLogger
+	// KubeClient reads the OciVcn that manages Spec.VcnId.
+	KubeClient client.Reader
+	ociClient  VirtualNetworkClientInterface
+}
+
+// NewOciLocalPeeringGatewayServiceManager creates a new OciLocalPeeringGatewayServiceManager.
+func NewOciLocalPeeringGatewayServiceManager(provider common.ConfigurationProvider, credClient credhelper.CredentialClient,
+	scheme *runtime.Scheme, log loggerutil.OSOKLogger) *OciLocalPeeringGatewayServiceManager {
+	return &OciLocalPeeringGatewayServiceManager{
+		Provider:         provider,
+		CredentialClient: credClient,
+		Scheme:           scheme,
+		Log:              log,
+	}
+}
+
+// CreateOrUpdate reconciles the OciLocalPeeringGateway resource against OCI.
+func (c *OciLocalPeeringGatewayServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	lpg, err := c.convertLPG(obj)
+	if err != nil {
+		c.Log.ErrorLog(err, "Conversion of object failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, lpg.Namespace, lpg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, lpg.Spec.Region)
+
+	vcnGeneration, err := parentVcnGeneration(ctx, c.KubeClient, lpg.Namespace, lpg.Spec.VcnId, nil)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading parent OciVcn failed")
+		lpg.Status.OsokStatus = util.UpdateOSOKStatusCondition(lpg.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	lpgInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.LocalPeeringGateway]{
+		SpecID: lpg.Spec.LocalPeeringGatewayId,
+		Status: &lpg.Status.OsokStatus,
+		Get: func(id ociv1beta1.OCID) (*ocicore.LocalPeeringGateway, error) {
+			return c.GetLocalPeeringGateway(ctx, id)
+		},
+		Update: func() error {
+			return c.UpdateLocalPeeringGateway(ctx, lpg)
+		},
+		Lookup: func() (*ociv1beta1.OCID, error) {
+			return c.GetLocalPeeringGatewayOcid(ctx, *lpg)
+		},
+		Create: func() (*ocicore.LocalPeeringGateway, error) {
+			if err := ensureSameCompartmentAsVcn(ctx, c.getOCIClient, "OciLocalPeeringGateway", lpg.Spec.CompartmentId, lpg.Spec.VcnId); err != nil {
+				return nil, err
+			}
+			return c.CreateLocalPeeringGateway(ctx, *lpg)
+		},
+		OnCreateError: func(err error) {
+			lpg.Status.OsokStatus = util.UpdateOSOKStatusCondition(lpg.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+			c.Log.ErrorLog(err, "Create OciLocalPeeringGateway failed")
+		},
+		Log:            c.Log,
+		GetExistingMsg: "Error while getting existing OciLocalPeeringGateway",
+		GetStatusMsg:   "Error while getting existing OciLocalPeeringGateway from status OCID",
+		GetByOCIDMsg:   "Error while getting OciLocalPeeringGateway by OCID",
+		UpdateMsg:      "Error while updating OciLocalPeeringGateway",
+	})
+	if err != nil {
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	lpg.Status.PeeringStatus = string(lpgInstance.PeeringStatus)
+	response := reconcileLifecycleStatus(&lpg.Status.OsokStatus, "OciLocalPeeringGateway", safeString(lpgInstance.DisplayName),
+		string(lpgInstance.LifecycleState), ociv1beta1.OCID(*lpgInstance.Id), c.Log)
+	if response.IsSuccessful && lpg.Spec.PeerId != "" {
+		if response, err = c.reconcilePeering(ctx, lpg, lpgInstance); err != nil {
+			return response, err
+		}
+	}
+	return recordParentVcnGeneration(response, &lpg.Status.VcnGeneration, vcnGeneration), nil
+}
+
+// reconcilePeering connects the gateway to Spec.PeerId once both gateways are AVAILABLE. A peer that does
+// not exist yet or is still provisioning requeues, so both sides of a peering can be applied together.
+func (c *OciLocalPeeringGatewayServiceManager) reconcilePeering(ctx context.Context, lpg *ociv1beta1.OciLocalPeeringGateway,
+	lpgInstance *ocicore.LocalPeeringGateway) (servicemanager.OSOKResponse, error) {
+	peerID := lpg.Spec.PeerId
+
+	switch lpgInstance.PeeringStatus {
+	case ocicore.LocalPeeringGatewayPeeringStatusPeered:
+		if safeString(lpgInstance.PeerId) == string(peerID) {
+			return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+		}
+		err := fmt.Errorf("OciLocalPeeringGateway %s is already peered with %s and cannot be peered with %s",
+			lpg.Status.OsokStatus.Ocid, safeString(lpgInstance.PeerId), peerID)
+		lpg.Status.OsokStatus = util.UpdateOSOKStatusCondition(lpg.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	case ocicore.LocalPeeringGatewayPeeringStatusPending:
+		return c.waitForPeering(lpg, fmt.Sprintf("OciLocalPeeringGateway peering with %s is PENDING", peerID)), nil
+	}
+
+	peer, err := c.GetLocalPeeringGateway(ctx, peerID)
+	if err != nil {
+		if !isNotFoundServiceError(err) {
+			c.Log.ErrorLog(err, "Error while getting the peer of OciLocalPeeringGateway")
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		return c.waitForPeering(lpg, fmt.Sprintf("Waiting for peer Local Peering Gateway %s to exist", peerID)), nil
+	}
+	if peer.LifecycleState != ocicore.LocalPeeringGatewayLifecycleStateAvailable {
+		return c.waitForPeering(lpg, fmt.Sprintf("Waiting for peer Local Peering Gateway %s, which is %s",
+			peerID, peer.LifecycleState)), nil
+	}
+
+	c.Log.InfoLog(fmt.Sprintf("Connecting OciLocalPeeringGateway %s to %s", lpg.Status.OsokStatus.Ocid, peerID))
+	if err := c.ConnectLocalPeeringGateways(ctx, lpg.Status.OsokStatus.Ocid, peerID); err != nil {
+		lpg.Status.OsokStatus = util.UpdateOSOKStatusCondition(lpg.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Connecting OciLocalPeeringGateway failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	return c.waitForPeering(lpg, fmt.Sprintf("OciLocalPeeringGateway peering with %s requested", peerID)), nil
+}
+
+// waitForPeering marks the gateway as provisioning until OCI reports it PEERED with Spec.PeerId.
+func (c *OciLocalPeeringGatewayServiceManager) waitForPeering(lpg *ociv1beta1.OciLocalPeeringGateway, message string) servicemanager.OSOKResponse {
+	c.Log.InfoLog(message)
+	lpg.Status.OsokStatus = util.UpdateOSOKStatusCondition(lpg.Status.OsokStatus,
+		ociv1beta1.Provisioning, v1.ConditionTrue, "", message, c.Log)
+	return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true}
+}
+
+// Delete handles deletion of the Local Peering Gateway (called by the finalizer).
+// Deleting a peered gateway revokes the peering on the other side.
+func (c *OciLocalPeeringGatewayServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	lpg, err := c.convertLPG(obj)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, lpg.Namespace, lpg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, lpg.Spec.Region)
+
+	resourceID := lpg.Status.OsokStatus.Ocid
+	if resourceID == "" {
+		resourceID = lpg.Spec.LocalPeeringGatewayId
+	}
+	if resourceID == "" {
+		c.Log.InfoLog("OciLocalPeeringGateway has no OCID, nothing to delete")
+		return true, nil
+	}
+
+	c.Log.InfoLog(fmt.Sprintf("Deleting OciLocalPeeringGateway %s", resourceID))
+	done, err := deleteResourceAndWait(
+		func() error { return c.DeleteLocalPeeringGateway(ctx, resourceID) },
+		func() error {
+			_, getErr := c.GetLocalPeeringGateway(ctx, resourceID)
+			return getErr
+		},
+	)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while deleting OciLocalPeeringGateway")
+		return false, err
+	}
+
+	return done, nil
+}
+
+// HasPendingAction reports whether the parent OciVcn spec changed since the OciLocalPeeringGateway last
+// settled, so a requeue from the OciVcn watch is not skipped as an unchanged spec.
+func (c *OciLocalPeeringGatewayServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	lpg, err := c.convertLPG(obj)
+	if err != nil {
+		return false
+	}
+	return parentVcnChanged(ctx, c.KubeClient, lpg.Namespace, lpg.Spec.VcnId, nil, lpg.Status.VcnGeneration)
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec once the parent OciVcn can be read.
+func (c *OciLocalPeeringGatewayServiceManager) SkipUnchangedSpec() bool {
+	return c.KubeClient != nil
+}
+
+// GetCrdStatus returns the OSOK status from the resource.
+func (c *OciLocalPeeringGatewayServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
+	resource, err := c.convertLPG(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &resource.Status.OsokStatus, nil
+}
+
+func (c *OciLocalPeeringGatewayServiceManager) convertLPG(obj runtime.Object) (*ociv1beta1.OciLocalPeeringGateway, error) {
+	lpg, ok := obj.(*ociv1beta1.OciLocalPeeringGateway)
+	if !ok {
+		return nil, fmt.Errorf("failed type assertion for OciLocalPeeringGateway")
+	}
+	return lpg, nil
+}
@@ -6,16 +6,25 @@
 package networking
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/authhelper"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 )
 
 func resolveResourceID(statusID, specID ociv1beta1.OCID) (ociv1beta1.OCID, error) {
@@ -28,10 +37,77 @@ func resolveResourceID(statusID, specID ociv1beta1.OCID) (ociv1beta1.OCID, error
 	return "", fmt.Errorf("resource ocid is empty")
 }
 
+// withAuthSecretProvider scopes ctx to a provider built from the referenced auth secret.
+// When no secret is referenced the manager's default provider remains in effect.
+func withAuthSecretProvider(ctx context.Context, credClient credhelper.CredentialClient, namespace string,
+	ref ociv1beta1.SecretSource) (context.Context, error) {
+	if ref.SecretName == "" {
+		return ctx, nil
+	}
+	provider, err := authhelper.ProviderFromSecret(ctx, credClient, ref.SecretName, namespace)
+	if err != nil {
+		return ctx, err
+	}
+	return servicemanager.WithRequestProvider(ctx, provider), nil
+}
+
 func hasResourceID(id ociv1beta1.OCID) bool {
 	return strings.TrimSpace(string(id)) != ""
 }
 
+// managedByTagKey is the freeform tag the operator writes on resources it creates. Its value is the
+// owning resource's namespace/name, which lets display-name lookups tell the operator's own resources
+// apart from same-named ones created by other resources or outside the operator.
+const managedByTagKey = "osok-managed-by"
+
+func managedByTagValue(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// withManagedByTag returns a copy of tags that also carries the managed-by tag for the given owner.
+func withManagedByTag(tags map[string]string, namespace, name string) map[string]string {
+	tagged := make(map[string]string, len(tags)+1)
+	for key, value := range tags {
+		tagged[key] = value
+	}
+	tagged[managedByTagKey] = managedByTagValue(namespace, name)
+	return tagged
+}
+
+// desiredManagedFreeformTags is the freeform tag set to reconcile on update. Nil spec tags stay nil so
+// that tags remain unmanaged; otherwise the managed-by tag is kept alongside the spec tags.
+func desiredManagedFreeformTags(tags map[string]string, namespace, name string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	return withManagedByTag(tags, namespace, name)
+}
+
+// managedResourceSelector picks which of several same-named lookup results to adopt. A resource tagged
+// for the owner wins outright, an untagged one is kept as a fallback, and one tagged for a different
+// owner is never adopted.
+type managedResourceSelector struct {
+	owner    string
+	fallback *string
+}
+
+func newManagedResourceSelector(namespace, name string) *managedResourceSelector {
+	return &managedResourceSelector{owner: managedByTagValue(namespace, name)}
+}
+
+// consider records a candidate and reports whether it is tagged for the owner and should be adopted now.
+func (s *managedResourceSelector) consider(id *string, tags map[string]string) bool {
+	switch tags[managedByTagKey] {
+	case s.owner:
+		return true
+	case "":
+		if s.fallback == nil {
+			s.fallback = id
+		}
+	}
+	return false
+}
+
 type networkingCreateOrUpdateOps[T any] struct {
 	SpecID         ociv1beta1.OCID
 	Status         *ociv1beta1.OSOKStatus
@@ -40,6 +116,7 @@ type networkingCreateOrUpdateOps[T any] struct {
 	Lookup         func() (*ociv1beta1.OCID, error)
 	Create         func() (*T, error)
 	OnCreateError  func(error)
+	IsTerminal     func(*T) bool
 	Log            loggerutil.OSOKLogger
 	GetExistingMsg string
 	GetStatusMsg   string
@@ -92,6 +169,10 @@ func resumeManagedNetworkingResource[T any](ops networkingCreateOrUpdateOps[T])
 		return nil, nil
 	}
 
+	if ops.IsTerminal != nil && ops.IsTerminal(instance) {
+		return instance, nil
+	}
+
 	if err := ops.Update(); err != nil {
 		ops.Log.ErrorLog(err, ops.UpdateMsg)
 		return nil, err
@@ -100,6 +181,31 @@ func resumeManagedNetworkingResource[T any](ops networkingCreateOrUpdateOps[T])
 	return instance, nil
 }
 
+// createReservationRequeueDuration is how soon a resource is reconciled again after its create retry
+// token has been reserved.
+const createReservationRequeueDuration = time.Second
+
+// reserveCreateRetryToken stores a new OCI retry token in status before a resource is first created and
+// asks for a requeue, so the token is persisted before OCI is called. If the operator stops after the
+// create but before the OCID reaches status, the next reconcile finds the resource by name or repeats the
+// create with the same token, which OCI answers with the resource it already created. It reports false
+// when the resource is bound through its spec, already tracked in status, or already has a token.
+func reserveCreateRetryToken(specID ociv1beta1.OCID, status *ociv1beta1.OSOKStatus, token *string,
+	kind, displayName string, log loggerutil.OSOKLogger) (servicemanager.OSOKResponse, bool) {
+	if hasResourceID(specID) || hasResourceID(status.Ocid) || *token != "" {
+		return servicemanager.OSOKResponse{}, false
+	}
+
+	*token = uuid.NewString()
+	*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "",
+		fmt.Sprintf("Reserved a retry token to create %s %s", kind, displayName), log)
+	return servicemanager.OSOKResponse{
+		IsSuccessful:    false,
+		ShouldRequeue:   true,
+		RequeueDuration: createReservationRequeueDuration,
+	}, true
+}
+
 func findOrCreateNetworkingResource[T any](ops networkingCreateOrUpdateOps[T]) (*T, error) {
 	resourceOCID, err := ops.Lookup()
 	if err != nil {
@@ -133,6 +239,8 @@ func findOrCreateNetworkingResource[T any](ops networkingCreateOrUpdateOps[T]) (
 }
 
 type networkingUpdateOps[Existing any, Details any] struct {
+	// Kind labels the drift corrections recorded for the resource (optional). Set it through driftKind.
+	Kind                 string
 	StatusID             ociv1beta1.OCID
 	SpecID               ociv1beta1.OCID
 	DesiredCompartmentID ociv1beta1.OCID
@@ -142,6 +250,8 @@ type networkingUpdateOps[Existing any, Details any] struct {
 	ChangeCompartment    func(ociv1beta1.OCID, ociv1beta1.OCID) error
 	BuildDetails         func(*Existing) (Details, bool)
 	Update               func(ociv1beta1.OCID, Details) error
+	// AfterUpdate reconciles state that OCI manages through dedicated APIs rather than the update details (optional).
+	AfterUpdate func(ociv1beta1.OCID, *Existing) error
 }
 
 func updateSimpleNetworkingResource[Existing any, Details any](ops networkingUpdateOps[Existing, Details]) error {
@@ -163,17 +273,75 @@ func updateSimpleNetworkingResource[Existing any, Details any](ops networkingUpd
 
 	if err := changeCompartmentIfNeeded(ops.ExistingCompartment(existing), ops.DesiredCompartmentID,
 		func(compartmentID ociv1beta1.OCID) error {
-			return ops.ChangeCompartment(targetID, compartmentID)
+			if err := ops.ChangeCompartment(targetID, compartmentID); err != nil {
+				return err
+			}
+			recordDriftCorrections(ops.Kind, []string{"compartmentId"})
+			return nil
 		}); err != nil {
 		return err
 	}
 
 	updateDetails, updateNeeded := ops.BuildDetails(existing)
-	if !updateNeeded {
+	if updateNeeded {
+		if err := ops.Update(targetID, updateDetails); err != nil {
+			return err
+		}
+		recordDriftCorrections(ops.Kind, updatedFields(updateDetails))
+	}
+
+	if ops.AfterUpdate != nil {
+		return ops.AfterUpdate(targetID, existing)
+	}
+	return nil
+}
+
+// updatedFields returns the JSON names of the fields set in OCI update details, in declaration order.
+func updatedFields(details any) []string {
+	value := reflect.ValueOf(details)
+	if value.Kind() != reflect.Struct {
 		return nil
 	}
+	var fields []string
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		switch field.Kind() {
+		case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+			if field.IsNil() {
+				continue
+			}
+		default:
+			if field.IsZero() {
+				continue
+			}
+		}
+		name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			name = value.Type().Field(i).Name
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// driftKind returns kind when the spec was already reconciled successfully, so the updates that follow
+// correct changes made outside the operator. It returns "" when the spec changed since, which
+// recordDriftCorrections ignores, so applying a user's spec edit is not counted as drift.
+func driftKind(kind string, generation, observedGeneration int64) string {
+	if generation != observedGeneration {
+		return ""
+	}
+	return kind
+}
 
-	return ops.Update(targetID, updateDetails)
+// recordDriftCorrections counts each field updated to bring the resource back in line with its spec.
+func recordDriftCorrections(kind string, fields []string) {
+	if kind == "" {
+		return
+	}
+	for _, field := range fields {
+		metrics.AddDriftCorrectionMetrics(kind, field)
+	}
 }
 
 func changeCompartmentIfNeeded(existingCompartment *string, desiredCompartment ociv1beta1.OCID, changeFn func(ociv1beta1.OCID) error) error {
@@ -186,46 +354,63 @@ func changeCompartmentIfNeeded(existingCompartment *string, desiredCompartment o
 	return changeFn(desiredCompartment)
 }
 
+// ensureSameCompartmentAsVcn rejects a gateway whose compartment differs from its VCN's, so the
+// mismatch is reported clearly instead of as an opaque OCI create error.
+func ensureSameCompartmentAsVcn(ctx context.Context, getClient func(context.Context) (VirtualNetworkClientInterface, error),
+	kind string, compartmentID, vcnID ociv1beta1.OCID) error {
+	client, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetVcn(ctx, ocicore.GetVcnRequest{VcnId: common.String(string(vcnID))})
+	if err != nil {
+		return err
+	}
+	if resp.CompartmentId == nil || *resp.CompartmentId == string(compartmentID) {
+		return nil
+	}
+	return fmt.Errorf("%s compartmentId %s does not match compartment %s of vcn %s", kind, compartmentID,
+		*resp.CompartmentId, vcnID)
+}
+
 func isNotFoundServiceError(err error) bool {
 	serviceErr, ok := err.(common.ServiceError)
 	return ok && serviceErr.GetHTTPStatusCode() == 404
 }
 
-func isPendingLifecycleState(state string) bool {
-	return state == "PROVISIONING" || state == "UPDATING"
-}
-
-func isReadyLifecycleState(state string) bool {
-	return state == "AVAILABLE"
+// networkingLifecycleStates is the lifecycle vocabulary shared by the virtual network resources.
+var networkingLifecycleStates = servicemanager.LifecycleStates{
+	Ready:       []string{"AVAILABLE"},
+	InProgress:  []string{"PROVISIONING", "UPDATING"},
+	Terminating: []string{"TERMINATING", "TERMINATED"},
+	Failed:      []string{"FAILED"},
 }
 
-func setCreatedAtIfUnset(status *ociv1beta1.OSOKStatus) {
-	if status.CreatedAt != nil {
-		return
-	}
-	now := metav1.NewTime(metav1.Now().Time)
-	status.CreatedAt = &now
+func isTerminalLifecycleState(state string) bool {
+	decision := networkingLifecycleStates.Evaluate(state)
+	return decision == servicemanager.LifecycleTerminating || decision == servicemanager.LifecycleFailed
 }
 
 func reconcileLifecycleStatus(status *ociv1beta1.OSOKStatus, kind, displayName, lifecycleState string,
 	ocid ociv1beta1.OCID, log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
-	status.Ocid = ocid
+	return networkingLifecycleStates.ReconcileStatus(status, kind, displayName, lifecycleState, ocid, log)
+}
 
-	switch {
-	case isReadyLifecycleState(lifecycleState):
-		setCreatedAtIfUnset(status)
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Active, v1.ConditionTrue, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
-		return servicemanager.OSOKResponse{IsSuccessful: true}
-	case isPendingLifecycleState(lifecycleState):
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
-		return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true}
-	default:
-		*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Failed, v1.ConditionFalse, "",
-			fmt.Sprintf("%s %s is %s", kind, displayName, lifecycleState), log)
-		return servicemanager.OSOKResponse{IsSuccessful: false}
+// reconcileTerminalLifecycleStatus marks a resource that OCI reports in a terminal
+// lifecycle state as failed and emits a warning event. It does not requeue, so a
+// terminated resource is left for the user to inspect instead of being re-created.
+func reconcileTerminalLifecycleStatus(recorder record.EventRecorder, obj runtime.Object, status *ociv1beta1.OSOKStatus,
+	kind, displayName, lifecycleState string, ocid ociv1beta1.OCID, log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
+	status.Ocid = ocid
+	message := fmt.Sprintf("%s %s is %s in OCI", kind, displayName, lifecycleState)
+	*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Failed, v1.ConditionFalse, lifecycleState, message, log)
+	status.Reason = lifecycleState
+	status.Message = message
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeWarning, lifecycleState, message)
 	}
+	return servicemanager.OSOKResponse{IsSuccessful: false}
 }
 
 func deleteResourceAndWait(deleteFn func() error, getFn func() error) (bool, error) {
@@ -18,7 +18,9 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Compile-time check that OciNatGatewayServiceManager implements OSOKServiceManager.
@@ -30,7 +32,10 @@ type OciNatGatewayServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
-	ociClient        VirtualNetworkClientInterface
+	Recorder         record.EventRecorder
+	// KubeClient reads the OciVcn named in Spec.VcnRef.
+	KubeClient client.Reader
+	ociClient  VirtualNetworkClientInterface
 }
 
 // NewOciNatGatewayServiceManager creates a new OciNatGatewayServiceManager.
@@ -52,6 +57,33 @@ func (c *OciNatGatewayServiceManager) CreateOrUpdate(ctx context.Context, obj ru
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, nat.Namespace, nat.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, nat.Spec.Region)
+
+	restoreVcn, ready, err := resolveVcnRef(ctx, c.KubeClient, nat.Namespace, nat.Spec.VcnRef, &nat.Spec.VcnId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving vcnRef failed")
+		nat.Status.OsokStatus = util.UpdateOSOKStatusCondition(nat.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if !ready {
+		return waitForVcnRef(&nat.Status.OsokStatus, "OciNatGateway", nat.Namespace, nat.Spec.VcnRef, c.Log), nil
+	}
+	defer restoreVcn()
+
+	vcnGeneration, err := parentVcnGeneration(ctx, c.KubeClient, nat.Namespace, nat.Spec.VcnId, nat.Spec.VcnRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading parent OciVcn failed")
+		nat.Status.OsokStatus = util.UpdateOSOKStatusCondition(nat.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
 	natInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.NatGateway]{
 		SpecID: nat.Spec.NatGatewayId,
 		Status: &nat.Status.OsokStatus,
@@ -65,6 +97,9 @@ func (c *OciNatGatewayServiceManager) CreateOrUpdate(ctx context.Context, obj ru
 			return c.GetNatGatewayOcid(ctx, *nat)
 		},
 		Create: func() (*ocicore.NatGateway, error) {
+			if err := ensureSameCompartmentAsVcn(ctx, c.getOCIClient, "OciNatGateway", nat.Spec.CompartmentId, nat.Spec.VcnId); err != nil {
+				return nil, err
+			}
 			return c.CreateNatGateway(ctx, *nat)
 		},
 		OnCreateError: func(err error) {
@@ -82,8 +117,12 @@ func (c *OciNatGatewayServiceManager) CreateOrUpdate(ctx context.Context, obj ru
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	return reconcileLifecycleStatus(&nat.Status.OsokStatus, "OciNatGateway", safeString(natInstance.DisplayName),
-		string(natInstance.LifecycleState), ociv1beta1.OCID(*natInstance.Id), c.Log), nil
+	response := reconcileLifecycleStatus(&nat.Status.OsokStatus, "OciNatGateway", safeString(natInstance.DisplayName),
+		string(natInstance.LifecycleState), ociv1beta1.OCID(*natInstance.Id), c.Log)
+	blocked := natInstance.BlockTraffic != nil && *natInstance.BlockTraffic
+	response = reconcileTrafficBlocked(c.Recorder, nat, &nat.Status.OsokStatus, response, blocked,
+		fmt.Sprintf("OciNatGateway %s blocks traffic", safeString(natInstance.DisplayName)), c.Log)
+	return recordParentVcnGeneration(response, &nat.Status.VcnGeneration, vcnGeneration), nil
 }
 
 // Delete handles deletion of the NAT Gateway (called by the finalizer).
@@ -93,6 +132,13 @@ func (c *OciNatGatewayServiceManager) Delete(ctx context.Context, obj runtime.Ob
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, nat.Namespace, nat.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, nat.Spec.Region)
+
 	resourceID := nat.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = nat.Spec.NatGatewayId
@@ -118,6 +164,21 @@ func (c *OciNatGatewayServiceManager) Delete(ctx context.Context, obj runtime.Ob
 	return done, nil
 }
 
+// HasPendingAction reports whether the parent OciVcn spec changed since the OciNatGateway last settled, so a
+// requeue from the OciVcn watch is not skipped as an unchanged spec.
+func (c *OciNatGatewayServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	nat, err := c.convertNAT(obj)
+	if err != nil {
+		return false
+	}
+	return parentVcnChanged(ctx, c.KubeClient, nat.Namespace, nat.Spec.VcnId, nat.Spec.VcnRef, nat.Status.VcnGeneration)
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec once the parent OciVcn can be read.
+func (c *OciNatGatewayServiceManager) SkipUnchangedSpec() bool {
+	return c.KubeClient != nil
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciNatGatewayServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertNAT(obj)
@@ -31,6 +31,7 @@ type OciNetworkSecurityGroupServiceManager struct {
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
 	ociClient        VirtualNetworkClientInterface
+	compartments     compartmentNameResolver
 }
 
 // NewOciNetworkSecurityGroupServiceManager creates a new OciNetworkSecurityGroupServiceManager.
@@ -52,6 +53,20 @@ func (c *OciNetworkSecurityGroupServiceManager) CreateOrUpdate(ctx context.Conte
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, nsg.Namespace, nsg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, nsg.Spec.Region)
+
+	restoreCompartment, err := c.compartments.resolveSpecCompartment(ctx, c.Provider, &nsg.Spec.CompartmentId, nsg.Spec.CompartmentName)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving compartment name failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreCompartment()
+
 	nsgInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.NetworkSecurityGroup]{
 		SpecID: nsg.Spec.NetworkSecurityGroupId,
 		Status: &nsg.Status.OsokStatus,
@@ -93,6 +108,13 @@ func (c *OciNetworkSecurityGroupServiceManager) Delete(ctx context.Context, obj
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, nsg.Namespace, nsg.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, nsg.Spec.Region)
+
 	resourceID := nsg.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = nsg.Spec.NetworkSecurityGroupId
@@ -118,6 +140,11 @@ func (c *OciNetworkSecurityGroupServiceManager) Delete(ctx context.Context, obj
 	return done, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec.
+func (c *OciNetworkSecurityGroupServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciNetworkSecurityGroupServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertNSG(obj)
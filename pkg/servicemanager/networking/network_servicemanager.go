@@ -0,0 +1,433 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Compile-time check that OciNetworkServiceManager implements OSOKServiceManager.
+var _ servicemanager.OSOKServiceManager = &OciNetworkServiceManager{}
+
+// OciNetworkServiceManager implements OSOKServiceManager for OciNetwork. It drives the VCN, gateway,
+// route table, security list and subnet managers in dependency order and deletes in reverse.
+type OciNetworkServiceManager struct {
+	Provider         common.ConfigurationProvider
+	CredentialClient credhelper.CredentialClient
+	Scheme           *runtime.Scheme
+	Log              loggerutil.OSOKLogger
+	RetentionTag     RetentionTag
+	DefaultTags      ociv1beta1.TagResources
+	// ProtectedTagNamespaces are defined-tag namespaces that updates keep as they are in OCI.
+	ProtectedTagNamespaces []string
+	vcns                   *OciVcnServiceManager
+	internetGateways       *OciInternetGatewayServiceManager
+	natGateways            *OciNatGatewayServiceManager
+	routeTables            *OciRouteTableServiceManager
+	securityLists          *OciSecurityListServiceManager
+	subnets                *OciSubnetServiceManager
+}
+
+// NewOciNetworkServiceManager creates a new OciNetworkServiceManager.
+func NewOciNetworkServiceManager(provider common.ConfigurationProvider, credClient credhelper.CredentialClient,
+	scheme *runtime.Scheme, log loggerutil.OSOKLogger) *OciNetworkServiceManager {
+	return &OciNetworkServiceManager{
+		Provider:         provider,
+		CredentialClient: credClient,
+		Scheme:           scheme,
+		Log:              log,
+		vcns:             NewOciVcnServiceManager(provider, credClient, scheme, log),
+		internetGateways: NewOciInternetGatewayServiceManager(provider, credClient, scheme, log),
+		natGateways:      NewOciNatGatewayServiceManager(provider, credClient, scheme, log),
+		routeTables:      NewOciRouteTableServiceManager(provider, credClient, scheme, log),
+		securityLists:    NewOciSecurityListServiceManager(provider, credClient, scheme, log),
+		subnets:          NewOciSubnetServiceManager(provider, credClient, scheme, log),
+	}
+}
+
+// networkChild is one resource of an OciNetwork. object builds the child resource from the network's
+// spec with the OCID recorded in id, so the child manager reconciles the same OCI resource each time.
+type networkChild struct {
+	kind    string
+	id      *ociv1beta1.OCID
+	object  func() runtime.Object
+	manager servicemanager.OSOKServiceManager
+}
+
+const (
+	// childAvailableState is the lifecycle state every child must report before the network is Ready.
+	childAvailableState = "AVAILABLE"
+	// childPendingState is recorded in Status.ChildStatuses for a child the network has not reconciled yet.
+	childPendingState = "PENDING"
+)
+
+// CreateOrUpdate reconciles each child of the OciNetwork in dependency order, stopping at the first
+// child that is not yet available. Status.ChildStatuses and Status.Ready are refreshed on every return.
+func (c *OciNetworkServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	network, err := c.convertNetwork(obj)
+	if err != nil {
+		c.Log.ErrorLog(err, "Conversion of object failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	c.vcns.DefaultTags = c.DefaultTags
+	c.subnets.DefaultTags = c.DefaultTags
+	c.vcns.ProtectedTagNamespaces = c.ProtectedTagNamespaces
+	c.subnets.ProtectedTagNamespaces = c.ProtectedTagNamespaces
+
+	children := c.children(network)
+	defer updateNetworkReadiness(network, children)
+
+	if err := validateNetworkCidrs(network); err != nil {
+		network.Status.OsokStatus = util.UpdateOSOKStatusCondition(network.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Invalid OciNetwork CIDR block")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	for _, child := range children {
+		childObj := child.object()
+		response, err := child.manager.CreateOrUpdate(ctx, childObj, req)
+		childStatus, statusErr := child.manager.GetCrdStatus(childObj)
+		if statusErr == nil && childStatus.Ocid != "" {
+			*child.id = childStatus.Ocid
+		}
+		if statusErr == nil {
+			setChildStatus(network, childStatusKey(child, childObj), childLifecycleState(childStatus))
+		}
+		network.Status.OsokStatus.Ocid = network.Status.VcnId
+
+		if err != nil {
+			network.Status.OsokStatus = util.UpdateOSOKStatusCondition(network.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", fmt.Sprintf("%s: %s", child.kind, err.Error()), c.Log)
+			c.Log.ErrorLog(err, fmt.Sprintf("Error while reconciling the OciNetwork %s", child.kind))
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		if response.ShouldRequeue || !response.IsSuccessful {
+			return c.waitForChild(network, child, childStatus, response), nil
+		}
+	}
+
+	servicemanager.SetCreatedAtIfUnset(&network.Status.OsokStatus)
+	network.Status.OsokStatus = util.UpdateOSOKStatusCondition(network.Status.OsokStatus,
+		ociv1beta1.Active, v1.ConditionTrue, "", fmt.Sprintf("OciNetwork %s is Active", network.Spec.DisplayName), c.Log)
+	return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+}
+
+// setChildStatus records the lifecycle state of one child in Status.ChildStatuses.
+func setChildStatus(network *ociv1beta1.OciNetwork, key, state string) {
+	if network.Status.ChildStatuses == nil {
+		network.Status.ChildStatuses = map[string]string{}
+	}
+	network.Status.ChildStatuses[key] = state
+}
+
+// updateNetworkReadiness marks the children the reconcile did not reach as pending and sets
+// Status.Ready, which is true only when every child is AVAILABLE. A child the reconcile stopped short
+// of keeps the state recorded by an earlier reconcile.
+func updateNetworkReadiness(network *ociv1beta1.OciNetwork, children []networkChild) {
+	ready := true
+	for _, child := range children {
+		key := childStatusKey(child, child.object())
+		state, ok := network.Status.ChildStatuses[key]
+		if !ok {
+			state = childPendingState
+			setChildStatus(network, key, state)
+		}
+		if state != childAvailableState {
+			ready = false
+		}
+	}
+	network.Status.Ready = ready
+}
+
+// childStatusKey names a child in Status.ChildStatuses as <kind>/<name>, for example
+// OciSubnet/my-network-public.
+func childStatusKey(child networkChild, childObj runtime.Object) string {
+	kind := child.kind[strings.LastIndex(child.kind, " ")+1:]
+	accessor, err := meta.Accessor(childObj)
+	if err != nil {
+		return kind
+	}
+	return kind + "/" + accessor.GetName()
+}
+
+// childLifecycleState reads a child's lifecycle state back from its OSOK status. The networking
+// managers mark a child Active only when OCI reports it AVAILABLE, and record the OCI state in Reason
+// when the child is in a terminal state.
+func childLifecycleState(status *ociv1beta1.OSOKStatus) string {
+	if status.Reason != "" {
+		return status.Reason
+	}
+	if len(status.Conditions) == 0 {
+		return childPendingState
+	}
+	switch status.Conditions[len(status.Conditions)-1].Type {
+	case ociv1beta1.Active:
+		return childAvailableState
+	case ociv1beta1.Provisioning:
+		return "PROVISIONING"
+	case ociv1beta1.Updating:
+		return "UPDATING"
+	case ociv1beta1.Terminating:
+		return "TERMINATING"
+	default:
+		return "FAILED"
+	}
+}
+
+// validateNetworkCidrs checks the VCN and subnet CIDR blocks locally, so a network whose subnets do not
+// fit in its VCN fails before any of its resources are created.
+func validateNetworkCidrs(network *ociv1beta1.OciNetwork) error {
+	if err := util.ValidateCidr(network.Spec.CidrBlock, true); err != nil {
+		return err
+	}
+	for _, subnet := range []ociv1beta1.NetworkSubnet{network.Spec.PublicSubnet, network.Spec.PrivateSubnet} {
+		if err := util.ValidateCidr(subnet.CidrBlock, false); err != nil {
+			return err
+		}
+		if err := util.ValidateCidrWithin(subnet.CidrBlock, network.Spec.CidrBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForChild reports a child that is still provisioning, or one that failed without an error.
+func (c *OciNetworkServiceManager) waitForChild(network *ociv1beta1.OciNetwork, child networkChild,
+	childStatus *ociv1beta1.OSOKStatus, response servicemanager.OSOKResponse) servicemanager.OSOKResponse {
+	message := fmt.Sprintf("Waiting for the OciNetwork %s", child.kind)
+	if childStatus != nil && len(childStatus.Conditions) > 0 {
+		message = fmt.Sprintf("%s: %s", child.kind, childStatus.Conditions[len(childStatus.Conditions)-1].Message)
+	}
+	if !response.ShouldRequeue {
+		network.Status.OsokStatus = util.UpdateOSOKStatusCondition(network.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", message, c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}
+	}
+	network.Status.OsokStatus = util.UpdateOSOKStatusCondition(network.Status.OsokStatus,
+		ociv1beta1.Provisioning, v1.ConditionTrue, "", message, c.Log)
+	return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true}
+}
+
+// Delete deletes the OciNetwork's children in reverse dependency order. Each child must be gone before
+// the next one is deleted, so a child still terminating keeps the finalizer in place.
+func (c *OciNetworkServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	network, err := c.convertNetwork(obj)
+	if err != nil {
+		return false, err
+	}
+
+	c.vcns.RetentionTag = c.RetentionTag
+	c.subnets.RetentionTag = c.RetentionTag
+
+	children := c.children(network)
+	for i := len(children) - 1; i >= 0; i-- {
+		child := children[i]
+		if *child.id == "" {
+			continue
+		}
+		done, err := child.manager.Delete(ctx, child.object())
+		if err != nil {
+			c.Log.ErrorLog(err, fmt.Sprintf("Error while deleting the OciNetwork %s", child.kind))
+			return false, err
+		}
+		if !done {
+			c.Log.InfoLog(fmt.Sprintf("Waiting for the OciNetwork %s %s to be deleted", child.kind, *child.id))
+			return false, nil
+		}
+		*child.id = ""
+	}
+	return true, nil
+}
+
+// GetCrdStatus returns the OSOK status from the resource.
+func (c *OciNetworkServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
+	resource, err := c.convertNetwork(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &resource.Status.OsokStatus, nil
+}
+
+func (c *OciNetworkServiceManager) convertNetwork(obj runtime.Object) (*ociv1beta1.OciNetwork, error) {
+	network, ok := obj.(*ociv1beta1.OciNetwork)
+	if !ok {
+		return nil, fmt.Errorf("failed type assertion for OciNetwork")
+	}
+	return network, nil
+}
+
+// children lists the OciNetwork's resources in the order they are created.
+func (c *OciNetworkServiceManager) children(network *ociv1beta1.OciNetwork) []networkChild {
+	status := &network.Status
+	return []networkChild{
+		{kind: "OciVcn", id: &status.VcnId, manager: c.vcns, object: func() runtime.Object {
+			return networkVcn(network)
+		}},
+		{kind: "OciInternetGateway", id: &status.InternetGatewayId, manager: c.internetGateways, object: func() runtime.Object {
+			return networkInternetGateway(network)
+		}},
+		{kind: "OciNatGateway", id: &status.NatGatewayId, manager: c.natGateways, object: func() runtime.Object {
+			return networkNatGateway(network)
+		}},
+		{kind: "public OciRouteTable", id: &status.PublicRouteTableId, manager: c.routeTables, object: func() runtime.Object {
+			return networkRouteTable(network, "public", status.PublicRouteTableId, status.InternetGatewayId)
+		}},
+		{kind: "private OciRouteTable", id: &status.PrivateRouteTableId, manager: c.routeTables, object: func() runtime.Object {
+			return networkRouteTable(network, "private", status.PrivateRouteTableId, status.NatGatewayId)
+		}},
+		{kind: "public OciSecurityList", id: &status.PublicSecurityListId, manager: c.securityLists, object: func() runtime.Object {
+			return networkSecurityList(network, "public", status.PublicSecurityListId, network.Spec.PublicSubnet.IngressSecurityRules)
+		}},
+		{kind: "private OciSecurityList", id: &status.PrivateSecurityListId, manager: c.securityLists, object: func() runtime.Object {
+			// The private subnet accepts all traffic from inside the VCN on top of its own rules.
+			ingress := append([]ociv1beta1.IngressSecurityRule{{Protocol: "all", Source: network.Spec.CidrBlock}},
+				network.Spec.PrivateSubnet.IngressSecurityRules...)
+			return networkSecurityList(network, "private", status.PrivateSecurityListId, ingress)
+		}},
+		{kind: "public OciSubnet", id: &status.PublicSubnetId, manager: c.subnets, object: func() runtime.Object {
+			return networkSubnet(network, "public", network.Spec.PublicSubnet, status.PublicSubnetId,
+				status.PublicRouteTableId, status.PublicSecurityListId, false)
+		}},
+		{kind: "private OciSubnet", id: &status.PrivateSubnetId, manager: c.subnets, object: func() runtime.Object {
+			return networkSubnet(network, "private", network.Spec.PrivateSubnet, status.PrivateSubnetId,
+				status.PrivateRouteTableId, status.PrivateSecurityListId, true)
+		}},
+	}
+}
+
+// networkChildMeta names a child after the OciNetwork so the child managers log and resolve auth
+// secrets in the network's namespace.
+func networkChildMeta(network *ociv1beta1.OciNetwork, suffix string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: network.Name + "-" + suffix, Namespace: network.Namespace}
+}
+
+// networkChildRetryToken derives a child's create retry token from the OciNetwork's UID. Children are
+// rebuilt on every reconcile, so a token reserved in their status would be lost; a derived token is the
+// same after a restart and still keeps OCI from creating the child twice.
+func networkChildRetryToken(network *ociv1beta1.OciNetwork, suffix string) string {
+	sum := sha256.Sum256([]byte(string(network.UID) + "/" + suffix))
+	return hex.EncodeToString(sum[:16])
+}
+
+func networkVcn(network *ociv1beta1.OciNetwork) *ociv1beta1.OciVcn {
+	vcn := &ociv1beta1.OciVcn{ObjectMeta: networkChildMeta(network, "vcn")}
+	vcn.Spec = ociv1beta1.OciVcnSpec{
+		CompartmentId: network.Spec.CompartmentId,
+		DisplayName:   network.Spec.DisplayName,
+		CidrBlock:     network.Spec.CidrBlock,
+		DnsLabel:      network.Spec.DnsLabel,
+		AuthSecretRef: network.Spec.AuthSecretRef,
+		Region:        network.Spec.Region,
+		TagResources:  network.Spec.TagResources,
+	}
+	vcn.Status.OsokStatus.Ocid = network.Status.VcnId
+	vcn.Status.CreateRetryToken = networkChildRetryToken(network, "vcn")
+	return vcn
+}
+
+func networkInternetGateway(network *ociv1beta1.OciNetwork) *ociv1beta1.OciInternetGateway {
+	igw := &ociv1beta1.OciInternetGateway{ObjectMeta: networkChildMeta(network, "igw")}
+	igw.Spec = ociv1beta1.OciInternetGatewaySpec{
+		CompartmentId: network.Spec.CompartmentId,
+		VcnId:         network.Status.VcnId,
+		DisplayName:   network.Spec.DisplayName + "-igw",
+		IsEnabled:     true,
+		AuthSecretRef: network.Spec.AuthSecretRef,
+		Region:        network.Spec.Region,
+		TagResources:  network.Spec.TagResources,
+	}
+	igw.Status.OsokStatus.Ocid = network.Status.InternetGatewayId
+	return igw
+}
+
+func networkNatGateway(network *ociv1beta1.OciNetwork) *ociv1beta1.OciNatGateway {
+	nat := &ociv1beta1.OciNatGateway{ObjectMeta: networkChildMeta(network, "nat")}
+	nat.Spec = ociv1beta1.OciNatGatewaySpec{
+		CompartmentId: network.Spec.CompartmentId,
+		VcnId:         network.Status.VcnId,
+		DisplayName:   network.Spec.DisplayName + "-nat",
+		AuthSecretRef: network.Spec.AuthSecretRef,
+		Region:        network.Spec.Region,
+		TagResources:  network.Spec.TagResources,
+	}
+	nat.Status.OsokStatus.Ocid = network.Status.NatGatewayId
+	return nat
+}
+
+func networkRouteTable(network *ociv1beta1.OciNetwork, tier string, id, gatewayID ociv1beta1.OCID) *ociv1beta1.OciRouteTable {
+	rt := &ociv1beta1.OciRouteTable{ObjectMeta: networkChildMeta(network, tier+"-rt")}
+	rt.Spec = ociv1beta1.OciRouteTableSpec{
+		RouteTableType: "VCN",
+		CompartmentId:  network.Spec.CompartmentId,
+		VcnId:          network.Status.VcnId,
+		DisplayName:    network.Spec.DisplayName + "-" + tier + "-rt",
+		RouteRules: []ociv1beta1.RouteRule{{
+			NetworkEntityId: string(gatewayID),
+			Destination:     defaultRouteDestination,
+		}},
+		AuthSecretRef: network.Spec.AuthSecretRef,
+		Region:        network.Spec.Region,
+		TagResources:  network.Spec.TagResources,
+	}
+	rt.Status.OsokStatus.Ocid = id
+	return rt
+}
+
+func networkSecurityList(network *ociv1beta1.OciNetwork, tier string, id ociv1beta1.OCID,
+	ingress []ociv1beta1.IngressSecurityRule) *ociv1beta1.OciSecurityList {
+	sl := &ociv1beta1.OciSecurityList{ObjectMeta: networkChildMeta(network, tier+"-sl")}
+	sl.Spec = ociv1beta1.OciSecurityListSpec{
+		CompartmentId:        network.Spec.CompartmentId,
+		VcnId:                network.Status.VcnId,
+		DisplayName:          network.Spec.DisplayName + "-" + tier + "-sl",
+		IngressSecurityRules: ingress,
+		EgressSecurityRules:  []ociv1beta1.EgressSecurityRule{{Protocol: "all", Destination: defaultRouteDestination}},
+		AuthSecretRef:        network.Spec.AuthSecretRef,
+		Region:               network.Spec.Region,
+		TagResources:         network.Spec.TagResources,
+	}
+	sl.Status.OsokStatus.Ocid = id
+	return sl
+}
+
+func networkSubnet(network *ociv1beta1.OciNetwork, tier string, subnetSpec ociv1beta1.NetworkSubnet,
+	id, routeTableID, securityListID ociv1beta1.OCID, private bool) *ociv1beta1.OciSubnet {
+	subnet := &ociv1beta1.OciSubnet{ObjectMeta: networkChildMeta(network, tier)}
+	subnet.Spec = ociv1beta1.OciSubnetSpec{
+		CompartmentId:          network.Spec.CompartmentId,
+		DisplayName:            network.Spec.DisplayName + "-" + tier,
+		VcnId:                  network.Status.VcnId,
+		CidrBlock:              subnetSpec.CidrBlock,
+		DnsLabel:               subnetSpec.DnsLabel,
+		ProhibitPublicIpOnVnic: private,
+		RouteTableId:           routeTableID,
+		SecurityListIds:        []ociv1beta1.OCID{securityListID},
+		AuthSecretRef:          network.Spec.AuthSecretRef,
+		Region:                 network.Spec.Region,
+		TagResources:           network.Spec.TagResources,
+	}
+	subnet.Status.OsokStatus.Ocid = id
+	subnet.Status.CreateRetryToken = networkChildRetryToken(network, tier)
+	return subnet
+}
@@ -665,6 +665,7 @@ func TestPropertyNetworkingStatusIDUsesTrackedResourceForUpdates(t *testing.T) {
 				mgr := securityListMgrWithFake(fake)
 				sl := &ociv1beta1.OciSecurityList{}
 				sl.Status.OsokStatus.Ocid = ociv1beta1.OCID(slID)
+				sl.Spec.DisplayName = "new-sl"
 				sl.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
 
 				resp, err := mgr.CreateOrUpdate(context.Background(), sl, ctrl.Request{})
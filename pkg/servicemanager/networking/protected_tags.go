@@ -0,0 +1,44 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+)
+
+// DefaultProtectedTagNamespaces are the defined-tag namespaces left intact when no others are configured.
+// Oracle-Tags holds tags OCI sets itself, such as Oracle-Tags.CreatedBy and Oracle-Tags.CreatedOn.
+var DefaultProtectedTagNamespaces = []string{"Oracle-Tags"}
+
+// withProtectedDefinedTags returns the desired defined tags with each protected namespace replaced by its
+// live value, so an update never removes or rewrites those tags. A protected namespace the live resource
+// does not carry is dropped. Nil desired tags leave defined tags unmanaged and are returned unchanged.
+func withProtectedDefinedTags(desired map[string]ociv1beta1.MapValue, live map[string]map[string]interface{},
+	protected []string) map[string]ociv1beta1.MapValue {
+	if desired == nil || len(protected) == 0 {
+		return desired
+	}
+
+	result := make(map[string]ociv1beta1.MapValue, len(desired)+len(protected))
+	for namespace, tags := range desired {
+		result[namespace] = tags
+	}
+	for _, namespace := range protected {
+		liveTags, ok := live[namespace]
+		if !ok {
+			delete(result, namespace)
+			continue
+		}
+		tags := make(ociv1beta1.MapValue, len(liveTags))
+		for key, value := range liveTags {
+			tags[key] = fmt.Sprint(value)
+		}
+		result[namespace] = tags
+	}
+	return result
+}
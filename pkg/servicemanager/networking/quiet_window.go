@@ -0,0 +1,92 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// mutationDeferredReason is the event reason used when a create, update or delete waits for the quiet
+// window to close.
+const mutationDeferredReason = "MutationDeferred"
+
+// errQuietWindow is returned by a create deferred by the quiet window.
+var errQuietWindow = errors.New("changes are deferred until the quiet window closes")
+
+// quietWindowEnd returns when the quiet window in effect now closes, or false outside the window.
+func quietWindowEnd(spec string) (time.Time, bool) {
+	return util.QuietWindowEnd(time.Now(), spec)
+}
+
+// withQuietWindow returns ops unchanged outside the quiet window. Inside it, Update does nothing and
+// Create fails with errQuietWindow, so an existing resource is still read and its status refreshed but
+// nothing changes in OCI.
+func withQuietWindow[T any](ops networkingCreateOrUpdateOps[T], quiet bool) networkingCreateOrUpdateOps[T] {
+	if !quiet {
+		return ops
+	}
+	ops.Update = func() error { return nil }
+	ops.Create = func() (*T, error) { return nil, errQuietWindow }
+	onCreateError := ops.OnCreateError
+	ops.OnCreateError = func(err error) {
+		if !errors.Is(err, errQuietWindow) && onCreateError != nil {
+			onCreateError(err)
+		}
+	}
+	return ops
+}
+
+// deferredCreateResponse reports a create held back by the quiet window and requeues the resource for
+// when the window closes.
+func deferredCreateResponse(recorder record.EventRecorder, obj client.Object, status *ociv1beta1.OSOKStatus,
+	kind, displayName string, until time.Time, log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
+	message := fmt.Sprintf("Create of %s %s is deferred until the quiet window closes at %s",
+		kind, displayName, until.UTC().Format(time.RFC3339))
+	*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "", message, log)
+	recordMutationDeferred(recorder, obj, log, message)
+	return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true, RequeueDuration: time.Until(until)}
+}
+
+// requeueAfterQuietWindow makes a reconcile that ran inside the quiet window requeue when it closes, so
+// updates skipped during the window are applied then. The applied spec is not recorded meanwhile.
+func requeueAfterQuietWindow(response servicemanager.OSOKResponse, until time.Time) servicemanager.OSOKResponse {
+	wait := time.Until(until)
+	if !response.ShouldRequeue || response.RequeueDuration <= 0 || response.RequeueDuration > wait {
+		response.ShouldRequeue = true
+		response.RequeueDuration = wait
+	}
+	return response
+}
+
+// deferDeleteForQuietWindow reports whether a delete should wait for the quiet window to close. It emits
+// an event naming when the window closes.
+func deferDeleteForQuietWindow(recorder record.EventRecorder, obj client.Object, log loggerutil.OSOKLogger,
+	kind string, resourceID ociv1beta1.OCID, spec string) bool {
+	until, quiet := quietWindowEnd(spec)
+	if !quiet {
+		return false
+	}
+	recordMutationDeferred(recorder, obj, log, fmt.Sprintf("Delete of %s %s is deferred until the quiet window closes at %s",
+		kind, resourceID, until.UTC().Format(time.RFC3339)))
+	return true
+}
+
+func recordMutationDeferred(recorder record.EventRecorder, obj client.Object, log loggerutil.OSOKLogger, message string) {
+	log.InfoLog(message)
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeNormal, mutationDeferredReason, message)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"strings"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// deleteBlockedByRetentionTagReason is the event reason used when a retention tag stops a delete.
+const deleteBlockedByRetentionTagReason = "DeleteBlockedByRetentionTag"
+
+// RetentionTag names an OCI defined tag that protects a live resource from deletion by the operator.
+// The zero value disables the check.
+type RetentionTag struct {
+	Namespace string
+	Key       string
+}
+
+// ParseRetentionTag parses a retention tag written as "<namespace>.<key>". An empty value disables the check.
+func ParseRetentionTag(value string) (RetentionTag, error) {
+	if value == "" {
+		return RetentionTag{}, nil
+	}
+	namespace, key, found := strings.Cut(value, ".")
+	if !found || namespace == "" || key == "" {
+		return RetentionTag{}, fmt.Errorf("retention tag %q must be written as <namespace>.<key>", value)
+	}
+	return RetentionTag{Namespace: namespace, Key: key}, nil
+}
+
+// Enabled reports whether a retention tag is configured.
+func (t RetentionTag) Enabled() bool {
+	return t.Namespace != "" && t.Key != ""
+}
+
+func (t RetentionTag) String() string {
+	if !t.Enabled() {
+		return ""
+	}
+	return t.Namespace + "." + t.Key
+}
+
+// retains reports whether the defined tags carry the retention tag, whatever its value.
+func (t RetentionTag) retains(definedTags map[string]map[string]interface{}) bool {
+	if !t.Enabled() {
+		return false
+	}
+	_, ok := definedTags[t.Namespace][t.Key]
+	return ok
+}
+
+// blockDeleteForRetention reports whether the live resource carries the retention tag. When it does,
+// a warning event is emitted so the finalizer staying in place is explained on the resource.
+func blockDeleteForRetention(recorder record.EventRecorder, obj runtime.Object, log loggerutil.OSOKLogger,
+	kind string, resourceID ociv1beta1.OCID, tag RetentionTag, definedTags map[string]map[string]interface{}) bool {
+	if !tag.retains(definedTags) {
+		return false
+	}
+	message := fmt.Sprintf("%s %s carries the retention tag %s; it will not be deleted until the tag is removed in OCI",
+		kind, resourceID, tag)
+	log.InfoLog(message)
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeWarning, deleteBlockedByRetentionTagReason, message)
+	}
+	return true
+}
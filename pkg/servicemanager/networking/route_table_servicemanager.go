@@ -18,6 +18,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -30,6 +31,7 @@ type OciRouteTableServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
+	Recorder         record.EventRecorder
 	ociClient        VirtualNetworkClientInterface
 }
 
@@ -52,6 +54,18 @@ func (c *OciRouteTableServiceManager) CreateOrUpdate(ctx context.Context, obj ru
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, rt.Namespace, rt.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, rt.Spec.Region)
+	rt.Status.EffectiveRoutes = effectiveRouteOrder(rt.Spec.RouteRules)
+
+	if isDrgRouteTable(*rt) {
+		return c.createOrUpdateDrgRouteTable(ctx, rt)
+	}
+
 	rtInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.RouteTable]{
 		SpecID: rt.Spec.RouteTableId,
 		Status: &rt.Status.OsokStatus,
@@ -82,6 +96,54 @@ func (c *OciRouteTableServiceManager) CreateOrUpdate(ctx context.Context, obj ru
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	response := reconcileLifecycleStatus(&rt.Status.OsokStatus, "OciRouteTable", safeString(rtInstance.DisplayName),
+		string(rtInstance.LifecycleState), ociv1beta1.OCID(*rtInstance.Id), c.Log)
+	if !response.IsSuccessful || len(rt.Spec.AttachToSubnetIds) == 0 {
+		return response, nil
+	}
+
+	// Subnets can only reference an AVAILABLE route table, so attach after the lifecycle check.
+	if err := c.AttachRouteTableToSubnets(ctx, ociv1beta1.OCID(*rtInstance.Id), rt.Spec.AttachToSubnetIds); err != nil {
+		rt.Status.OsokStatus = util.UpdateOSOKStatusCondition(rt.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Error while attaching OciRouteTable to subnets")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	return response, nil
+}
+
+// createOrUpdateDrgRouteTable reconciles a DRG route table through the DRG route table API.
+func (c *OciRouteTableServiceManager) createOrUpdateDrgRouteTable(ctx context.Context, rt *ociv1beta1.OciRouteTable) (servicemanager.OSOKResponse, error) {
+	rtInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.DrgRouteTable]{
+		SpecID: rt.Spec.RouteTableId,
+		Status: &rt.Status.OsokStatus,
+		Get: func(id ociv1beta1.OCID) (*ocicore.DrgRouteTable, error) {
+			return c.GetDrgRouteTable(ctx, id)
+		},
+		Update: func() error {
+			return c.UpdateDrgRouteTable(ctx, rt)
+		},
+		Lookup: func() (*ociv1beta1.OCID, error) {
+			return c.GetDrgRouteTableOcid(ctx, *rt)
+		},
+		Create: func() (*ocicore.DrgRouteTable, error) {
+			return c.CreateDrgRouteTable(ctx, *rt)
+		},
+		OnCreateError: func(err error) {
+			rt.Status.OsokStatus = util.UpdateOSOKStatusCondition(rt.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+			c.Log.ErrorLog(err, "Create DRG OciRouteTable failed")
+		},
+		Log:            c.Log,
+		GetExistingMsg: "Error while getting existing DRG OciRouteTable",
+		GetStatusMsg:   "Error while getting existing DRG OciRouteTable from status OCID",
+		GetByOCIDMsg:   "Error while getting DRG OciRouteTable by OCID",
+		UpdateMsg:      "Error while updating DRG OciRouteTable",
+	})
+	if err != nil {
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
 	return reconcileLifecycleStatus(&rt.Status.OsokStatus, "OciRouteTable", safeString(rtInstance.DisplayName),
 		string(rtInstance.LifecycleState), ociv1beta1.OCID(*rtInstance.Id), c.Log), nil
 }
@@ -93,6 +155,13 @@ func (c *OciRouteTableServiceManager) Delete(ctx context.Context, obj runtime.Ob
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, rt.Namespace, rt.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, rt.Spec.Region)
+
 	resourceID := rt.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = rt.Spec.RouteTableId
@@ -102,14 +171,35 @@ func (c *OciRouteTableServiceManager) Delete(ctx context.Context, obj runtime.Ob
 		return true, nil
 	}
 
+	if !isDrgRouteTable(*rt) {
+		if err := c.DetachRouteTableFromSubnets(ctx, resourceID, rt.Spec.VcnId, rt.Spec.AttachToSubnetIds); err != nil {
+			c.Log.ErrorLog(err, "Error while detaching OciRouteTable from subnets")
+			return false, err
+		}
+		subnetIDs, err := c.SubnetsUsingRouteTable(ctx, resourceID, rt.Spec.CompartmentId, rt.Spec.VcnId)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error while listing subnets that use OciRouteTable")
+			return false, err
+		}
+		if blockDeleteForSubnets(c.Recorder, rt, c.Log, "OciRouteTable", resourceID, subnetIDs) {
+			return false, nil
+		}
+	}
+
 	c.Log.InfoLog(fmt.Sprintf("Deleting OciRouteTable %s", resourceID))
-	done, err := deleteResourceAndWait(
-		func() error { return c.DeleteRouteTable(ctx, resourceID) },
-		func() error {
-			_, getErr := c.GetRouteTable(ctx, resourceID)
+	deleteFn := func() error { return c.DeleteRouteTable(ctx, resourceID) }
+	getFn := func() error {
+		_, getErr := c.GetRouteTable(ctx, resourceID)
+		return getErr
+	}
+	if isDrgRouteTable(*rt) {
+		deleteFn = func() error { return c.DeleteDrgRouteTable(ctx, resourceID) }
+		getFn = func() error {
+			_, getErr := c.GetDrgRouteTable(ctx, resourceID)
 			return getErr
-		},
-	)
+		}
+	}
+	done, err := deleteResourceAndWait(deleteFn, getFn)
 	if err != nil {
 		c.Log.ErrorLog(err, "Error while deleting OciRouteTable")
 		return false, err
@@ -118,6 +208,12 @@ func (c *OciRouteTableServiceManager) Delete(ctx context.Context, obj runtime.Ob
 	return done, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec; route rules name their targets
+// by OCID.
+func (c *OciRouteTableServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciRouteTableServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertRouteTable(obj)
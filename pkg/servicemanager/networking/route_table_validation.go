@@ -0,0 +1,158 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Event reasons for route rules that are skipped instead of sent to OCI.
+const (
+	duplicateRouteDestinationReason = "DuplicateRouteDestination"
+	conflictingDefaultRouteReason   = "ConflictingDefaultRoute"
+	routeDestinationIsVcnCidrReason = "RouteDestinationIsVcnCidr"
+)
+
+const defaultRouteDestination = "0.0.0.0/0"
+
+// routeRuleWarning describes a suspicious route rule that was left out of the applied rules.
+type routeRuleWarning struct {
+	Reason  string
+	Message string
+}
+
+// checkRouteRules returns the rules that are safe to apply and a warning for each one that is
+// skipped: a repeat of an earlier destination, a default route that competes with an internet
+// gateway default, or a destination equal to one of the VCN CIDRs, which OCI already routes locally.
+func checkRouteRules(rules []ociv1beta1.RouteRule, vcnCidrs []string) ([]ociv1beta1.RouteRule, []routeRuleWarning) {
+	vcnCidrSet := make(map[string]bool, len(vcnCidrs))
+	for _, cidr := range vcnCidrs {
+		vcnCidrSet[cidr] = true
+	}
+
+	var igwDefault *ociv1beta1.RouteRule
+	for i := range rules {
+		if isCidrRouteRule(rules[i]) && rules[i].Destination == defaultRouteDestination &&
+			isNetworkEntityKind(rules[i].NetworkEntityId, "internetgateway") {
+			igwDefault = &rules[i]
+			break
+		}
+	}
+
+	valid := make([]ociv1beta1.RouteRule, 0, len(rules))
+	var warnings []routeRuleWarning
+	seen := map[string]int{}
+	for i, rule := range rules {
+		key := routeRuleDestinationType(rule) + "|" + rule.Destination
+		if isCidrRouteRule(rule) && rule.Destination == defaultRouteDestination && igwDefault != nil &&
+			isNetworkEntityKind(rule.NetworkEntityId, "drg") {
+			warnings = append(warnings, routeRuleWarning{
+				Reason: conflictingDefaultRouteReason,
+				Message: fmt.Sprintf("route rule %d sends %s to DRG %s but the table already has a default route to internet gateway %s; skipping it",
+					i, defaultRouteDestination, rule.NetworkEntityId, igwDefault.NetworkEntityId),
+			})
+			continue
+		}
+		if first, ok := seen[key]; ok {
+			warnings = append(warnings, routeRuleWarning{
+				Reason:  duplicateRouteDestinationReason,
+				Message: fmt.Sprintf("route rule %d repeats destination %s from rule %d; skipping it", i, rule.Destination, first),
+			})
+			continue
+		}
+		if isCidrRouteRule(rule) && vcnCidrSet[rule.Destination] {
+			warnings = append(warnings, routeRuleWarning{
+				Reason:  routeDestinationIsVcnCidrReason,
+				Message: fmt.Sprintf("route rule %d targets the VCN CIDR %s, which OCI routes locally; skipping it", i, rule.Destination),
+			})
+			continue
+		}
+		seen[key] = i
+		valid = append(valid, rule)
+	}
+	return valid, warnings
+}
+
+// effectiveRouteOrder returns the rules in the order OCI evaluates them: CIDR destinations by
+// longest prefix first, then service destinations and unparsable CIDRs in spec order.
+func effectiveRouteOrder(rules []ociv1beta1.RouteRule) []ociv1beta1.RouteRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	ordered := make([]ociv1beta1.RouteRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return routePrefixLength(ordered[i]) > routePrefixLength(ordered[j])
+	})
+	return ordered
+}
+
+// routePrefixLength returns the prefix length of a CIDR route rule, or -1 for rules without one.
+func routePrefixLength(rule ociv1beta1.RouteRule) int {
+	if !isCidrRouteRule(rule) {
+		return -1
+	}
+	_, ipNet, err := net.ParseCIDR(rule.Destination)
+	if err != nil {
+		return -1
+	}
+	ones, _ := ipNet.Mask.Size()
+	return ones
+}
+
+func routeRuleDestinationType(rule ociv1beta1.RouteRule) string {
+	if rule.DestinationType == "" {
+		return string(ocicore.RouteRuleDestinationTypeCidrBlock)
+	}
+	return rule.DestinationType
+}
+
+func isCidrRouteRule(rule ociv1beta1.RouteRule) bool {
+	return routeRuleDestinationType(rule) == string(ocicore.RouteRuleDestinationTypeCidrBlock)
+}
+
+// isNetworkEntityKind reports whether an OCID names the given resource type, e.g. "drg" for ocid1.drg.oc1...
+func isNetworkEntityKind(ocid, kind string) bool {
+	return strings.HasPrefix(ocid, "ocid1."+kind+".")
+}
+
+// applicableRouteRules drops suspicious rules from the spec, emitting a warning event for each one.
+func (c *OciRouteTableServiceManager) applicableRouteRules(ctx context.Context, client VirtualNetworkClientInterface,
+	rt *ociv1beta1.OciRouteTable) ([]ociv1beta1.RouteRule, error) {
+	if len(rt.Spec.RouteRules) == 0 {
+		return nil, nil
+	}
+
+	var vcnCidrs []string
+	if rt.Spec.VcnId != "" {
+		resp, err := client.GetVcn(ctx, ocicore.GetVcnRequest{VcnId: common.String(string(rt.Spec.VcnId))})
+		if err != nil {
+			return nil, err
+		}
+		vcnCidrs = append(vcnCidrs, resp.CidrBlocks...)
+		if resp.CidrBlock != nil {
+			vcnCidrs = append(vcnCidrs, *resp.CidrBlock)
+		}
+	}
+
+	valid, warnings := checkRouteRules(rt.Spec.RouteRules, vcnCidrs)
+	for _, warning := range warnings {
+		c.Log.InfoLog(fmt.Sprintf("OciRouteTable %s: %s", rt.Spec.DisplayName, warning.Message))
+		if c.Recorder != nil {
+			c.Recorder.Event(rt, v1.EventTypeWarning, warning.Reason, warning.Message)
+		}
+	}
+	return valid, nil
+}
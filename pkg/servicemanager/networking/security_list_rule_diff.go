@@ -0,0 +1,170 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// securityRulesUpdatedReason is the event reason for an update that changed the rules of a Security List.
+const securityRulesUpdatedReason = "SecurityRulesUpdated"
+
+// maxDescribedRuleChanges caps how many rules of each kind of change an event names.
+const maxDescribedRuleChanges = 3
+
+// securityProtocolNames names the protocol numbers the webhook accepts as aliases.
+var securityProtocolNames = map[string]string{
+	"6":  "tcp",
+	"17": "udp",
+	"1":  "icmp",
+	"58": "icmpv6",
+}
+
+// securityRuleDiff holds brief descriptions of the rules an update adds, removes and changes. A changed
+// rule differs from a live rule only in its description.
+type securityRuleDiff struct {
+	added   []string
+	removed []string
+	changed []string
+}
+
+func (d securityRuleDiff) empty() bool {
+	return len(d.added) == 0 && len(d.removed) == 0 && len(d.changed) == 0
+}
+
+// summary returns the counts of the diff followed by the first few rules of each kind of change.
+func (d securityRuleDiff) summary() string {
+	message := fmt.Sprintf("%d added, %d removed, %d changed", len(d.added), len(d.removed), len(d.changed))
+	var details []string
+	for _, part := range []struct {
+		label string
+		rules []string
+	}{{"added", d.added}, {"removed", d.removed}, {"changed", d.changed}} {
+		if len(part.rules) == 0 {
+			continue
+		}
+		shown := part.rules
+		more := ""
+		if len(shown) > maxDescribedRuleChanges {
+			more = fmt.Sprintf(" and %d more", len(shown)-maxDescribedRuleChanges)
+			shown = shown[:maxDescribedRuleChanges]
+		}
+		details = append(details, fmt.Sprintf("%s %s%s", part.label, strings.Join(shown, ", "), more))
+	}
+	if len(details) == 0 {
+		return message
+	}
+	return message + ": " + strings.Join(details, "; ")
+}
+
+// diffSecurityRules compares the rules an update sends with the live rules, in the same canonical form
+// ingressRulesMatch and egressRulesMatch compare them in.
+func diffSecurityRules(desiredIngress []ocicore.IngressSecurityRule, desiredEgress []ocicore.EgressSecurityRule,
+	existing *ocicore.SecurityList) securityRuleDiff {
+	diff := securityRuleDiff{}
+	diffRules(&diff, canonicalIngressRules(desiredIngress), canonicalIngressRules(existing.IngressSecurityRules),
+		describeIngressRule, func(rule ociv1beta1.IngressSecurityRule) ociv1beta1.IngressSecurityRule {
+			rule.Description = ""
+			return rule
+		})
+	diffRules(&diff, canonicalEgressRules(desiredEgress), canonicalEgressRules(existing.EgressSecurityRules),
+		describeEgressRule, func(rule ociv1beta1.EgressSecurityRule) ociv1beta1.EgressSecurityRule {
+			rule.Description = ""
+			return rule
+		})
+	return diff
+}
+
+// diffRules adds to diff the desired rules with no equal live rule and the live rules with no equal
+// desired rule. A desired and a live rule that are equal apart from their description count as changed.
+func diffRules[T any](diff *securityRuleDiff, desired, existing []T, describe func(T) string, withoutDescription func(T) T) {
+	_, desired, existing = pairRules(desired, existing, func(rule T) T { return rule })
+	changed, added, removed := pairRules(desired, existing, withoutDescription)
+	for _, rule := range added {
+		diff.added = append(diff.added, describe(rule))
+	}
+	for _, rule := range removed {
+		diff.removed = append(diff.removed, describe(rule))
+	}
+	for _, rule := range changed {
+		diff.changed = append(diff.changed, describe(rule))
+	}
+}
+
+// pairRules pairs each rule of a with an equal rule of b, comparing the rules as key returns them. It
+// returns the rules of a that were paired, the rules of a left without a pair and the rules of b left
+// without a pair.
+func pairRules[T any](a, b []T, key func(T) T) ([]T, []T, []T) {
+	remaining := append([]T(nil), b...)
+	var paired, unpaired []T
+	for _, rule := range a {
+		found := -1
+		for i, other := range remaining {
+			if reflect.DeepEqual(key(rule), key(other)) {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			unpaired = append(unpaired, rule)
+			continue
+		}
+		paired = append(paired, rule)
+		remaining = append(remaining[:found], remaining[found+1:]...)
+	}
+	return paired, unpaired, remaining
+}
+
+// recordRuleDiff emits an event summarizing the rules an update added, removed and changed.
+func (c *OciSecurityListServiceManager) recordRuleDiff(sl *ociv1beta1.OciSecurityList, diff securityRuleDiff) {
+	if diff.empty() {
+		return
+	}
+	message := "Updated security rules: " + diff.summary()
+	c.Log.InfoLog(fmt.Sprintf("OciSecurityList %s: %s", sl.Spec.DisplayName, message))
+	if c.Recorder != nil {
+		c.Recorder.Event(sl, v1.EventTypeNormal, securityRulesUpdatedReason, message)
+	}
+}
+
+func describeIngressRule(rule ociv1beta1.IngressSecurityRule) string {
+	return fmt.Sprintf("ingress %s from %s%s", protocolName(rule.Protocol), rule.Source, describeRulePorts(rule.TcpOptions, rule.UdpOptions))
+}
+
+func describeEgressRule(rule ociv1beta1.EgressSecurityRule) string {
+	return fmt.Sprintf("egress %s to %s%s", protocolName(rule.Protocol), rule.Destination, describeRulePorts(rule.TcpOptions, rule.UdpOptions))
+}
+
+func protocolName(protocol string) string {
+	if name, ok := securityProtocolNames[protocol]; ok {
+		return name
+	}
+	return protocol
+}
+
+// describeRulePorts returns the destination ports of the rule, or an empty string when it matches all ports.
+func describeRulePorts(tcp *ociv1beta1.TcpOptions, udp *ociv1beta1.UdpOptions) string {
+	var portRange *ociv1beta1.PortRange
+	switch {
+	case tcp != nil:
+		portRange = tcp.DestinationPortRange
+	case udp != nil:
+		portRange = udp.DestinationPortRange
+	}
+	if portRange == nil {
+		return ""
+	}
+	if portRange.Min == portRange.Max {
+		return fmt.Sprintf(" port %d", portRange.Min)
+	}
+	return fmt.Sprintf(" ports %d-%d", portRange.Min, portRange.Max)
+}
@@ -0,0 +1,181 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// Compile-time check that OciSecurityListServiceManager reports changed rule sets.
+var _ servicemanager.PendingActionReporter = &OciSecurityListServiceManager{}
+
+// readRuleSets reads the OciSecurityRuleSets named in Spec.RuleSetRefs, in order, followed by the rules
+// of Spec.RulesFromConfigMap as one more rule set. It returns nil when neither is set.
+func (c *OciSecurityListServiceManager) readRuleSets(ctx context.Context,
+	sl *ociv1beta1.OciSecurityList) ([]ociv1beta1.OciSecurityRuleSet, error) {
+	if !usesExternalRules(sl) {
+		return nil, nil
+	}
+	if c.KubeClient == nil {
+		return nil, errors.New("ruleSetRefs or rulesFromConfigMap is set but no Kubernetes client is configured to read them")
+	}
+
+	ruleSets := make([]ociv1beta1.OciSecurityRuleSet, 0, len(sl.Spec.RuleSetRefs)+1)
+	for _, name := range sl.Spec.RuleSetRefs {
+		ruleSet := ociv1beta1.OciSecurityRuleSet{}
+		if err := c.KubeClient.Get(ctx, types.NamespacedName{Namespace: sl.Namespace, Name: name}, &ruleSet); err != nil {
+			return nil, fmt.Errorf("reading OciSecurityRuleSet %s/%s: %w", sl.Namespace, name, err)
+		}
+		ruleSets = append(ruleSets, ruleSet)
+	}
+
+	if source := sl.Spec.RulesFromConfigMap; source != nil {
+		ruleSet, err := c.readConfigMapRules(ctx, sl.Namespace, *source)
+		if err != nil {
+			return nil, err
+		}
+		ruleSets = append(ruleSets, ruleSet)
+	}
+	return ruleSets, nil
+}
+
+// usesExternalRules reports whether the Security List takes rules from outside its own spec.
+func usesExternalRules(sl *ociv1beta1.OciSecurityList) bool {
+	return len(sl.Spec.RuleSetRefs) > 0 || sl.Spec.RulesFromConfigMap != nil
+}
+
+// readConfigMapRules parses the ConfigMap entry named by source, which holds the fields of an
+// OciSecurityRuleSet spec as YAML or JSON. Unknown fields are rejected, so a misspelled field is reported
+// instead of silently dropping its rules.
+func (c *OciSecurityListServiceManager) readConfigMapRules(ctx context.Context, namespace string,
+	source ociv1beta1.ConfigMapRulesSource) (ociv1beta1.OciSecurityRuleSet, error) {
+	configMap := corev1.ConfigMap{}
+	if err := c.KubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: source.Name}, &configMap); err != nil {
+		return ociv1beta1.OciSecurityRuleSet{}, fmt.Errorf("reading ConfigMap %s/%s: %w", namespace, source.Name, err)
+	}
+	data, ok := configMap.Data[source.Key]
+	if !ok {
+		return ociv1beta1.OciSecurityRuleSet{}, fmt.Errorf("ConfigMap %s/%s has no key %q", namespace, source.Name, source.Key)
+	}
+
+	ruleSet := ociv1beta1.OciSecurityRuleSet{}
+	if err := yaml.UnmarshalStrict([]byte(data), &ruleSet.Spec); err != nil {
+		return ociv1beta1.OciSecurityRuleSet{}, fmt.Errorf("parsing rules in key %q of ConfigMap %s/%s: %w",
+			source.Key, namespace, source.Name, err)
+	}
+	return ruleSet, nil
+}
+
+// ruleSetsHash returns a SHA-256 of the rules of the rule sets, recorded in status so a changed rule set
+// can be detected while the OciSecurityList spec is unchanged.
+func ruleSetsHash(ruleSets []ociv1beta1.OciSecurityRuleSet) string {
+	specs := make([]ociv1beta1.OciSecurityRuleSetSpec, 0, len(ruleSets))
+	for _, ruleSet := range ruleSets {
+		specs = append(specs, ruleSet.Spec)
+	}
+	data, _ := json.Marshal(specs)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// expandIngressRules appends the ingress rules of the rule sets to the inline rules. A rule that differs
+// from an earlier one only in its description is dropped, so an inline rule wins over a rule set.
+func expandIngressRules(inline []ociv1beta1.IngressSecurityRule,
+	ruleSets []ociv1beta1.OciSecurityRuleSet) []ociv1beta1.IngressSecurityRule {
+	rules := append([]ociv1beta1.IngressSecurityRule(nil), inline...)
+	for _, ruleSet := range ruleSets {
+		for _, rule := range ruleSet.Spec.IngressSecurityRules {
+			if !containsIngressRule(rules, rule) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+// expandEgressRules is the egress counterpart of expandIngressRules.
+func expandEgressRules(inline []ociv1beta1.EgressSecurityRule,
+	ruleSets []ociv1beta1.OciSecurityRuleSet) []ociv1beta1.EgressSecurityRule {
+	rules := append([]ociv1beta1.EgressSecurityRule(nil), inline...)
+	for _, ruleSet := range ruleSets {
+		for _, rule := range ruleSet.Spec.EgressSecurityRules {
+			if !containsEgressRule(rules, rule) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+func containsIngressRule(rules []ociv1beta1.IngressSecurityRule, rule ociv1beta1.IngressSecurityRule) bool {
+	rule.Description = ""
+	for _, existing := range rules {
+		existing.Description = ""
+		if reflect.DeepEqual(existing, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEgressRule(rules []ociv1beta1.EgressSecurityRule, rule ociv1beta1.EgressSecurityRule) bool {
+	rule.Description = ""
+	for _, existing := range rules {
+		existing.Description = ""
+		if reflect.DeepEqual(existing, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRuleSets adds the rules of Spec.RuleSetRefs to the spec's inline rules for the duration of a
+// reconcile, so the create and update paths send them like inline rules. The returned function restores
+// the spec, which keeps the expanded rules out of the applied spec. The hash is empty when no rule set is
+// referenced.
+func (c *OciSecurityListServiceManager) applyRuleSets(ctx context.Context,
+	sl *ociv1beta1.OciSecurityList) (func(), string, error) {
+	ruleSets, err := c.readRuleSets(ctx, sl)
+	if err != nil || ruleSets == nil {
+		return func() {}, "", err
+	}
+
+	ingress, egress := sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules
+	sl.Spec.IngressSecurityRules = expandIngressRules(ingress, ruleSets)
+	sl.Spec.EgressSecurityRules = expandEgressRules(egress, ruleSets)
+	return func() {
+		sl.Spec.IngressSecurityRules = ingress
+		sl.Spec.EgressSecurityRules = egress
+	}, ruleSetsHash(ruleSets), nil
+}
+
+// HasPendingAction reports whether a referenced rule set has changed since it was last applied, so the
+// reconciler updates the Security List even though its spec is unchanged. An unreadable rule set also
+// counts, so the error is reported on the resource.
+func (c *OciSecurityListServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	sl, err := c.convertSecurityList(obj)
+	if err != nil || !usesExternalRules(sl) {
+		return false
+	}
+	ruleSets, err := c.readRuleSets(ctx, sl)
+	if err != nil {
+		return true
+	}
+	return ruleSetsHash(ruleSets) != sl.Status.RuleSetHash
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Compile-time check that OciSecurityListServiceManager implements OSOKServiceManager.
@@ -30,7 +32,10 @@ type OciSecurityListServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
-	ociClient        VirtualNetworkClientInterface
+	Recorder         record.EventRecorder
+	// KubeClient reads the OciSecurityRuleSets named in Spec.RuleSetRefs.
+	KubeClient client.Reader
+	ociClient  VirtualNetworkClientInterface
 }
 
 // NewOciSecurityListServiceManager creates a new OciSecurityListServiceManager.
@@ -52,6 +57,40 @@ func (c *OciSecurityListServiceManager) CreateOrUpdate(ctx context.Context, obj
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, sl.Namespace, sl.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, sl.Spec.Region)
+
+	restoreSpec, ruleSetHash, err := c.applyRuleSets(ctx, sl)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving rule sets failed")
+		sl.Status.OsokStatus = util.UpdateOSOKStatusCondition(sl.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreSpec()
+
+	restorePorts, err := applyRulePorts(sl)
+	if err != nil {
+		c.Log.ErrorLog(err, "Expanding rule ports failed")
+		sl.Status.OsokStatus = util.UpdateOSOKStatusCondition(sl.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restorePorts()
+
+	if err := validateAllProtocolRuleOptions(sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules); err != nil {
+		c.Log.ErrorLog(err, "Invalid security rule")
+		sl.Status.OsokStatus = util.UpdateOSOKStatusCondition(sl.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	c.warnStatelessStatefulOverlaps(sl)
+
 	slInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.SecurityList]{
 		SpecID: sl.Spec.SecurityListId,
 		Status: &sl.Status.OsokStatus,
@@ -82,10 +121,26 @@ func (c *OciSecurityListServiceManager) CreateOrUpdate(ctx context.Context, obj
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	c.recordObservedRules(ctx, sl, ociv1beta1.OCID(*slInstance.Id))
+	sl.Status.RuleSetHash = ruleSetHash
+
 	return reconcileLifecycleStatus(&sl.Status.OsokStatus, "OciSecurityList", safeString(slInstance.DisplayName),
 		string(slInstance.LifecycleState), ociv1beta1.OCID(*slInstance.Id), c.Log), nil
 }
 
+// recordObservedRules copies the rules OCI currently holds into status, so they can be compared with
+// the spec. It reads the Security List again because the reconcile may have just updated it. Failures
+// keep the previous observed rules.
+func (c *OciSecurityListServiceManager) recordObservedRules(ctx context.Context, sl *ociv1beta1.OciSecurityList, slId ociv1beta1.OCID) {
+	live, err := c.GetSecurityList(ctx, slId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while getting OciSecurityList rules (non-fatal)")
+		return
+	}
+	sl.Status.ObservedIngressRules = observedIngressRules(live.IngressSecurityRules)
+	sl.Status.ObservedEgressRules = observedEgressRules(live.EgressSecurityRules)
+}
+
 // Delete handles deletion of the Security List (called by the finalizer).
 func (c *OciSecurityListServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	sl, err := c.convertSecurityList(obj)
@@ -93,6 +148,13 @@ func (c *OciSecurityListServiceManager) Delete(ctx context.Context, obj runtime.
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, sl.Namespace, sl.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, sl.Spec.Region)
+
 	resourceID := sl.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = sl.Spec.SecurityListId
@@ -102,6 +164,15 @@ func (c *OciSecurityListServiceManager) Delete(ctx context.Context, obj runtime.
 		return true, nil
 	}
 
+	subnetIDs, err := c.SubnetsUsingSecurityList(ctx, resourceID, sl.Spec.CompartmentId, sl.Spec.VcnId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while listing subnets that use OciSecurityList")
+		return false, err
+	}
+	if blockDeleteForSubnets(c.Recorder, sl, c.Log, "OciSecurityList", resourceID, subnetIDs) {
+		return false, nil
+	}
+
 	c.Log.InfoLog(fmt.Sprintf("Deleting OciSecurityList %s", resourceID))
 	done, err := deleteResourceAndWait(
 		func() error { return c.DeleteSecurityList(ctx, resourceID) },
@@ -118,6 +189,12 @@ func (c *OciSecurityListServiceManager) Delete(ctx context.Context, obj runtime.
 	return done, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec. Rule sets and ConfigMap rules
+// are read outside the spec, and HasPendingAction reports when they change.
+func (c *OciSecurityListServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciSecurityListServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertSecurityList(obj)
@@ -0,0 +1,150 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// statelessStatefulOverlapReason is the event reason for a stateless rule that overlaps a stateful rule.
+const statelessStatefulOverlapReason = "StatelessStatefulOverlap"
+
+// securityRuleWarning describes a suspicious combination of security rules. The rules are still applied.
+type securityRuleWarning struct {
+	Reason  string
+	Message string
+}
+
+// securityRuleMatch is the part of an ingress or egress rule that decides which packets it matches.
+type securityRuleMatch struct {
+	protocol  string
+	cidr      string
+	stateless bool
+	tcp       *ociv1beta1.TcpOptions
+	udp       *ociv1beta1.UdpOptions
+}
+
+// checkStatelessStatefulOverlaps returns a warning for each stateless rule that matches some of the same
+// traffic as a stateful rule in the same direction. The stateless rule takes precedence for that traffic,
+// so the stateful rule's connection tracking no longer lets return traffic back in, a common source of
+// dropped connections.
+func checkStatelessStatefulOverlaps(ingress []ociv1beta1.IngressSecurityRule, egress []ociv1beta1.EgressSecurityRule) []securityRuleWarning {
+	ingressMatches := make([]securityRuleMatch, len(ingress))
+	for i, rule := range ingress {
+		ingressMatches[i] = securityRuleMatch{protocol: rule.Protocol, cidr: rule.Source, stateless: rule.IsStateless,
+			tcp: rule.TcpOptions, udp: rule.UdpOptions}
+	}
+	egressMatches := make([]securityRuleMatch, len(egress))
+	for i, rule := range egress {
+		egressMatches[i] = securityRuleMatch{protocol: rule.Protocol, cidr: rule.Destination, stateless: rule.IsStateless,
+			tcp: rule.TcpOptions, udp: rule.UdpOptions}
+	}
+	return append(overlappingRules("ingress", ingressMatches), overlappingRules("egress", egressMatches)...)
+}
+
+func overlappingRules(direction string, rules []securityRuleMatch) []securityRuleWarning {
+	var warnings []securityRuleWarning
+	for i, stateless := range rules {
+		if !stateless.stateless {
+			continue
+		}
+		for j, stateful := range rules {
+			if stateful.stateless || !securityRulesOverlap(stateless, stateful) {
+				continue
+			}
+			warnings = append(warnings, securityRuleWarning{
+				Reason: statelessStatefulOverlapReason,
+				Message: fmt.Sprintf("stateless %s rule %d overlaps stateful %s rule %d on protocol %s and %s; "+
+					"return traffic for the stateful rule may be dropped", direction, i, direction, j, stateless.protocol, stateless.cidr),
+			})
+		}
+	}
+	return warnings
+}
+
+func securityRulesOverlap(a, b securityRuleMatch) bool {
+	if !protocolsOverlap(a.protocol, b.protocol) || !cidrsOverlap(a.cidr, b.cidr) {
+		return false
+	}
+	if a.tcp != nil && b.tcp != nil {
+		return portRangesOverlap(a.tcp.DestinationPortRange, b.tcp.DestinationPortRange) &&
+			portRangesOverlap(a.tcp.SourcePortRange, b.tcp.SourcePortRange)
+	}
+	if a.udp != nil && b.udp != nil {
+		return portRangesOverlap(a.udp.DestinationPortRange, b.udp.DestinationPortRange) &&
+			portRangesOverlap(a.udp.SourcePortRange, b.udp.SourcePortRange)
+	}
+	return true
+}
+
+func protocolsOverlap(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return strings.EqualFold(a, "all") || strings.EqualFold(b, "all") || strings.EqualFold(a, b)
+}
+
+// cidrsOverlap reports whether two CIDR blocks share any address. Values that are not CIDR blocks, such
+// as service CIDR labels, only overlap when they are equal.
+func cidrsOverlap(a, b string) bool {
+	_, aNet, aErr := net.ParseCIDR(a)
+	_, bNet, bErr := net.ParseCIDR(b)
+	if aErr != nil || bErr != nil {
+		return a == b
+	}
+	return aNet.Contains(bNet.IP) || bNet.Contains(aNet.IP)
+}
+
+// portRangesOverlap reports whether two port ranges share a port. A nil range matches every port.
+func portRangesOverlap(a, b *ociv1beta1.PortRange) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	return a.Min <= b.Max && b.Min <= a.Max
+}
+
+// validateAllProtocolRuleOptions rejects rules for protocol "all" that set tcpOptions or udpOptions,
+// which OCI refuses. It runs on the rules as they are sent to OCI, so rules from rule sets are checked too,
+// and numbers them in that order: inline rules first, then each rule set, with ports expanded.
+func validateAllProtocolRuleOptions(ingress []ociv1beta1.IngressSecurityRule, egress []ociv1beta1.EgressSecurityRule) error {
+	for i, rule := range ingress {
+		if err := allProtocolRuleOptionsError(rule.Protocol, rule.TcpOptions, rule.UdpOptions); err != nil {
+			return fmt.Errorf("ingress rule %d: %w", i, err)
+		}
+	}
+	for i, rule := range egress {
+		if err := allProtocolRuleOptionsError(rule.Protocol, rule.TcpOptions, rule.UdpOptions); err != nil {
+			return fmt.Errorf("egress rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func allProtocolRuleOptionsError(protocol string, tcp *ociv1beta1.TcpOptions, udp *ociv1beta1.UdpOptions) error {
+	if !strings.EqualFold(strings.TrimSpace(protocol), "all") {
+		return nil
+	}
+	if tcp != nil {
+		return fmt.Errorf("tcpOptions cannot be set on a rule for protocol \"all\"; use protocol %q (TCP) to match ports", protocolTCP)
+	}
+	if udp != nil {
+		return fmt.Errorf("udpOptions cannot be set on a rule for protocol \"all\"; use protocol %q (UDP) to match ports", protocolUDP)
+	}
+	return nil
+}
+
+// warnStatelessStatefulOverlaps emits a warning event for each stateless rule that overlaps a stateful rule.
+func (c *OciSecurityListServiceManager) warnStatelessStatefulOverlaps(sl *ociv1beta1.OciSecurityList) {
+	for _, warning := range checkStatelessStatefulOverlaps(sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules) {
+		c.Log.InfoLog(fmt.Sprintf("OciSecurityList %s: %s", sl.Spec.DisplayName, warning.Message))
+		if c.Recorder != nil {
+			c.Recorder.Event(sl, v1.EventTypeWarning, warning.Reason, warning.Message)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"fmt"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+)
+
+const (
+	protocolTCP = "6"
+	protocolUDP = "17"
+	maxPort     = 65535
+)
+
+// securityRulePorts is the part of an ingress or egress rule that expandRulePorts reads and rewrites.
+type securityRulePorts struct {
+	protocol   string
+	ports      []int
+	portRanges []ociv1beta1.PortRange
+	tcp        *ociv1beta1.TcpOptions
+	udp        *ociv1beta1.UdpOptions
+}
+
+// expandRulePorts returns the TCP or UDP options of each concrete rule the Ports and PortRanges of a rule
+// expand to, one per destination port range, in the order they are listed. It returns nil when the rule
+// lists neither. The rule's source port range is kept on every expanded rule.
+func expandRulePorts(rule securityRulePorts) ([]*ociv1beta1.TcpOptions, []*ociv1beta1.UdpOptions, error) {
+	if len(rule.ports) == 0 && len(rule.portRanges) == 0 {
+		return nil, nil, nil
+	}
+
+	ranges := make([]ociv1beta1.PortRange, 0, len(rule.ports)+len(rule.portRanges))
+	for _, port := range rule.ports {
+		ranges = append(ranges, ociv1beta1.PortRange{Min: port, Max: port})
+	}
+	ranges = append(ranges, rule.portRanges...)
+	for _, portRange := range ranges {
+		if portRange.Min < 1 || portRange.Max > maxPort || portRange.Min > portRange.Max {
+			return nil, nil, fmt.Errorf("port range %d-%d is not within 1-%d", portRange.Min, portRange.Max, maxPort)
+		}
+	}
+
+	switch rule.protocol {
+	case protocolTCP:
+		var source *ociv1beta1.PortRange
+		if rule.tcp != nil {
+			if rule.tcp.DestinationPortRange != nil {
+				return nil, nil, fmt.Errorf("ports and portRanges cannot be combined with tcpOptions.destinationPortRange")
+			}
+			source = rule.tcp.SourcePortRange
+		}
+		options := make([]*ociv1beta1.TcpOptions, len(ranges))
+		for i := range ranges {
+			options[i] = &ociv1beta1.TcpOptions{DestinationPortRange: &ranges[i], SourcePortRange: source}
+		}
+		return options, nil, nil
+	case protocolUDP:
+		var source *ociv1beta1.PortRange
+		if rule.udp != nil {
+			if rule.udp.DestinationPortRange != nil {
+				return nil, nil, fmt.Errorf("ports and portRanges cannot be combined with udpOptions.destinationPortRange")
+			}
+			source = rule.udp.SourcePortRange
+		}
+		options := make([]*ociv1beta1.UdpOptions, len(ranges))
+		for i := range ranges {
+			options[i] = &ociv1beta1.UdpOptions{DestinationPortRange: &ranges[i], SourcePortRange: source}
+		}
+		return nil, options, nil
+	default:
+		return nil, nil, fmt.Errorf("ports and portRanges need protocol %q (TCP) or %q (UDP), not %q",
+			protocolTCP, protocolUDP, rule.protocol)
+	}
+}
+
+// expandIngressRulePorts replaces each ingress rule that lists Ports or PortRanges with one rule per
+// destination port range. Protocol aliases such as "tcp" are rewritten to the values OCI accepts, so rules
+// from rule sets and clusters without the defaulting webhook behave like defaulted inline rules. Other
+// rules are kept as they are.
+func expandIngressRulePorts(rules []ociv1beta1.IngressSecurityRule) ([]ociv1beta1.IngressSecurityRule, error) {
+	result := make([]ociv1beta1.IngressSecurityRule, 0, len(rules))
+	for i, rule := range rules {
+		rule.Protocol = ociv1beta1.NormalizeSecurityRuleProtocol(rule.Protocol)
+		tcp, udp, err := expandRulePorts(securityRulePorts{protocol: rule.Protocol, ports: rule.Ports,
+			portRanges: rule.PortRanges, tcp: rule.TcpOptions, udp: rule.UdpOptions})
+		if err != nil {
+			return nil, fmt.Errorf("ingress rule %d: %w", i, err)
+		}
+		if tcp == nil && udp == nil {
+			result = append(result, rule)
+			continue
+		}
+		rule.Ports, rule.PortRanges = nil, nil
+		for _, options := range tcp {
+			rule.TcpOptions = options
+			result = append(result, rule)
+		}
+		for _, options := range udp {
+			rule.UdpOptions = options
+			result = append(result, rule)
+		}
+	}
+	return result, nil
+}
+
+// expandEgressRulePorts is the egress counterpart of expandIngressRulePorts.
+func expandEgressRulePorts(rules []ociv1beta1.EgressSecurityRule) ([]ociv1beta1.EgressSecurityRule, error) {
+	result := make([]ociv1beta1.EgressSecurityRule, 0, len(rules))
+	for i, rule := range rules {
+		rule.Protocol = ociv1beta1.NormalizeSecurityRuleProtocol(rule.Protocol)
+		tcp, udp, err := expandRulePorts(securityRulePorts{protocol: rule.Protocol, ports: rule.Ports,
+			portRanges: rule.PortRanges, tcp: rule.TcpOptions, udp: rule.UdpOptions})
+		if err != nil {
+			return nil, fmt.Errorf("egress rule %d: %w", i, err)
+		}
+		if tcp == nil && udp == nil {
+			result = append(result, rule)
+			continue
+		}
+		rule.Ports, rule.PortRanges = nil, nil
+		for _, options := range tcp {
+			rule.TcpOptions = options
+			result = append(result, rule)
+		}
+		for _, options := range udp {
+			rule.UdpOptions = options
+			result = append(result, rule)
+		}
+	}
+	return result, nil
+}
+
+// applyRulePorts normalizes the protocols of the spec rules and expands their Ports and PortRanges into
+// concrete rules for the duration of a reconcile, so the rule count, the overlap warnings, the create and update paths and the
+// comparison with the live rules all see the rules OCI holds. The returned function restores the spec.
+func applyRulePorts(sl *ociv1beta1.OciSecurityList) (func(), error) {
+	ingress, err := expandIngressRulePorts(sl.Spec.IngressSecurityRules)
+	if err != nil {
+		return func() {}, err
+	}
+	egress, err := expandEgressRulePorts(sl.Spec.EgressSecurityRules)
+	if err != nil {
+		return func() {}, err
+	}
+
+	originalIngress, originalEgress := sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules
+	sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules = ingress, egress
+	return func() {
+		sl.Spec.IngressSecurityRules, sl.Spec.EgressSecurityRules = originalIngress, originalEgress
+	}, nil
+}
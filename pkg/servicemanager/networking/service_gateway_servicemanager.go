@@ -19,6 +19,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Compile-time check that OciServiceGatewayServiceManager implements OSOKServiceManager.
@@ -30,7 +31,9 @@ type OciServiceGatewayServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
-	ociClient        VirtualNetworkClientInterface
+	// KubeClient reads the OciVcn named in Spec.VcnRef.
+	KubeClient client.Reader
+	ociClient  VirtualNetworkClientInterface
 }
 
 // NewOciServiceGatewayServiceManager creates a new OciServiceGatewayServiceManager.
@@ -52,6 +55,33 @@ func (c *OciServiceGatewayServiceManager) CreateOrUpdate(ctx context.Context, ob
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, sgw.Namespace, sgw.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, sgw.Spec.Region)
+
+	restoreVcn, ready, err := resolveVcnRef(ctx, c.KubeClient, sgw.Namespace, sgw.Spec.VcnRef, &sgw.Spec.VcnId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving vcnRef failed")
+		sgw.Status.OsokStatus = util.UpdateOSOKStatusCondition(sgw.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if !ready {
+		return waitForVcnRef(&sgw.Status.OsokStatus, "OciServiceGateway", sgw.Namespace, sgw.Spec.VcnRef, c.Log), nil
+	}
+	defer restoreVcn()
+
+	vcnGeneration, err := parentVcnGeneration(ctx, c.KubeClient, sgw.Namespace, sgw.Spec.VcnId, sgw.Spec.VcnRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading parent OciVcn failed")
+		sgw.Status.OsokStatus = util.UpdateOSOKStatusCondition(sgw.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
 	sgwInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.ServiceGateway]{
 		SpecID: sgw.Spec.ServiceGatewayId,
 		Status: &sgw.Status.OsokStatus,
@@ -65,6 +95,9 @@ func (c *OciServiceGatewayServiceManager) CreateOrUpdate(ctx context.Context, ob
 			return c.GetServiceGatewayOcid(ctx, *sgw)
 		},
 		Create: func() (*ocicore.ServiceGateway, error) {
+			if err := ensureSameCompartmentAsVcn(ctx, c.getOCIClient, "OciServiceGateway", sgw.Spec.CompartmentId, sgw.Spec.VcnId); err != nil {
+				return nil, err
+			}
 			return c.CreateServiceGateway(ctx, *sgw)
 		},
 		OnCreateError: func(err error) {
@@ -82,8 +115,13 @@ func (c *OciServiceGatewayServiceManager) CreateOrUpdate(ctx context.Context, ob
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	return reconcileLifecycleStatus(&sgw.Status.OsokStatus, "OciServiceGateway", safeString(sgwInstance.DisplayName),
-		string(sgwInstance.LifecycleState), ociv1beta1.OCID(*sgwInstance.Id), c.Log), nil
+	response := reconcileLifecycleStatus(&sgw.Status.OsokStatus, "OciServiceGateway", safeString(sgwInstance.DisplayName),
+		string(sgwInstance.LifecycleState), ociv1beta1.OCID(*sgwInstance.Id), c.Log)
+	if response.IsSuccessful && sgwInstance.BlockTraffic != nil && *sgwInstance.BlockTraffic != sgw.Spec.BlockTraffic {
+		// The observed gateway predates the BlockTraffic update (or was just created unblocked); confirm on the next pass.
+		response.ShouldRequeue = true
+	}
+	return recordParentVcnGeneration(response, &sgw.Status.VcnGeneration, vcnGeneration), nil
 }
 
 // Delete handles deletion of the Service Gateway (called by the finalizer).
@@ -93,6 +131,13 @@ func (c *OciServiceGatewayServiceManager) Delete(ctx context.Context, obj runtim
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, sgw.Namespace, sgw.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, sgw.Spec.Region)
+
 	resourceID := sgw.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = sgw.Spec.ServiceGatewayId
@@ -118,6 +163,21 @@ func (c *OciServiceGatewayServiceManager) Delete(ctx context.Context, obj runtim
 	return done, nil
 }
 
+// HasPendingAction reports whether the parent OciVcn spec changed since the OciServiceGateway last settled, so a
+// requeue from the OciVcn watch is not skipped as an unchanged spec.
+func (c *OciServiceGatewayServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	sgw, err := c.convertSGW(obj)
+	if err != nil {
+		return false
+	}
+	return parentVcnChanged(ctx, c.KubeClient, sgw.Namespace, sgw.Spec.VcnId, sgw.Spec.VcnRef, sgw.Status.VcnGeneration)
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec once the parent OciVcn can be read.
+func (c *OciServiceGatewayServiceManager) SkipUnchangedSpec() bool {
+	return c.KubeClient != nil
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciServiceGatewayServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertSGW(obj)
@@ -0,0 +1,70 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"strings"
+
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+)
+
+// internetGatewayOcidPrefix identifies a route rule whose target is an internet gateway.
+const internetGatewayOcidPrefix = "ocid1.internetgateway."
+
+// classifySubnet records in Status.IsPublic whether the live subnet is internet-facing. Failures to read
+// the route table keep the previous classification.
+func (c *OciSubnetServiceManager) classifySubnet(ctx context.Context, subnet *ociv1beta1.OciSubnet, live *ocicore.Subnet) {
+	if !allowsPublicTraffic(live) {
+		subnet.Status.IsPublic = false
+		return
+	}
+
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while classifying OciSubnet (non-fatal)")
+		return
+	}
+	resp, err := client.GetRouteTable(ctx, ocicore.GetRouteTableRequest{RtId: live.RouteTableId})
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while getting the OciSubnet route table (non-fatal)")
+		return
+	}
+	subnet.Status.IsPublic = hasPublicDefaultRoute(resp.RouteRules)
+}
+
+// allowsPublicTraffic reports whether the subnet lets VNICs have public IPs and accept internet ingress,
+// and has a route table to route that traffic.
+func allowsPublicTraffic(live *ocicore.Subnet) bool {
+	if live == nil || live.RouteTableId == nil {
+		return false
+	}
+	if live.ProhibitPublicIpOnVnic != nil && *live.ProhibitPublicIpOnVnic {
+		return false
+	}
+	return live.ProhibitInternetIngress == nil || !*live.ProhibitInternetIngress
+}
+
+// hasPublicDefaultRoute reports whether the rules send the IPv4 default route to an internet gateway.
+func hasPublicDefaultRoute(rules []ocicore.RouteRule) bool {
+	for _, rule := range rules {
+		destination := rule.Destination
+		if destination == nil {
+			destination = rule.CidrBlock
+		}
+		if destination == nil || *destination != "0.0.0.0/0" {
+			continue
+		}
+		if rule.DestinationType != "" && rule.DestinationType != ocicore.RouteRuleDestinationTypeCidrBlock {
+			continue
+		}
+		if strings.HasPrefix(safeString(rule.NetworkEntityId), internetGatewayOcidPrefix) {
+			return true
+		}
+	}
+	return false
+}
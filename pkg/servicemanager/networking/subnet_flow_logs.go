@@ -0,0 +1,210 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+const (
+	flowLogsSourceService  = "flowlogs"
+	flowLogsSourceCategory = "all"
+	flowLogsCaptureFilter  = "capture_filter"
+)
+
+// FlowLogsClientInterface defines the capture filter and logging operations used to manage subnet flow logs.
+type FlowLogsClientInterface interface {
+	CreateCaptureFilter(ctx context.Context, request ocicore.CreateCaptureFilterRequest) (ocicore.CreateCaptureFilterResponse, error)
+	DeleteCaptureFilter(ctx context.Context, request ocicore.DeleteCaptureFilterRequest) (ocicore.DeleteCaptureFilterResponse, error)
+	ListLogs(ctx context.Context, request logging.ListLogsRequest) (logging.ListLogsResponse, error)
+	CreateLog(ctx context.Context, request logging.CreateLogRequest) (logging.CreateLogResponse, error)
+	DeleteLog(ctx context.Context, request logging.DeleteLogRequest) (logging.DeleteLogResponse, error)
+}
+
+// flowLogsClient routes capture filter calls to the virtual network API and log calls to the logging API.
+type flowLogsClient struct {
+	network ocicore.VirtualNetworkClient
+	logs    logging.LoggingManagementClient
+}
+
+func (c flowLogsClient) CreateCaptureFilter(ctx context.Context, request ocicore.CreateCaptureFilterRequest) (ocicore.CreateCaptureFilterResponse, error) {
+	return c.network.CreateCaptureFilter(ctx, request)
+}
+
+func (c flowLogsClient) DeleteCaptureFilter(ctx context.Context, request ocicore.DeleteCaptureFilterRequest) (ocicore.DeleteCaptureFilterResponse, error) {
+	return c.network.DeleteCaptureFilter(ctx, request)
+}
+
+func (c flowLogsClient) ListLogs(ctx context.Context, request logging.ListLogsRequest) (logging.ListLogsResponse, error) {
+	return c.logs.ListLogs(ctx, request)
+}
+
+func (c flowLogsClient) CreateLog(ctx context.Context, request logging.CreateLogRequest) (logging.CreateLogResponse, error) {
+	return c.logs.CreateLog(ctx, request)
+}
+
+func (c flowLogsClient) DeleteLog(ctx context.Context, request logging.DeleteLogRequest) (logging.DeleteLogResponse, error) {
+	return c.logs.DeleteLog(ctx, request)
+}
+
+// newFlowLogsClient builds a flow logs client for the given provider.
+var newFlowLogsClient = func(provider common.ConfigurationProvider) (FlowLogsClientInterface, error) {
+	network, err := ocicore.NewVirtualNetworkClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&network.BaseClient)
+	logs, err := logging.NewLoggingManagementClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&logs.BaseClient)
+	return flowLogsClient{network: network, logs: logs}, nil
+}
+
+// getFlowLogsClient returns the injected flow logs client if set, otherwise creates one from the request or default provider.
+func (c *OciSubnetServiceManager) getFlowLogsClient(ctx context.Context) (FlowLogsClientInterface, error) {
+	if c.flowLogsClient != nil {
+		return c.flowLogsClient, nil
+	}
+	return newFlowLogsClient(servicemanager.RequestProvider(ctx, c.Provider))
+}
+
+// ReconcileFlowLogs enables or disables the subnet's flow log to match Spec.FlowLogsEnabled. It reports
+// pending when a log deletion was just requested and the capture filter can only be removed afterwards.
+func (c *OciSubnetServiceManager) ReconcileFlowLogs(ctx context.Context, subnet *ociv1beta1.OciSubnet, subnetID ociv1beta1.OCID) (bool, error) {
+	if subnet.Spec.FlowLogsEnabled {
+		return false, c.enableFlowLogs(ctx, subnet, subnetID)
+	}
+	if subnet.Status.FlowLogGroupId == "" && subnet.Status.FlowLogCaptureFilterId == "" {
+		return false, nil
+	}
+	return c.disableFlowLogs(ctx, subnet, subnetID)
+}
+
+func (c *OciSubnetServiceManager) enableFlowLogs(ctx context.Context, subnet *ociv1beta1.OciSubnet, subnetID ociv1beta1.OCID) error {
+	client, err := c.getFlowLogsClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	logGroupID := subnet.Spec.FlowLogGroupId
+	existing, err := listSubnetFlowLogs(ctx, client, logGroupID, subnetID)
+	if err != nil {
+		return err
+	}
+	subnet.Status.FlowLogGroupId = logGroupID
+	if len(existing) > 0 {
+		return nil
+	}
+
+	if subnet.Status.FlowLogCaptureFilterId == "" {
+		c.Log.DebugLog("Creating flow log capture filter", "subnet", subnetID)
+		resp, err := client.CreateCaptureFilter(ctx, ocicore.CreateCaptureFilterRequest{
+			CreateCaptureFilterDetails: ocicore.CreateCaptureFilterDetails{
+				CompartmentId: common.String(string(subnet.Spec.CompartmentId)),
+				DisplayName:   common.String(subnet.Spec.DisplayName + "-flowlogs"),
+				FilterType:    ocicore.CreateCaptureFilterDetailsFilterTypeFlowlog,
+				FlowLogCaptureFilterRules: []ocicore.FlowLogCaptureFilterRuleDetails{{
+					IsEnabled:       common.Bool(true),
+					Priority:        common.Int(0),
+					SamplingRate:    common.Int(1),
+					SourceCidr:      common.String("0.0.0.0/0"),
+					DestinationCidr: common.String("0.0.0.0/0"),
+					Protocol:        common.String("all"),
+					FlowLogType:     ocicore.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll,
+					RuleAction:      ocicore.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+				}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		subnet.Status.FlowLogCaptureFilterId = ociv1beta1.OCID(safeString(resp.Id))
+	}
+
+	c.Log.DebugLog("Creating subnet flow log", "subnet", subnetID, "logGroup", logGroupID)
+	_, err = client.CreateLog(ctx, logging.CreateLogRequest{
+		LogGroupId: common.String(string(logGroupID)),
+		CreateLogDetails: logging.CreateLogDetails{
+			DisplayName: common.String(subnet.Spec.DisplayName + "-flowlogs"),
+			LogType:     logging.CreateLogDetailsLogTypeService,
+			IsEnabled:   common.Bool(true),
+			Configuration: &logging.Configuration{
+				Source: logging.OciService{
+					Service:  common.String(flowLogsSourceService),
+					Resource: common.String(string(subnetID)),
+					Category: common.String(flowLogsSourceCategory),
+					Parameters: map[string]string{
+						flowLogsCaptureFilter: string(subnet.Status.FlowLogCaptureFilterId),
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+func (c *OciSubnetServiceManager) disableFlowLogs(ctx context.Context, subnet *ociv1beta1.OciSubnet, subnetID ociv1beta1.OCID) (bool, error) {
+	client, err := c.getFlowLogsClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if subnet.Status.FlowLogGroupId != "" {
+		existing, err := listSubnetFlowLogs(ctx, client, subnet.Status.FlowLogGroupId, subnetID)
+		if err != nil {
+			return false, err
+		}
+		if len(existing) > 0 {
+			for _, log := range existing {
+				if log.LifecycleState == logging.LogLifecycleStateDeleting {
+					continue
+				}
+				c.Log.DebugLog("Deleting subnet flow log", "subnet", subnetID, "log", safeString(log.Id))
+				if _, err := client.DeleteLog(ctx, logging.DeleteLogRequest{
+					LogGroupId: common.String(string(subnet.Status.FlowLogGroupId)),
+					LogId:      log.Id,
+				}); err != nil && !isNotFoundServiceError(err) {
+					return false, err
+				}
+			}
+			// The capture filter stays in use until OCI finishes deleting the log.
+			return true, nil
+		}
+		subnet.Status.FlowLogGroupId = ""
+	}
+
+	if subnet.Status.FlowLogCaptureFilterId != "" {
+		c.Log.DebugLog("Deleting flow log capture filter", "subnet", subnetID)
+		if _, err := client.DeleteCaptureFilter(ctx, ocicore.DeleteCaptureFilterRequest{
+			CaptureFilterId: common.String(string(subnet.Status.FlowLogCaptureFilterId)),
+		}); err != nil && !isNotFoundServiceError(err) {
+			return false, err
+		}
+		subnet.Status.FlowLogCaptureFilterId = ""
+	}
+	return false, nil
+}
+
+func listSubnetFlowLogs(ctx context.Context, client FlowLogsClientInterface, logGroupID, subnetID ociv1beta1.OCID) ([]logging.LogSummary, error) {
+	resp, err := client.ListLogs(ctx, logging.ListLogsRequest{
+		LogGroupId:     common.String(string(logGroupID)),
+		LogType:        logging.ListLogsLogTypeService,
+		SourceService:  common.String(flowLogsSourceService),
+		SourceResource: common.String(string(subnetID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
@@ -0,0 +1,205 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+)
+
+// PrivateDnsClientInterface defines the resolver operations used to attach a private DNS view for a subnet.
+type PrivateDnsClientInterface interface {
+	GetVcnDnsResolverAssociation(ctx context.Context, request ocicore.GetVcnDnsResolverAssociationRequest) (ocicore.GetVcnDnsResolverAssociationResponse, error)
+	GetResolver(ctx context.Context, request dns.GetResolverRequest) (dns.GetResolverResponse, error)
+	UpdateResolver(ctx context.Context, request dns.UpdateResolverRequest) (dns.UpdateResolverResponse, error)
+}
+
+// privateDnsClient routes the VCN resolver lookup to the virtual network API and resolver calls to the DNS API.
+type privateDnsClient struct {
+	network ocicore.VirtualNetworkClient
+	dns     dns.DnsClient
+}
+
+func (c privateDnsClient) GetVcnDnsResolverAssociation(ctx context.Context, request ocicore.GetVcnDnsResolverAssociationRequest) (ocicore.GetVcnDnsResolverAssociationResponse, error) {
+	return c.network.GetVcnDnsResolverAssociation(ctx, request)
+}
+
+func (c privateDnsClient) GetResolver(ctx context.Context, request dns.GetResolverRequest) (dns.GetResolverResponse, error) {
+	return c.dns.GetResolver(ctx, request)
+}
+
+func (c privateDnsClient) UpdateResolver(ctx context.Context, request dns.UpdateResolverRequest) (dns.UpdateResolverResponse, error) {
+	return c.dns.UpdateResolver(ctx, request)
+}
+
+// newPrivateDnsClient builds a private DNS client for the given provider.
+var newPrivateDnsClient = func(provider common.ConfigurationProvider) (PrivateDnsClientInterface, error) {
+	network, err := ocicore.NewVirtualNetworkClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&network.BaseClient)
+	dnsClient, err := dns.NewDnsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&dnsClient.BaseClient)
+	return privateDnsClient{network: network, dns: dnsClient}, nil
+}
+
+// getPrivateDnsClient returns the injected private DNS client if set, otherwise creates one from the request or default provider.
+func (c *OciSubnetServiceManager) getPrivateDnsClient(ctx context.Context) (PrivateDnsClientInterface, error) {
+	if c.privateDnsClient != nil {
+		return c.privateDnsClient, nil
+	}
+	return newPrivateDnsClient(servicemanager.RequestProvider(ctx, c.Provider))
+}
+
+// ReconcilePrivateView attaches Spec.PrivateViewId to the DNS resolver serving the subnet's VCN, replacing
+// the view attached by an earlier reconcile, and detaches it once the spec no longer names a view.
+func (c *OciSubnetServiceManager) ReconcilePrivateView(ctx context.Context, subnet *ociv1beta1.OciSubnet) error {
+	desired := subnet.Spec.PrivateViewId
+	if desired == "" {
+		return c.detachPrivateView(ctx, subnet)
+	}
+
+	client, err := c.getPrivateDnsClient(ctx)
+	if err != nil {
+		return err
+	}
+	resolverID := subnet.Spec.DnsResolverId
+	if resolverID == "" {
+		if resolverID, err = vcnDnsResolverID(ctx, client, subnet.Spec.VcnId); err != nil {
+			return err
+		}
+	}
+	if subnet.Status.DnsResolverId != "" && subnet.Status.DnsResolverId != resolverID {
+		if err := c.detachPrivateView(ctx, subnet); err != nil {
+			return err
+		}
+	}
+
+	c.Log.DebugLog("Attaching private DNS view", "view", desired, "resolver", resolverID)
+	err = updateResolverViews(ctx, client, resolverID, func(views []string) []string {
+		views = removeView(views, string(subnet.Status.PrivateViewId))
+		if !containsView(views, string(desired)) {
+			views = append(views, string(desired))
+		}
+		return views
+	})
+	if err != nil {
+		return err
+	}
+	subnet.Status.PrivateViewId = desired
+	subnet.Status.DnsResolverId = resolverID
+	return nil
+}
+
+// detachPrivateView removes the view recorded in status from its resolver. A resolver that no longer
+// exists has nothing left to detach.
+func (c *OciSubnetServiceManager) detachPrivateView(ctx context.Context, subnet *ociv1beta1.OciSubnet) error {
+	if subnet.Status.PrivateViewId == "" {
+		return nil
+	}
+
+	client, err := c.getPrivateDnsClient(ctx)
+	if err != nil {
+		return err
+	}
+	resolverID := subnet.Status.DnsResolverId
+	if resolverID == "" {
+		if resolverID, err = vcnDnsResolverID(ctx, client, subnet.Spec.VcnId); err != nil {
+			return err
+		}
+	}
+
+	c.Log.DebugLog("Detaching private DNS view", "view", subnet.Status.PrivateViewId, "resolver", resolverID)
+	err = updateResolverViews(ctx, client, resolverID, func(views []string) []string {
+		return removeView(views, string(subnet.Status.PrivateViewId))
+	})
+	if err != nil && !isNotFoundServiceError(err) {
+		return err
+	}
+	subnet.Status.PrivateViewId = ""
+	subnet.Status.DnsResolverId = ""
+	return nil
+}
+
+// vcnDnsResolverID returns the DNS resolver OCI created for the VCN.
+func vcnDnsResolverID(ctx context.Context, client PrivateDnsClientInterface, vcnID ociv1beta1.OCID) (ociv1beta1.OCID, error) {
+	resp, err := client.GetVcnDnsResolverAssociation(ctx, ocicore.GetVcnDnsResolverAssociationRequest{
+		VcnId: common.String(string(vcnID)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.DnsResolverId == nil || *resp.DnsResolverId == "" {
+		return "", fmt.Errorf("VCN %s has no DNS resolver yet", vcnID)
+	}
+	return ociv1beta1.OCID(*resp.DnsResolverId), nil
+}
+
+// updateResolverViews rewrites the resolver's attached views with edit, skipping the update when
+// nothing changes. The resolver's etag guards against overwriting a concurrent change.
+func updateResolverViews(ctx context.Context, client PrivateDnsClientInterface, resolverID ociv1beta1.OCID,
+	edit func([]string) []string) error {
+	resp, err := client.GetResolver(ctx, dns.GetResolverRequest{
+		ResolverId: common.String(string(resolverID)),
+		Scope:      dns.GetResolverScopePrivate,
+	})
+	if err != nil {
+		return err
+	}
+
+	current := make([]string, 0, len(resp.AttachedViews))
+	for _, view := range resp.AttachedViews {
+		current = append(current, safeString(view.ViewId))
+	}
+	desired := edit(append([]string(nil), current...))
+	if slicesEqualIgnoringOrder(current, desired) {
+		return nil
+	}
+
+	details := make([]dns.AttachedViewDetails, 0, len(desired))
+	for _, view := range desired {
+		details = append(details, dns.AttachedViewDetails{ViewId: common.String(view)})
+	}
+	_, err = client.UpdateResolver(ctx, dns.UpdateResolverRequest{
+		ResolverId:            common.String(string(resolverID)),
+		UpdateResolverDetails: dns.UpdateResolverDetails{AttachedViews: details},
+		IfMatch:               resp.Etag,
+		Scope:                 dns.UpdateResolverScopePrivate,
+	})
+	return err
+}
+
+func containsView(views []string, view string) bool {
+	for _, v := range views {
+		if v == view {
+			return true
+		}
+	}
+	return false
+}
+
+func removeView(views []string, view string) []string {
+	if view == "" {
+		return views
+	}
+	kept := views[:0]
+	for _, v := range views {
+		if v != view {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,69 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// deleteBlockedBySubnetsReason is the event reason used when subnets still reference a resource being deleted.
+const deleteBlockedBySubnetsReason = "DeleteBlockedBySubnets"
+
+// referencingSubnets lists the live subnets in the compartment and VCN for which references reports true.
+func referencingSubnets(ctx context.Context, client VirtualNetworkClientInterface, compartmentID, vcnID ociv1beta1.OCID,
+	references func(ocicore.Subnet) bool) ([]string, error) {
+	req := ocicore.ListSubnetsRequest{
+		CompartmentId: common.String(string(compartmentID)),
+		VcnId:         common.String(string(vcnID)),
+		Limit:         common.Int(100),
+	}
+	var ids []string
+	for {
+		resp, err := client.ListSubnets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if item.LifecycleState == ocicore.SubnetLifecycleStateTerminated ||
+				item.LifecycleState == ocicore.SubnetLifecycleStateTerminating {
+				continue
+			}
+			if references(item) {
+				ids = append(ids, safeString(item.Id))
+			}
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return ids, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+// blockDeleteForSubnets reports whether any subnet still references the resource. When one does, a
+// warning event is emitted so the finalizer staying in place is explained on the resource.
+func blockDeleteForSubnets(recorder record.EventRecorder, obj runtime.Object, log loggerutil.OSOKLogger,
+	kind string, resourceID ociv1beta1.OCID, subnetIDs []string) bool {
+	if len(subnetIDs) == 0 {
+		return false
+	}
+	message := fmt.Sprintf("%s %s is still used by subnets %s; it will be deleted once they no longer reference it",
+		kind, resourceID, strings.Join(subnetIDs, ", "))
+	log.InfoLog(message)
+	if recorder != nil {
+		recorder.Event(obj, v1.EventTypeWarning, deleteBlockedBySubnetsReason, message)
+	}
+	return true
+}
@@ -7,7 +7,9 @@ package networking
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ocicore "github.com/oracle/oci-go-sdk/v65/core"
@@ -18,7 +20,9 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Compile-time check that OciSubnetServiceManager implements OSOKServiceManager.
@@ -30,7 +34,23 @@ type OciSubnetServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
+	Recorder         record.EventRecorder
+	RetentionTag     RetentionTag
+	// DeleteTimeout is how long a delete may run before the finalizer can be force-removed. Zero disables it.
+	DeleteTimeout time.Duration
+	// QuietWindow lists the UTC time ranges in which creates, updates and deletes are deferred, in the
+	// format of util.ValidateQuietWindow. Empty disables it.
+	QuietWindow string
+	DefaultTags ociv1beta1.TagResources
+	// ProtectedTagNamespaces are defined-tag namespaces that updates keep as they are in OCI.
+	ProtectedTagNamespaces []string
+	// KubeClient reads the OciVcn named in Spec.VcnRef.
+	KubeClient       client.Reader
 	ociClient        VirtualNetworkClientInterface
+	flowLogsClient   FlowLogsClientInterface
+	privateDnsClient PrivateDnsClientInterface
+	compartments     compartmentNameResolver
+	adResolver       availabilityDomainResolver
 }
 
 // NewOciSubnetServiceManager creates a new OciSubnetServiceManager.
@@ -52,7 +72,54 @@ func (c *OciSubnetServiceManager) CreateOrUpdate(ctx context.Context, obj runtim
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	subnetInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.Subnet]{
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, subnet.Namespace, subnet.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, subnet.Spec.Region)
+
+	restoreVcn, ready, err := resolveVcnRef(ctx, c.KubeClient, subnet.Namespace, subnet.Spec.VcnRef, &subnet.Spec.VcnId)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving vcnRef failed")
+		subnet.Status.OsokStatus = util.UpdateOSOKStatusCondition(subnet.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if !ready {
+		return waitForVcnRef(&subnet.Status.OsokStatus, "OciSubnet", subnet.Namespace, subnet.Spec.VcnRef, c.Log), nil
+	}
+	defer restoreVcn()
+
+	vcnGeneration, err := parentVcnGeneration(ctx, c.KubeClient, subnet.Namespace, subnet.Spec.VcnId, subnet.Spec.VcnRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading parent OciVcn failed")
+		subnet.Status.OsokStatus = util.UpdateOSOKStatusCondition(subnet.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	restoreCompartment, err := c.compartments.resolveSpecCompartment(ctx, c.Provider, &subnet.Spec.CompartmentId, subnet.Spec.CompartmentName)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving compartment name failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreCompartment()
+
+	restoreAvailabilityDomain, err := c.adResolver.resolveSpecAvailabilityDomain(ctx, c.Provider, &subnet.Spec.AvailabilityDomain)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving availability domain failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreAvailabilityDomain()
+
+	if response, reserved := reserveCreateRetryToken(subnet.Spec.SubnetId, &subnet.Status.OsokStatus, &subnet.Status.CreateRetryToken,
+		"OciSubnet", subnet.Spec.DisplayName, c.Log); reserved {
+		return response, nil
+	}
+
+	quietUntil, quiet := quietWindowEnd(c.QuietWindow)
+	subnetInstance, err := reconcileNetworkingResource(withQuietWindow(networkingCreateOrUpdateOps[ocicore.Subnet]{
 		SpecID: subnet.Spec.SubnetId,
 		Status: &subnet.Status.OsokStatus,
 		Get: func(id ociv1beta1.OCID) (*ocicore.Subnet, error) {
@@ -65,7 +132,22 @@ func (c *OciSubnetServiceManager) CreateOrUpdate(ctx context.Context, obj runtim
 			return c.GetSubnetOcid(ctx, *subnet)
 		},
 		Create: func() (*ocicore.Subnet, error) {
-			return c.CreateSubnet(ctx, *subnet)
+			desired := *subnet
+			if subnet.Spec.AutoDnsLabel && subnet.Spec.DnsLabel == "" {
+				label, err := c.resolveAutoSubnetDnsLabel(ctx, desired)
+				if err != nil {
+					return nil, err
+				}
+				desired.Spec.DnsLabel = label
+			}
+			instance, err := c.CreateSubnet(ctx, desired)
+			if err == nil {
+				subnet.Status.RouteTableId = subnet.Spec.RouteTableId
+			}
+			return instance, err
+		},
+		IsTerminal: func(instance *ocicore.Subnet) bool {
+			return isTerminalLifecycleState(string(instance.LifecycleState))
 		},
 		OnCreateError: func(err error) {
 			subnet.Status.OsokStatus = util.UpdateOSOKStatusCondition(subnet.Status.OsokStatus,
@@ -77,13 +159,53 @@ func (c *OciSubnetServiceManager) CreateOrUpdate(ctx context.Context, obj runtim
 		GetStatusMsg:   "Error while getting existing OciSubnet from status OCID",
 		GetByOCIDMsg:   "Error while getting OciSubnet by OCID",
 		UpdateMsg:      "Error while updating OciSubnet",
-	})
+	}, quiet))
+	if errors.Is(err, errQuietWindow) {
+		return deferredCreateResponse(c.Recorder, subnet, &subnet.Status.OsokStatus, "OciSubnet", subnet.Spec.DisplayName, quietUntil, c.Log), nil
+	}
 	if err != nil {
+		if response, limited := reconcileLimitExceeded(c.Recorder, subnet, &subnet.Status.OsokStatus, "OciSubnet",
+			subnet.Spec.DisplayName, err, c.Log); limited {
+			return response, err
+		}
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	subnet.Status.CreateRetryToken = ""
+
+	if isTerminalLifecycleState(string(subnetInstance.LifecycleState)) {
+		return reconcileTerminalLifecycleStatus(c.Recorder, subnet, &subnet.Status.OsokStatus, "OciSubnet",
+			safeString(subnetInstance.DisplayName), string(subnetInstance.LifecycleState), ociv1beta1.OCID(*subnetInstance.Id), c.Log), nil
+	}
+
+	response := reconcileLifecycleStatus(&subnet.Status.OsokStatus, "OciSubnet", safeString(subnetInstance.DisplayName),
+		string(subnetInstance.LifecycleState), ociv1beta1.OCID(*subnetInstance.Id), c.Log)
+	if !response.IsSuccessful {
+		return response, nil
+	}
+
+	c.classifySubnet(ctx, subnet, subnetInstance)
+	if quiet {
+		return requeueAfterQuietWindow(response, quietUntil), nil
+	}
+
+	if err := c.ReconcilePrivateView(ctx, subnet); err != nil {
+		subnet.Status.OsokStatus = util.UpdateOSOKStatusCondition(subnet.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Error while reconciling OciSubnet private DNS view")
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	return reconcileLifecycleStatus(&subnet.Status.OsokStatus, "OciSubnet", safeString(subnetInstance.DisplayName),
-		string(subnetInstance.LifecycleState), ociv1beta1.OCID(*subnetInstance.Id), c.Log), nil
+	pending, err := c.ReconcileFlowLogs(ctx, subnet, ociv1beta1.OCID(*subnetInstance.Id))
+	if err != nil {
+		subnet.Status.OsokStatus = util.UpdateOSOKStatusCondition(subnet.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Error while reconciling OciSubnet flow logs")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	if pending {
+		return servicemanager.OSOKResponse{IsSuccessful: true, ShouldRequeue: true}, nil
+	}
+	return recordParentVcnGeneration(response, &subnet.Status.VcnGeneration, vcnGeneration), nil
 }
 
 // Delete handles deletion of the Subnet (called by the finalizer).
@@ -93,6 +215,13 @@ func (c *OciSubnetServiceManager) Delete(ctx context.Context, obj runtime.Object
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, subnet.Namespace, subnet.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, subnet.Spec.Region)
+
 	resourceID := subnet.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = subnet.Spec.SubnetId
@@ -102,6 +231,37 @@ func (c *OciSubnetServiceManager) Delete(ctx context.Context, obj runtime.Object
 		return true, nil
 	}
 
+	if c.RetentionTag.Enabled() {
+		live, err := c.GetSubnet(ctx, resourceID)
+		if err != nil && !isNotFoundServiceError(err) {
+			c.Log.ErrorLog(err, "Error while checking the OciSubnet retention tag")
+			return false, err
+		}
+		if err == nil && blockDeleteForRetention(c.Recorder, subnet, c.Log, "OciSubnet", resourceID, c.RetentionTag, live.DefinedTags) {
+			return false, nil
+		}
+	}
+
+	if deferDeleteForQuietWindow(c.Recorder, subnet, c.Log, "OciSubnet", resourceID, c.QuietWindow) {
+		return false, nil
+	}
+
+	if err := c.detachPrivateView(ctx, subnet); err != nil {
+		c.Log.ErrorLog(err, "Error while detaching OciSubnet private DNS view")
+		return false, err
+	}
+
+	if subnet.Status.FlowLogGroupId != "" || subnet.Status.FlowLogCaptureFilterId != "" {
+		pending, err := c.disableFlowLogs(ctx, subnet, resourceID)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error while removing OciSubnet flow logs")
+			return false, err
+		}
+		if pending {
+			return false, nil
+		}
+	}
+
 	c.Log.InfoLog(fmt.Sprintf("Deleting OciSubnet %s", resourceID))
 	done, err := deleteResourceAndWait(
 		func() error { return c.DeleteSubnet(ctx, resourceID) },
@@ -110,6 +270,10 @@ func (c *OciSubnetServiceManager) Delete(ctx context.Context, obj runtime.Object
 			return getErr
 		},
 	)
+	// A delete that keeps failing, such as a 409 while dependents remain, is subject to the timeout too.
+	if !done && releaseAfterDeleteTimeout(c.Recorder, subnet, c.Log, "OciSubnet", resourceID, c.DeleteTimeout) {
+		return true, nil
+	}
 	if err != nil {
 		c.Log.ErrorLog(err, "Error while deleting OciSubnet")
 		return false, err
@@ -118,6 +282,21 @@ func (c *OciSubnetServiceManager) Delete(ctx context.Context, obj runtime.Object
 	return done, nil
 }
 
+// HasPendingAction reports whether the parent OciVcn spec changed since the OciSubnet last settled, so a
+// requeue from the OciVcn watch is not skipped as an unchanged spec.
+func (c *OciSubnetServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	subnet, err := c.convertSubnet(obj)
+	if err != nil {
+		return false
+	}
+	return parentVcnChanged(ctx, c.KubeClient, subnet.Namespace, subnet.Spec.VcnId, subnet.Spec.VcnRef, subnet.Status.VcnGeneration)
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec once the parent OciVcn can be read.
+func (c *OciSubnetServiceManager) SkipUnchangedSpec() bool {
+	return c.KubeClient != nil
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciSubnetServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertSubnet(obj)
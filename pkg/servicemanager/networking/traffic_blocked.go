@@ -0,0 +1,60 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// trafficBlockedCondition is the condition type, and event reason, for a gateway that is available in OCI
+// but does not pass traffic, so resources routed through it silently lose connectivity.
+const trafficBlockedCondition ociv1beta1.OSOKConditionType = "TrafficBlocked"
+
+// reconcileTrafficBlocked adds the TrafficBlocked condition and emits a warning event when an available
+// gateway blocks traffic, and removes the condition once it no longer does. The event is only emitted
+// when the condition is first added. The response is returned unchanged.
+func reconcileTrafficBlocked(recorder record.EventRecorder, obj runtime.Object, status *ociv1beta1.OSOKStatus,
+	response servicemanager.OSOKResponse, blocked bool, message string, log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
+	if !response.IsSuccessful || !blocked {
+		removeStatusCondition(status, trafficBlockedCondition)
+		return response
+	}
+
+	if !hasStatusCondition(status, trafficBlockedCondition) {
+		log.InfoLog(message)
+		if recorder != nil {
+			recorder.Event(obj, v1.EventTypeWarning, string(trafficBlockedCondition), message)
+		}
+	}
+	*status = util.UpdateOSOKStatusCondition(*status, trafficBlockedCondition, v1.ConditionTrue,
+		string(trafficBlockedCondition), message, log)
+	return response
+}
+
+func hasStatusCondition(status *ociv1beta1.OSOKStatus, conditionType ociv1beta1.OSOKConditionType) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+func removeStatusCondition(status *ociv1beta1.OSOKStatus, conditionType ociv1beta1.OSOKConditionType) {
+	conditions := status.Conditions[:0]
+	for _, condition := range status.Conditions {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	status.Conditions = conditions
+}
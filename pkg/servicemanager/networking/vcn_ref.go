@@ -0,0 +1,117 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// vcnRefRequeueDuration is how long to wait before checking again for the OCID of a referenced OciVcn.
+const vcnRefRequeueDuration = 15 * time.Second
+
+// resolveVcnRef sets *vcnID to the OCID recorded in the status of the OciVcn named by ref, for the
+// duration of a reconcile. It is a no-op when ref is nil or vcnID is already set. The returned bool is
+// false when the OciVcn exists but has no OCID yet; the returned function restores the spec.
+func resolveVcnRef(ctx context.Context, reader client.Reader, namespace string, ref *ociv1beta1.ResourceRef,
+	vcnID *ociv1beta1.OCID) (func(), bool, error) {
+	if ref == nil || *vcnID != "" {
+		return func() {}, true, nil
+	}
+	if reader == nil {
+		return func() {}, false, errors.New("vcnRef is set but no Kubernetes client is configured to read OciVcns")
+	}
+
+	key := vcnRefKey(namespace, ref)
+	vcn := ociv1beta1.OciVcn{}
+	if err := reader.Get(ctx, key, &vcn); err != nil {
+		return func() {}, false, fmt.Errorf("reading OciVcn %s named in vcnRef: %w", key, err)
+	}
+	if vcn.Status.OsokStatus.Ocid == "" {
+		return func() {}, false, nil
+	}
+
+	*vcnID = vcn.Status.OsokStatus.Ocid
+	return func() { *vcnID = "" }, true, nil
+}
+
+// vcnRefKey returns the namespaced name ref points to, defaulting to the referencing resource's namespace.
+func vcnRefKey(namespace string, ref *ociv1beta1.ResourceRef) types.NamespacedName {
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+// waitForVcnRef reports that the resource waits for the OciVcn named in its vcnRef to be provisioned.
+func waitForVcnRef(status *ociv1beta1.OSOKStatus, kind, namespace string, ref *ociv1beta1.ResourceRef,
+	log loggerutil.OSOKLogger) servicemanager.OSOKResponse {
+	*status = util.UpdateOSOKStatusCondition(*status, ociv1beta1.Provisioning, v1.ConditionTrue, "",
+		fmt.Sprintf("%s is waiting for OciVcn %s to be provisioned", kind, vcnRefKey(namespace, ref)), log)
+	return servicemanager.OSOKResponse{
+		IsSuccessful:    false,
+		ShouldRequeue:   true,
+		RequeueDuration: vcnRefRequeueDuration,
+	}
+}
+
+// parentVcnGeneration returns the metadata.generation of the OciVcn that vcnID or ref names, or 0 when no
+// OciVcn in the cluster manages the VCN or no Kubernetes client is configured.
+func parentVcnGeneration(ctx context.Context, reader client.Reader, namespace string, vcnID ociv1beta1.OCID,
+	ref *ociv1beta1.ResourceRef) (int64, error) {
+	if reader == nil {
+		return 0, nil
+	}
+	if ref != nil {
+		vcn := ociv1beta1.OciVcn{}
+		if err := reader.Get(ctx, vcnRefKey(namespace, ref), &vcn); err != nil {
+			return 0, client.IgnoreNotFound(err)
+		}
+		return vcn.Generation, nil
+	}
+	if vcnID == "" {
+		return 0, nil
+	}
+
+	vcns := ociv1beta1.OciVcnList{}
+	if err := reader.List(ctx, &vcns, client.InNamespace(namespace)); err != nil {
+		return 0, fmt.Errorf("listing OciVcns in namespace %s: %w", namespace, err)
+	}
+	for _, vcn := range vcns.Items {
+		if vcn.Status.OsokStatus.Ocid == vcnID || vcn.Spec.VcnId == vcnID {
+			return vcn.Generation, nil
+		}
+	}
+	return 0, nil
+}
+
+// recordParentVcnGeneration stores generation in *recorded once response reports the resource settled, so
+// HasPendingAction can tell when the parent OciVcn spec has changed since.
+func recordParentVcnGeneration(response servicemanager.OSOKResponse, recorded *int64,
+	generation int64) servicemanager.OSOKResponse {
+	if response.IsSuccessful && !response.ShouldRequeue {
+		*recorded = generation
+	}
+	return response
+}
+
+// parentVcnChanged reports whether the parent OciVcn's generation differs from the one recorded when the
+// resource last settled. A failed lookup counts as a change so the resource is reconciled rather than skipped.
+func parentVcnChanged(ctx context.Context, reader client.Reader, namespace string, vcnID ociv1beta1.OCID,
+	ref *ociv1beta1.ResourceRef, recorded int64) bool {
+	generation, err := parentVcnGeneration(ctx, reader, namespace, vcnID, ref)
+	return err != nil || generation != recorded
+}
@@ -9,11 +9,16 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ocicore "github.com/oracle/oci-go-sdk/v65/core"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -27,7 +32,8 @@ func networkingDefinedTagsChanged(desired map[string]ociv1beta1.MapValue, existi
 }
 
 func networkingLookupStateMatches(state string) bool {
-	return state == "AVAILABLE" || state == "PROVISIONING" || state == "UPDATING"
+	decision := networkingLifecycleStates.Evaluate(state)
+	return decision == servicemanager.LifecycleReady || decision == servicemanager.LifecycleInProgress
 }
 
 func networkingFreeformTagsChanged(desired map[string]string, existing map[string]string) bool {
@@ -124,6 +130,8 @@ type VirtualNetworkClientInterface interface {
 	ChangeSubnetCompartment(ctx context.Context, request ocicore.ChangeSubnetCompartmentRequest) (ocicore.ChangeSubnetCompartmentResponse, error)
 	UpdateSubnet(ctx context.Context, request ocicore.UpdateSubnetRequest) (ocicore.UpdateSubnetResponse, error)
 	DeleteSubnet(ctx context.Context, request ocicore.DeleteSubnetRequest) (ocicore.DeleteSubnetResponse, error)
+	AddIpv6SubnetCidr(ctx context.Context, request ocicore.AddIpv6SubnetCidrRequest) (ocicore.AddIpv6SubnetCidrResponse, error)
+	RemoveIpv6SubnetCidr(ctx context.Context, request ocicore.RemoveIpv6SubnetCidrRequest) (ocicore.RemoveIpv6SubnetCidrResponse, error)
 	// Internet Gateway
 	CreateInternetGateway(ctx context.Context, request ocicore.CreateInternetGatewayRequest) (ocicore.CreateInternetGatewayResponse, error)
 	GetInternetGateway(ctx context.Context, request ocicore.GetInternetGatewayRequest) (ocicore.GetInternetGatewayResponse, error)
@@ -152,6 +160,9 @@ type VirtualNetworkClientInterface interface {
 	ChangeDrgCompartment(ctx context.Context, request ocicore.ChangeDrgCompartmentRequest) (ocicore.ChangeDrgCompartmentResponse, error)
 	UpdateDrg(ctx context.Context, request ocicore.UpdateDrgRequest) (ocicore.UpdateDrgResponse, error)
 	DeleteDrg(ctx context.Context, request ocicore.DeleteDrgRequest) (ocicore.DeleteDrgResponse, error)
+	ListDrgRouteDistributionStatements(ctx context.Context, request ocicore.ListDrgRouteDistributionStatementsRequest) (ocicore.ListDrgRouteDistributionStatementsResponse, error)
+	AddDrgRouteDistributionStatements(ctx context.Context, request ocicore.AddDrgRouteDistributionStatementsRequest) (ocicore.AddDrgRouteDistributionStatementsResponse, error)
+	RemoveDrgRouteDistributionStatements(ctx context.Context, request ocicore.RemoveDrgRouteDistributionStatementsRequest) (ocicore.RemoveDrgRouteDistributionStatementsResponse, error)
 	// Security List
 	CreateSecurityList(ctx context.Context, request ocicore.CreateSecurityListRequest) (ocicore.CreateSecurityListResponse, error)
 	GetSecurityList(ctx context.Context, request ocicore.GetSecurityListRequest) (ocicore.GetSecurityListResponse, error)
@@ -173,63 +184,91 @@ type VirtualNetworkClientInterface interface {
 	ChangeRouteTableCompartment(ctx context.Context, request ocicore.ChangeRouteTableCompartmentRequest) (ocicore.ChangeRouteTableCompartmentResponse, error)
 	UpdateRouteTable(ctx context.Context, request ocicore.UpdateRouteTableRequest) (ocicore.UpdateRouteTableResponse, error)
 	DeleteRouteTable(ctx context.Context, request ocicore.DeleteRouteTableRequest) (ocicore.DeleteRouteTableResponse, error)
+	// DRG Route Table
+	CreateDrgRouteTable(ctx context.Context, request ocicore.CreateDrgRouteTableRequest) (ocicore.CreateDrgRouteTableResponse, error)
+	GetDrgRouteTable(ctx context.Context, request ocicore.GetDrgRouteTableRequest) (ocicore.GetDrgRouteTableResponse, error)
+	ListDrgRouteTables(ctx context.Context, request ocicore.ListDrgRouteTablesRequest) (ocicore.ListDrgRouteTablesResponse, error)
+	UpdateDrgRouteTable(ctx context.Context, request ocicore.UpdateDrgRouteTableRequest) (ocicore.UpdateDrgRouteTableResponse, error)
+	DeleteDrgRouteTable(ctx context.Context, request ocicore.DeleteDrgRouteTableRequest) (ocicore.DeleteDrgRouteTableResponse, error)
+	ListDrgRouteRules(ctx context.Context, request ocicore.ListDrgRouteRulesRequest) (ocicore.ListDrgRouteRulesResponse, error)
+	AddDrgRouteRules(ctx context.Context, request ocicore.AddDrgRouteRulesRequest) (ocicore.AddDrgRouteRulesResponse, error)
+	RemoveDrgRouteRules(ctx context.Context, request ocicore.RemoveDrgRouteRulesRequest) (ocicore.RemoveDrgRouteRulesResponse, error)
+	// Local Peering Gateway
+	CreateLocalPeeringGateway(ctx context.Context, request ocicore.CreateLocalPeeringGatewayRequest) (ocicore.CreateLocalPeeringGatewayResponse, error)
+	GetLocalPeeringGateway(ctx context.Context, request ocicore.GetLocalPeeringGatewayRequest) (ocicore.GetLocalPeeringGatewayResponse, error)
+	ListLocalPeeringGateways(ctx context.Context, request ocicore.ListLocalPeeringGatewaysRequest) (ocicore.ListLocalPeeringGatewaysResponse, error)
+	ChangeLocalPeeringGatewayCompartment(ctx context.Context, request ocicore.ChangeLocalPeeringGatewayCompartmentRequest) (ocicore.ChangeLocalPeeringGatewayCompartmentResponse, error)
+	UpdateLocalPeeringGateway(ctx context.Context, request ocicore.UpdateLocalPeeringGatewayRequest) (ocicore.UpdateLocalPeeringGatewayResponse, error)
+	DeleteLocalPeeringGateway(ctx context.Context, request ocicore.DeleteLocalPeeringGatewayRequest) (ocicore.DeleteLocalPeeringGatewayResponse, error)
+	ConnectLocalPeeringGateways(ctx context.Context, request ocicore.ConnectLocalPeeringGatewaysRequest) (ocicore.ConnectLocalPeeringGatewaysResponse, error)
+}
+
+// newVirtualNetworkClient builds an OCI virtual network client for the given provider.
+var newVirtualNetworkClient = func(provider common.ConfigurationProvider) (VirtualNetworkClientInterface, error) {
+	client, err := ocicore.NewVirtualNetworkClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
-func getVirtualNetworkClient(provider common.ConfigurationProvider) (ocicore.VirtualNetworkClient, error) {
-	return ocicore.NewVirtualNetworkClientWithConfigurationProvider(provider)
-}
-
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciVcnServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciVcnServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciSubnetServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciSubnetServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciInternetGatewayServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciInternetGatewayServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciNatGatewayServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciNatGatewayServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciServiceGatewayServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciServiceGatewayServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciDrgServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciDrgServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
 // CreateVcn calls the OCI API to create a new VCN.
 func (c *OciVcnServiceManager) CreateVcn(ctx context.Context, vcn ociv1beta1.OciVcn) (*ocicore.Vcn, error) {
-	client, err := c.getOCIClient()
+	if err := util.ValidateCidr(vcn.Spec.CidrBlock, true); err != nil {
+		return nil, err
+	}
+	vcn.Spec.TagResources = util.MergeTags(c.DefaultTags, vcn.Spec.TagResources)
+
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +279,7 @@ func (c *OciVcnServiceManager) CreateVcn(ctx context.Context, vcn ociv1beta1.Oci
 		CompartmentId: common.String(string(vcn.Spec.CompartmentId)),
 		DisplayName:   common.String(vcn.Spec.DisplayName),
 		CidrBlock:     common.String(vcn.Spec.CidrBlock),
-		FreeformTags:  vcn.Spec.FreeFormTags,
+		FreeformTags:  withManagedByTag(vcn.Spec.FreeFormTags, vcn.Namespace, vcn.Name),
 	}
 	if vcn.Spec.DnsLabel != "" {
 		details.DnsLabel = common.String(vcn.Spec.DnsLabel)
@@ -249,7 +288,12 @@ func (c *OciVcnServiceManager) CreateVcn(ctx context.Context, vcn ociv1beta1.Oci
 		details.DefinedTags = *util.ConvertToOciDefinedTags(&vcn.Spec.DefinedTags)
 	}
 
-	resp, err := client.CreateVcn(ctx, ocicore.CreateVcnRequest{CreateVcnDetails: details})
+	req := ocicore.CreateVcnRequest{CreateVcnDetails: details}
+	if vcn.Status.CreateRetryToken != "" {
+		req.OpcRetryToken = common.String(vcn.Status.CreateRetryToken)
+	}
+
+	resp, err := client.CreateVcn(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +302,7 @@ func (c *OciVcnServiceManager) CreateVcn(ctx context.Context, vcn ociv1beta1.Oci
 
 // GetVcn retrieves a VCN by OCID.
 func (c *OciVcnServiceManager) GetVcn(ctx context.Context, vcnId ociv1beta1.OCID) (*ocicore.Vcn, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -271,8 +315,10 @@ func (c *OciVcnServiceManager) GetVcn(ctx context.Context, vcnId ociv1beta1.OCID
 }
 
 // GetVcnOcid looks up an existing VCN by display name and returns its OCID if found.
+// A VCN tagged as managed by this resource is preferred over an untagged one with the same name,
+// and a VCN tagged for another resource is never returned.
 func (c *OciVcnServiceManager) GetVcnOcid(ctx context.Context, vcn ociv1beta1.OciVcn) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -282,6 +328,7 @@ func (c *OciVcnServiceManager) GetVcnOcid(ctx context.Context, vcn ociv1beta1.Oc
 		DisplayName:   common.String(vcn.Spec.DisplayName),
 		Limit:         common.Int(100),
 	}
+	selector := newManagedResourceSelector(vcn.Namespace, vcn.Name)
 	for {
 		resp, err := client.ListVcns(ctx, req)
 		if err != nil {
@@ -290,7 +337,7 @@ func (c *OciVcnServiceManager) GetVcnOcid(ctx context.Context, vcn ociv1beta1.Oc
 		}
 
 		for _, item := range resp.Items {
-			if networkingLookupStateMatches(string(item.LifecycleState)) {
+			if networkingLookupStateMatches(string(item.LifecycleState)) && selector.consider(item.Id, item.FreeformTags) {
 				c.Log.DebugLog(fmt.Sprintf("OciVcn %s exists with OCID %s", vcn.Spec.DisplayName, *item.Id))
 				return (*ociv1beta1.OCID)(item.Id), nil
 			}
@@ -302,18 +349,24 @@ func (c *OciVcnServiceManager) GetVcnOcid(ctx context.Context, vcn ociv1beta1.Oc
 		req.Page = resp.OpcNextPage
 	}
 
+	if selector.fallback != nil {
+		c.Log.DebugLog(fmt.Sprintf("OciVcn %s exists without a managed-by tag with OCID %s", vcn.Spec.DisplayName, *selector.fallback))
+		return (*ociv1beta1.OCID)(selector.fallback), nil
+	}
+
 	c.Log.DebugLog(fmt.Sprintf("OciVcn %s does not exist", vcn.Spec.DisplayName))
 	return nil, nil
 }
 
 // UpdateVcn updates an existing VCN's display name and tags.
 func (c *OciVcnServiceManager) UpdateVcn(ctx context.Context, vcn *ociv1beta1.OciVcn) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.Vcn, ocicore.UpdateVcnDetails]{
+		Kind:                 driftKind("OciVcn", vcn.Generation, vcn.Status.OsokStatus.ObservedGeneration),
 		StatusID:             vcn.Status.OsokStatus.Ocid,
 		SpecID:               vcn.Spec.VcnId,
 		DesiredCompartmentID: vcn.Spec.CompartmentId,
@@ -336,7 +389,10 @@ func (c *OciVcnServiceManager) UpdateVcn(ctx context.Context, vcn *ociv1beta1.Oc
 			return err
 		},
 		BuildDetails: func(existing *ocicore.Vcn) (ocicore.UpdateVcnDetails, bool) {
-			return buildVcnUpdateDetails(vcn, existing)
+			desired := *vcn
+			desired.Spec.TagResources = util.MergeManagedTags(c.DefaultTags, vcn.Spec.TagResources)
+			desired.Spec.DefinedTags = withProtectedDefinedTags(desired.Spec.DefinedTags, existing.DefinedTags, c.ProtectedTagNamespaces)
+			return buildVcnUpdateDetails(&desired, existing)
 		},
 		Update: func(targetID ociv1beta1.OCID, updateDetails ocicore.UpdateVcnDetails) error {
 			_, err := client.UpdateVcn(ctx, ocicore.UpdateVcnRequest{
@@ -356,8 +412,9 @@ func buildVcnUpdateDetails(vcn *ociv1beta1.OciVcn, existing *ocicore.Vcn) (ocico
 		updateDetails.DisplayName = common.String(vcn.Spec.DisplayName)
 		updateNeeded = true
 	}
-	if networkingFreeformTagsChanged(vcn.Spec.FreeFormTags, existing.FreeformTags) {
-		updateDetails.FreeformTags = vcn.Spec.FreeFormTags
+	desiredFreeformTags := desiredManagedFreeformTags(vcn.Spec.FreeFormTags, vcn.Namespace, vcn.Name)
+	if networkingFreeformTagsChanged(desiredFreeformTags, existing.FreeformTags) {
+		updateDetails.FreeformTags = desiredFreeformTags
 		updateNeeded = true
 	}
 	if desiredTags, changed := networkingDefinedTagsChanged(vcn.Spec.DefinedTags, existing.DefinedTags); changed {
@@ -377,7 +434,7 @@ func validateVcnUnsupportedChanges(vcn *ociv1beta1.OciVcn, existing *ocicore.Vcn
 
 // DeleteVcn deletes the VCN for the given OCID.
 func (c *OciVcnServiceManager) DeleteVcn(ctx context.Context, vcnId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -388,7 +445,12 @@ func (c *OciVcnServiceManager) DeleteVcn(ctx context.Context, vcnId ociv1beta1.O
 
 // CreateSubnet calls the OCI API to create a new Subnet.
 func (c *OciSubnetServiceManager) CreateSubnet(ctx context.Context, subnet ociv1beta1.OciSubnet) (*ocicore.Subnet, error) {
-	client, err := c.getOCIClient()
+	if err := util.ValidateCidr(subnet.Spec.CidrBlock, false); err != nil {
+		return nil, err
+	}
+	subnet.Spec.TagResources = util.MergeTags(c.DefaultTags, subnet.Spec.TagResources)
+
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +462,7 @@ func (c *OciSubnetServiceManager) CreateSubnet(ctx context.Context, subnet ociv1
 		VcnId:         common.String(string(subnet.Spec.VcnId)),
 		CidrBlock:     common.String(subnet.Spec.CidrBlock),
 		DisplayName:   common.String(subnet.Spec.DisplayName),
-		FreeformTags:  subnet.Spec.FreeFormTags,
+		FreeformTags:  withManagedByTag(subnet.Spec.FreeFormTags, subnet.Namespace, subnet.Name),
 	}
 	if subnet.Spec.AvailabilityDomain != "" {
 		details.AvailabilityDomain = common.String(subnet.Spec.AvailabilityDomain)
@@ -421,20 +483,101 @@ func (c *OciSubnetServiceManager) CreateSubnet(ctx context.Context, subnet ociv1
 		}
 		details.SecurityListIds = slIds
 	}
+	if len(subnet.Spec.Ipv6CidrBlocks) > 0 {
+		details.Ipv6CidrBlocks = subnet.Spec.Ipv6CidrBlocks
+	}
 	if subnet.Spec.DefinedTags != nil {
 		details.DefinedTags = *util.ConvertToOciDefinedTags(&subnet.Spec.DefinedTags)
 	}
 
-	resp, err := client.CreateSubnet(ctx, ocicore.CreateSubnetRequest{CreateSubnetDetails: details})
+	req := ocicore.CreateSubnetRequest{CreateSubnetDetails: details}
+	if subnet.Status.CreateRetryToken != "" {
+		req.OpcRetryToken = common.String(subnet.Status.CreateRetryToken)
+	}
+
+	resp, err := client.CreateSubnet(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	return &resp.Subnet, nil
 }
 
+// resolveAutoSubnetDnsLabel derives a DNS label from the subnet's display name. It returns "" when
+// the VCN is not DNS-enabled, and appends a numeric suffix when another subnet in the VCN already
+// uses the derived label.
+func (c *OciSubnetServiceManager) resolveAutoSubnetDnsLabel(ctx context.Context, subnet ociv1beta1.OciSubnet) (string, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	vcnResp, err := client.GetVcn(ctx, ocicore.GetVcnRequest{VcnId: common.String(string(subnet.Spec.VcnId))})
+	if err != nil {
+		return "", err
+	}
+	if safeString(vcnResp.DnsLabel) == "" {
+		c.Log.DebugLog("VCN is not DNS-enabled, creating OciSubnet without a DNS label", "vcn", subnet.Spec.VcnId)
+		return "", nil
+	}
+
+	base := util.SanitizeDnsLabel(subnet.Spec.DisplayName)
+	if base == "" {
+		return "", fmt.Errorf("cannot derive a DNS label from displayName %q; set dnsLabel explicitly", subnet.Spec.DisplayName)
+	}
+
+	taken, err := c.listSubnetDnsLabels(ctx, client, subnet)
+	if err != nil {
+		return "", err
+	}
+	return uniqueDnsLabel(base, taken), nil
+}
+
+func (c *OciSubnetServiceManager) listSubnetDnsLabels(ctx context.Context, client VirtualNetworkClientInterface, subnet ociv1beta1.OciSubnet) (map[string]bool, error) {
+	req := ocicore.ListSubnetsRequest{
+		CompartmentId: common.String(string(subnet.Spec.CompartmentId)),
+		VcnId:         common.String(string(subnet.Spec.VcnId)),
+		Limit:         common.Int(100),
+	}
+	taken := map[string]bool{}
+	for {
+		resp, err := client.ListSubnets(ctx, req)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error listing Subnets")
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if item.LifecycleState == ocicore.SubnetLifecycleStateTerminated || item.DnsLabel == nil {
+				continue
+			}
+			taken[*item.DnsLabel] = true
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return taken, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+// uniqueDnsLabel returns base, or base shortened and suffixed with the lowest number that is not taken.
+func uniqueDnsLabel(base string, taken map[string]bool) string {
+	if !taken[base] {
+		return base
+	}
+	for i := 1; ; i++ {
+		suffix := strconv.Itoa(i)
+		prefix := base
+		if len(prefix)+len(suffix) > util.DnsLabelMaxLength {
+			prefix = prefix[:util.DnsLabelMaxLength-len(suffix)]
+		}
+		if candidate := prefix + suffix; !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
 // GetSubnet retrieves a Subnet by OCID.
 func (c *OciSubnetServiceManager) GetSubnet(ctx context.Context, subnetId ociv1beta1.OCID) (*ocicore.Subnet, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -447,8 +590,10 @@ func (c *OciSubnetServiceManager) GetSubnet(ctx context.Context, subnetId ociv1b
 }
 
 // GetSubnetOcid looks up an existing Subnet by display name within a VCN and returns its OCID if found.
+// A Subnet tagged as managed by this resource is preferred over an untagged one with the same name,
+// and a Subnet tagged for another resource is never returned.
 func (c *OciSubnetServiceManager) GetSubnetOcid(ctx context.Context, subnet ociv1beta1.OciSubnet) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -459,6 +604,7 @@ func (c *OciSubnetServiceManager) GetSubnetOcid(ctx context.Context, subnet ociv
 		DisplayName:   common.String(subnet.Spec.DisplayName),
 		Limit:         common.Int(100),
 	}
+	selector := newManagedResourceSelector(subnet.Namespace, subnet.Name)
 	for {
 		resp, err := client.ListSubnets(ctx, req)
 		if err != nil {
@@ -467,7 +613,7 @@ func (c *OciSubnetServiceManager) GetSubnetOcid(ctx context.Context, subnet ociv
 		}
 
 		for _, item := range resp.Items {
-			if networkingLookupStateMatches(string(item.LifecycleState)) {
+			if networkingLookupStateMatches(string(item.LifecycleState)) && selector.consider(item.Id, item.FreeformTags) {
 				c.Log.DebugLog(fmt.Sprintf("OciSubnet %s exists with OCID %s", subnet.Spec.DisplayName, *item.Id))
 				return (*ociv1beta1.OCID)(item.Id), nil
 			}
@@ -479,18 +625,29 @@ func (c *OciSubnetServiceManager) GetSubnetOcid(ctx context.Context, subnet ociv
 		req.Page = resp.OpcNextPage
 	}
 
+	if selector.fallback != nil {
+		c.Log.DebugLog(fmt.Sprintf("OciSubnet %s exists without a managed-by tag with OCID %s", subnet.Spec.DisplayName, *selector.fallback))
+		return (*ociv1beta1.OCID)(selector.fallback), nil
+	}
+
 	c.Log.DebugLog(fmt.Sprintf("OciSubnet %s does not exist", subnet.Spec.DisplayName))
 	return nil, nil
 }
 
 // UpdateSubnet updates an existing Subnet's display name and tags.
 func (c *OciSubnetServiceManager) UpdateSubnet(ctx context.Context, subnet *ociv1beta1.OciSubnet) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	routeTableID, err := desiredSubnetRouteTable(ctx, client, subnet)
 	if err != nil {
 		return err
 	}
 
-	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.Subnet, ocicore.UpdateSubnetDetails]{
+	err = updateSimpleNetworkingResource(networkingUpdateOps[ocicore.Subnet, ocicore.UpdateSubnetDetails]{
+		Kind:                 driftKind("OciSubnet", subnet.Generation, subnet.Status.OsokStatus.ObservedGeneration),
 		StatusID:             subnet.Status.OsokStatus.Ocid,
 		SpecID:               subnet.Spec.SubnetId,
 		DesiredCompartmentID: subnet.Spec.CompartmentId,
@@ -513,7 +670,10 @@ func (c *OciSubnetServiceManager) UpdateSubnet(ctx context.Context, subnet *ociv
 			return err
 		},
 		BuildDetails: func(existing *ocicore.Subnet) (ocicore.UpdateSubnetDetails, bool) {
-			return buildSubnetUpdateDetails(subnet, existing)
+			desired := *subnet
+			desired.Spec.TagResources = util.MergeManagedTags(c.DefaultTags, subnet.Spec.TagResources)
+			desired.Spec.DefinedTags = withProtectedDefinedTags(desired.Spec.DefinedTags, existing.DefinedTags, c.ProtectedTagNamespaces)
+			return buildSubnetUpdateDetails(&desired, existing, routeTableID)
 		},
 		Update: func(targetID ociv1beta1.OCID, updateDetails ocicore.UpdateSubnetDetails) error {
 			_, err := client.UpdateSubnet(ctx, ocicore.UpdateSubnetRequest{
@@ -522,10 +682,83 @@ func (c *OciSubnetServiceManager) UpdateSubnet(ctx context.Context, subnet *ociv
 			})
 			return err
 		},
+		AfterUpdate: func(targetID ociv1beta1.OCID, existing *ocicore.Subnet) error {
+			return reconcileSubnetIpv6Cidrs(ctx, client, targetID, subnet.Spec.Ipv6CidrBlocks, existing.Ipv6CidrBlocks)
+		},
 	})
+	if err != nil {
+		return err
+	}
+	subnet.Status.RouteTableId = subnet.Spec.RouteTableId
+	return nil
+}
+
+// desiredSubnetRouteTable returns the route table the subnet should use. An explicit routeTableId wins.
+// With useVcnDefaultRouteTable set, or once a routeTableId the operator applied is removed from the
+// spec, it is the VCN's default route table. Otherwise it is empty and the route table is left alone.
+func desiredSubnetRouteTable(ctx context.Context, client VirtualNetworkClientInterface, subnet *ociv1beta1.OciSubnet) (ociv1beta1.OCID, error) {
+	if subnet.Spec.RouteTableId != "" {
+		return subnet.Spec.RouteTableId, nil
+	}
+	if !subnet.Spec.UseVcnDefaultRouteTable && subnet.Status.RouteTableId == "" {
+		return "", nil
+	}
+	return vcnDefaultRouteTable(ctx, client, subnet.Spec.VcnId)
+}
+
+// vcnDefaultRouteTable returns the OCID of the route table OCI created with the VCN.
+func vcnDefaultRouteTable(ctx context.Context, client VirtualNetworkClientInterface, vcnId ociv1beta1.OCID) (ociv1beta1.OCID, error) {
+	vcnResp, err := client.GetVcn(ctx, ocicore.GetVcnRequest{VcnId: common.String(string(vcnId))})
+	if err != nil {
+		return "", err
+	}
+	if vcnResp.DefaultRouteTableId == nil {
+		return "", fmt.Errorf("vcn %s has no default route table", vcnId)
+	}
+	return ociv1beta1.OCID(*vcnResp.DefaultRouteTableId), nil
+}
+
+// reconcileSubnetIpv6Cidrs adds and removes IPv6 prefixes so the subnet matches the spec.
+// An empty spec list leaves the subnet's IPv6 prefixes unmanaged.
+func reconcileSubnetIpv6Cidrs(ctx context.Context, client VirtualNetworkClientInterface, subnetID ociv1beta1.OCID,
+	desired []string, existing []string) error {
+	if len(desired) == 0 {
+		return nil
+	}
+
+	existingSet := make(map[string]bool, len(existing))
+	for _, cidr := range existing {
+		existingSet[cidr] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, cidr := range desired {
+		desiredSet[cidr] = true
+		if existingSet[cidr] {
+			continue
+		}
+		if _, err := client.AddIpv6SubnetCidr(ctx, ocicore.AddIpv6SubnetCidrRequest{
+			SubnetId:                 common.String(string(subnetID)),
+			AddSubnetIpv6CidrDetails: ocicore.AddSubnetIpv6CidrDetails{Ipv6CidrBlock: common.String(cidr)},
+		}); err != nil {
+			return err
+		}
+	}
+	for _, cidr := range existing {
+		if desiredSet[cidr] {
+			continue
+		}
+		if _, err := client.RemoveIpv6SubnetCidr(ctx, ocicore.RemoveIpv6SubnetCidrRequest{
+			SubnetId:                    common.String(string(subnetID)),
+			RemoveSubnetIpv6CidrDetails: ocicore.RemoveSubnetIpv6CidrDetails{Ipv6CidrBlock: common.String(cidr)},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func buildSubnetUpdateDetails(subnet *ociv1beta1.OciSubnet, existing *ocicore.Subnet) (ocicore.UpdateSubnetDetails, bool) {
+func buildSubnetUpdateDetails(subnet *ociv1beta1.OciSubnet, existing *ocicore.Subnet,
+	routeTableID ociv1beta1.OCID) (ocicore.UpdateSubnetDetails, bool) {
 	updateDetails := ocicore.UpdateSubnetDetails{}
 	updateNeeded := applySubnetDisplayNameUpdate(&updateDetails, subnet, existing)
 	if applySubnetFreeformTagUpdate(&updateDetails, subnet, existing) {
@@ -537,7 +770,7 @@ func buildSubnetUpdateDetails(subnet *ociv1beta1.OciSubnet, existing *ocicore.Su
 	if applySubnetCIDRUpdate(&updateDetails, subnet, existing) {
 		updateNeeded = true
 	}
-	if applySubnetRouteTableUpdate(&updateDetails, subnet, existing) {
+	if applySubnetRouteTableUpdate(&updateDetails, routeTableID, existing) {
 		updateNeeded = true
 	}
 	if applySubnetSecurityListsUpdate(&updateDetails, subnet, existing) {
@@ -556,10 +789,11 @@ func applySubnetDisplayNameUpdate(updateDetails *ocicore.UpdateSubnetDetails, su
 }
 
 func applySubnetFreeformTagUpdate(updateDetails *ocicore.UpdateSubnetDetails, subnet *ociv1beta1.OciSubnet, existing *ocicore.Subnet) bool {
-	if !networkingFreeformTagsChanged(subnet.Spec.FreeFormTags, existing.FreeformTags) {
+	desiredFreeformTags := desiredManagedFreeformTags(subnet.Spec.FreeFormTags, subnet.Namespace, subnet.Name)
+	if !networkingFreeformTagsChanged(desiredFreeformTags, existing.FreeformTags) {
 		return false
 	}
-	updateDetails.FreeformTags = subnet.Spec.FreeFormTags
+	updateDetails.FreeformTags = desiredFreeformTags
 	return true
 }
 
@@ -580,11 +814,11 @@ func applySubnetCIDRUpdate(updateDetails *ocicore.UpdateSubnetDetails, subnet *o
 	return true
 }
 
-func applySubnetRouteTableUpdate(updateDetails *ocicore.UpdateSubnetDetails, subnet *ociv1beta1.OciSubnet, existing *ocicore.Subnet) bool {
-	if subnet.Spec.RouteTableId == "" || (existing.RouteTableId != nil && *existing.RouteTableId == string(subnet.Spec.RouteTableId)) {
+func applySubnetRouteTableUpdate(updateDetails *ocicore.UpdateSubnetDetails, routeTableID ociv1beta1.OCID, existing *ocicore.Subnet) bool {
+	if routeTableID == "" || (existing.RouteTableId != nil && *existing.RouteTableId == string(routeTableID)) {
 		return false
 	}
-	updateDetails.RouteTableId = common.String(string(subnet.Spec.RouteTableId))
+	updateDetails.RouteTableId = common.String(string(routeTableID))
 	return true
 }
 
@@ -615,7 +849,7 @@ func validateSubnetUnsupportedChanges(subnet *ociv1beta1.OciSubnet, existing *oc
 
 // DeleteSubnet deletes the Subnet for the given OCID.
 func (c *OciSubnetServiceManager) DeleteSubnet(ctx context.Context, subnetId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -628,7 +862,7 @@ func (c *OciSubnetServiceManager) DeleteSubnet(ctx context.Context, subnetId oci
 
 // CreateInternetGateway calls the OCI API to create a new Internet Gateway.
 func (c *OciInternetGatewayServiceManager) CreateInternetGateway(ctx context.Context, igw ociv1beta1.OciInternetGateway) (*ocicore.InternetGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -656,7 +890,7 @@ func (c *OciInternetGatewayServiceManager) CreateInternetGateway(ctx context.Con
 
 // GetInternetGateway retrieves an Internet Gateway by OCID.
 func (c *OciInternetGatewayServiceManager) GetInternetGateway(ctx context.Context, igwId ociv1beta1.OCID) (*ocicore.InternetGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -670,7 +904,7 @@ func (c *OciInternetGatewayServiceManager) GetInternetGateway(ctx context.Contex
 
 // GetInternetGatewayOcid looks up an existing Internet Gateway by display name and returns its OCID if found.
 func (c *OciInternetGatewayServiceManager) GetInternetGatewayOcid(ctx context.Context, igw ociv1beta1.OciInternetGateway) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -707,12 +941,13 @@ func (c *OciInternetGatewayServiceManager) GetInternetGatewayOcid(ctx context.Co
 
 // UpdateInternetGateway updates an existing Internet Gateway's display name and tags.
 func (c *OciInternetGatewayServiceManager) UpdateInternetGateway(ctx context.Context, igw *ociv1beta1.OciInternetGateway) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.InternetGateway, ocicore.UpdateInternetGatewayDetails]{
+		Kind:                 driftKind("OciInternetGateway", igw.Generation, igw.Status.OsokStatus.ObservedGeneration),
 		StatusID:             igw.Status.OsokStatus.Ocid,
 		SpecID:               igw.Spec.InternetGatewayId,
 		DesiredCompartmentID: igw.Spec.CompartmentId,
@@ -769,7 +1004,7 @@ func buildInternetGatewayUpdateDetails(igw *ociv1beta1.OciInternetGateway, exist
 
 // DeleteInternetGateway deletes the Internet Gateway for the given OCID.
 func (c *OciInternetGatewayServiceManager) DeleteInternetGateway(ctx context.Context, igwId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -782,7 +1017,7 @@ func (c *OciInternetGatewayServiceManager) DeleteInternetGateway(ctx context.Con
 
 // CreateNatGateway calls the OCI API to create a new NAT Gateway.
 func (c *OciNatGatewayServiceManager) CreateNatGateway(ctx context.Context, nat ociv1beta1.OciNatGateway) (*ocicore.NatGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -811,7 +1046,7 @@ func (c *OciNatGatewayServiceManager) CreateNatGateway(ctx context.Context, nat
 
 // GetNatGateway retrieves a NAT Gateway by OCID.
 func (c *OciNatGatewayServiceManager) GetNatGateway(ctx context.Context, natId ociv1beta1.OCID) (*ocicore.NatGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -825,7 +1060,7 @@ func (c *OciNatGatewayServiceManager) GetNatGateway(ctx context.Context, natId o
 
 // GetNatGatewayOcid looks up an existing NAT Gateway by display name and returns its OCID if found.
 func (c *OciNatGatewayServiceManager) GetNatGatewayOcid(ctx context.Context, nat ociv1beta1.OciNatGateway) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -862,12 +1097,13 @@ func (c *OciNatGatewayServiceManager) GetNatGatewayOcid(ctx context.Context, nat
 
 // UpdateNatGateway updates an existing NAT Gateway's display name and tags.
 func (c *OciNatGatewayServiceManager) UpdateNatGateway(ctx context.Context, nat *ociv1beta1.OciNatGateway) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.NatGateway, ocicore.UpdateNatGatewayDetails]{
+		Kind:                 driftKind("OciNatGateway", nat.Generation, nat.Status.OsokStatus.ObservedGeneration),
 		StatusID:             nat.Status.OsokStatus.Ocid,
 		SpecID:               nat.Spec.NatGatewayId,
 		DesiredCompartmentID: nat.Spec.CompartmentId,
@@ -928,7 +1164,7 @@ func buildNatGatewayUpdateDetails(nat *ociv1beta1.OciNatGateway, existing *ocico
 
 // DeleteNatGateway deletes the NAT Gateway for the given OCID.
 func (c *OciNatGatewayServiceManager) DeleteNatGateway(ctx context.Context, natId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -941,7 +1177,7 @@ func (c *OciNatGatewayServiceManager) DeleteNatGateway(ctx context.Context, natI
 
 // CreateServiceGateway calls the OCI API to create a new Service Gateway.
 func (c *OciServiceGatewayServiceManager) CreateServiceGateway(ctx context.Context, sgw ociv1beta1.OciServiceGateway) (*ocicore.ServiceGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -968,7 +1204,7 @@ func (c *OciServiceGatewayServiceManager) CreateServiceGateway(ctx context.Conte
 
 // GetServiceGateway retrieves a Service Gateway by OCID.
 func (c *OciServiceGatewayServiceManager) GetServiceGateway(ctx context.Context, sgwId ociv1beta1.OCID) (*ocicore.ServiceGateway, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -982,7 +1218,7 @@ func (c *OciServiceGatewayServiceManager) GetServiceGateway(ctx context.Context,
 
 // GetServiceGatewayOcid looks up an existing Service Gateway by display name and returns its OCID if found.
 func (c *OciServiceGatewayServiceManager) GetServiceGatewayOcid(ctx context.Context, sgw ociv1beta1.OciServiceGateway) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1017,14 +1253,15 @@ func (c *OciServiceGatewayServiceManager) GetServiceGatewayOcid(ctx context.Cont
 	return nil, nil
 }
 
-// UpdateServiceGateway updates an existing Service Gateway's display name and tags.
+// UpdateServiceGateway updates an existing Service Gateway's display name, tags, services, and traffic blocking.
 func (c *OciServiceGatewayServiceManager) UpdateServiceGateway(ctx context.Context, sgw *ociv1beta1.OciServiceGateway) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.ServiceGateway, ocicore.UpdateServiceGatewayDetails]{
+		Kind:                 driftKind("OciServiceGateway", sgw.Generation, sgw.Status.OsokStatus.ObservedGeneration),
 		StatusID:             sgw.Status.OsokStatus.Ocid,
 		SpecID:               sgw.Spec.ServiceGatewayId,
 		DesiredCompartmentID: sgw.Spec.CompartmentId,
@@ -1075,6 +1312,10 @@ func buildServiceGatewayUpdateDetails(sgw *ociv1beta1.OciServiceGateway, existin
 		updateDetails.DefinedTags = desiredTags
 		updateNeeded = true
 	}
+	if existing.BlockTraffic != nil && *existing.BlockTraffic != sgw.Spec.BlockTraffic {
+		updateDetails.BlockTraffic = common.Bool(sgw.Spec.BlockTraffic)
+		updateNeeded = true
+	}
 	if len(sgw.Spec.Services) > 0 && !slicesEqualIgnoringOrder(serviceGatewayServiceIDs(existing.Services), sgw.Spec.Services) {
 		updateDetails.Services = buildServiceGatewayServices(sgw.Spec.Services)
 		updateNeeded = true
@@ -1085,7 +1326,7 @@ func buildServiceGatewayUpdateDetails(sgw *ociv1beta1.OciServiceGateway, existin
 
 // DeleteServiceGateway deletes the Service Gateway for the given OCID.
 func (c *OciServiceGatewayServiceManager) DeleteServiceGateway(ctx context.Context, sgwId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1098,7 +1339,7 @@ func (c *OciServiceGatewayServiceManager) DeleteServiceGateway(ctx context.Conte
 
 // CreateDrg calls the OCI API to create a new DRG.
 func (c *OciDrgServiceManager) CreateDrg(ctx context.Context, drg ociv1beta1.OciDrg) (*ocicore.Drg, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1123,7 +1364,7 @@ func (c *OciDrgServiceManager) CreateDrg(ctx context.Context, drg ociv1beta1.Oci
 
 // GetDrg retrieves a DRG by OCID.
 func (c *OciDrgServiceManager) GetDrg(ctx context.Context, drgId ociv1beta1.OCID) (*ocicore.Drg, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1137,7 +1378,7 @@ func (c *OciDrgServiceManager) GetDrg(ctx context.Context, drgId ociv1beta1.OCID
 
 // GetDrgOcid looks up an existing DRG by display name and returns its OCID if found.
 func (c *OciDrgServiceManager) GetDrgOcid(ctx context.Context, drg ociv1beta1.OciDrg) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,14 +1412,15 @@ func (c *OciDrgServiceManager) GetDrgOcid(ctx context.Context, drg ociv1beta1.Oc
 	return nil, nil
 }
 
-// UpdateDrg updates an existing DRG's display name and tags.
+// UpdateDrg updates an existing DRG's display name and tags, and reconciles its route distribution statements.
 func (c *OciDrgServiceManager) UpdateDrg(ctx context.Context, drg *ociv1beta1.OciDrg) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.Drg, ocicore.UpdateDrgDetails]{
+		Kind:                 driftKind("OciDrg", drg.Generation, drg.Status.OsokStatus.ObservedGeneration),
 		StatusID:             drg.Status.OsokStatus.Ocid,
 		SpecID:               drg.Spec.DrgId,
 		DesiredCompartmentID: drg.Spec.CompartmentId,
@@ -1207,9 +1449,138 @@ func (c *OciDrgServiceManager) UpdateDrg(ctx context.Context, drg *ociv1beta1.Oc
 			})
 			return err
 		},
+		AfterUpdate: func(_ ociv1beta1.OCID, existing *ocicore.Drg) error {
+			drg.Status.DrgVersion = drgVersion(existing)
+			return c.reconcileDrgRouteDistribution(ctx, client, drg.Spec.RouteDistribution, existing)
+		},
 	})
 }
 
+// reconcileDrgRouteDistribution makes the selected route distribution contain exactly the statements in the spec.
+// Changed statements are removed before the replacements are added so that priorities never collide.
+func (c *OciDrgServiceManager) reconcileDrgRouteDistribution(ctx context.Context, client VirtualNetworkClientInterface,
+	desired *ociv1beta1.DrgRouteDistribution, existing *ocicore.Drg) error {
+	if desired == nil {
+		return nil
+	}
+	if err := requireDrgV2(existing, "routeDistribution"); err != nil {
+		return err
+	}
+
+	distributionID := string(desired.DrgRouteDistributionId)
+	if distributionID == "" {
+		distributionID = safeString(existing.DefaultExportDrgRouteDistributionId)
+	}
+	if distributionID == "" {
+		return fmt.Errorf("routeDistribution.id is required because the DRG has no default export route distribution")
+	}
+
+	existingByKey := map[string]string{}
+	req := ocicore.ListDrgRouteDistributionStatementsRequest{
+		DrgRouteDistributionId: common.String(distributionID),
+	}
+	for {
+		resp, err := client.ListDrgRouteDistributionStatements(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, item := range resp.Items {
+			existingByKey[drgRouteDistributionStatementKey(item)] = safeString(item.Id)
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	var toAdd []ocicore.AddDrgRouteDistributionStatementDetails
+	desiredKeys := map[string]bool{}
+	for _, statement := range desired.Statements {
+		details := buildDrgRouteDistributionStatement(statement)
+		key := drgRouteDistributionStatementKey(ocicore.DrgRouteDistributionStatement{
+			Priority:      details.Priority,
+			MatchCriteria: details.MatchCriteria,
+		})
+		desiredKeys[key] = true
+		if _, ok := existingByKey[key]; !ok {
+			toAdd = append(toAdd, details)
+		}
+	}
+
+	var toRemove []string
+	for key, id := range existingByKey {
+		if !desiredKeys[key] {
+			toRemove = append(toRemove, id)
+		}
+	}
+	sort.Strings(toRemove)
+
+	if len(toRemove) > 0 {
+		c.Log.DebugLog("Removing DRG route distribution statements", "distribution", distributionID, "count", len(toRemove))
+		if _, err := client.RemoveDrgRouteDistributionStatements(ctx, ocicore.RemoveDrgRouteDistributionStatementsRequest{
+			DrgRouteDistributionId: common.String(distributionID),
+			RemoveDrgRouteDistributionStatementsDetails: ocicore.RemoveDrgRouteDistributionStatementsDetails{
+				StatementIds: toRemove,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		c.Log.DebugLog("Adding DRG route distribution statements", "distribution", distributionID, "count", len(toAdd))
+		if _, err := client.AddDrgRouteDistributionStatements(ctx, ocicore.AddDrgRouteDistributionStatementsRequest{
+			DrgRouteDistributionId: common.String(distributionID),
+			AddDrgRouteDistributionStatementsDetails: ocicore.AddDrgRouteDistributionStatementsDetails{
+				Statements: toAdd,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildDrgRouteDistributionStatement(statement ociv1beta1.DrgRouteDistributionStatement) ocicore.AddDrgRouteDistributionStatementDetails {
+	var criteria ocicore.DrgRouteDistributionMatchCriteria
+	switch statement.MatchType {
+	case "DRG_ATTACHMENT_TYPE":
+		criteria = ocicore.DrgAttachmentTypeDrgRouteDistributionMatchCriteria{
+			AttachmentType: ocicore.DrgAttachmentTypeDrgRouteDistributionMatchCriteriaAttachmentTypeEnum(statement.AttachmentType),
+		}
+	case "DRG_ATTACHMENT_ID":
+		criteria = ocicore.DrgAttachmentIdDrgRouteDistributionMatchCriteria{
+			DrgAttachmentId: common.String(string(statement.DrgAttachmentId)),
+		}
+	default:
+		criteria = ocicore.DrgAttachmentMatchAllDrgRouteDistributionMatchCriteria{}
+	}
+
+	return ocicore.AddDrgRouteDistributionStatementDetails{
+		Action:        ocicore.AddDrgRouteDistributionStatementDetailsActionAccept,
+		Priority:      common.Int(statement.Priority),
+		MatchCriteria: []ocicore.DrgRouteDistributionMatchCriteria{criteria},
+	}
+}
+
+// drgRouteDistributionStatementKey identifies a statement by its priority and match criteria.
+// A statement without criteria matches everything, the same as an explicit MATCH_ALL criterion.
+func drgRouteDistributionStatementKey(statement ocicore.DrgRouteDistributionStatement) string {
+	match := "MATCH_ALL"
+	for _, criteria := range statement.MatchCriteria {
+		switch typed := criteria.(type) {
+		case ocicore.DrgAttachmentTypeDrgRouteDistributionMatchCriteria:
+			match = "DRG_ATTACHMENT_TYPE|" + string(typed.AttachmentType)
+		case ocicore.DrgAttachmentIdDrgRouteDistributionMatchCriteria:
+			match = "DRG_ATTACHMENT_ID|" + safeString(typed.DrgAttachmentId)
+		}
+	}
+	priority := 0
+	if statement.Priority != nil {
+		priority = *statement.Priority
+	}
+	return fmt.Sprintf("%d|%s", priority, match)
+}
+
 func buildDrgUpdateDetails(drg *ociv1beta1.OciDrg, existing *ocicore.Drg) (ocicore.UpdateDrgDetails, bool) {
 	updateDetails := ocicore.UpdateDrgDetails{}
 	updateNeeded := false
@@ -1232,7 +1603,7 @@ func buildDrgUpdateDetails(drg *ociv1beta1.OciDrg, existing *ocicore.Drg) (ocico
 
 // DeleteDrg deletes the DRG for the given OCID.
 func (c *OciDrgServiceManager) DeleteDrg(ctx context.Context, drgId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1241,28 +1612,36 @@ func (c *OciDrgServiceManager) DeleteDrg(ctx context.Context, drgId ociv1beta1.O
 	return err
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciSecurityListServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciSecurityListServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
+	if c.ociClient != nil {
+		return c.ociClient, nil
+	}
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
+}
+
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciNetworkSecurityGroupServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciNetworkSecurityGroupServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciRouteTableServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
-// getOCIClient returns the injected client if set, otherwise creates one from the provider.
-func (c *OciRouteTableServiceManager) getOCIClient() (VirtualNetworkClientInterface, error) {
+// getOCIClient returns the injected client if set, otherwise creates one from the request or default provider.
+func (c *OciLocalPeeringGatewayServiceManager) getOCIClient(ctx context.Context) (VirtualNetworkClientInterface, error) {
 	if c.ociClient != nil {
 		return c.ociClient, nil
 	}
-	return getVirtualNetworkClient(c.Provider)
+	return newVirtualNetworkClient(servicemanager.RequestProvider(ctx, c.Provider))
 }
 
 // --- Security List CRUD ---
@@ -1272,7 +1651,7 @@ func buildIngressRules(rules []ociv1beta1.IngressSecurityRule) []ocicore.Ingress
 	for i, r := range rules {
 		rule := ocicore.IngressSecurityRule{
 			Protocol:    common.String(r.Protocol),
-			Source:      common.String(r.Source),
+			Source:      common.String(util.NormalizeCidr(r.Source)),
 			IsStateless: common.Bool(r.IsStateless),
 		}
 		if r.Description != "" {
@@ -1290,7 +1669,7 @@ func buildEgressRules(rules []ociv1beta1.EgressSecurityRule) []ocicore.EgressSec
 	for i, r := range rules {
 		rule := ocicore.EgressSecurityRule{
 			Protocol:    common.String(r.Protocol),
-			Destination: common.String(r.Destination),
+			Destination: common.String(util.NormalizeCidr(r.Destination)),
 			IsStateless: common.Bool(r.IsStateless),
 		}
 		if r.DestinationType != "" {
@@ -1339,9 +1718,222 @@ func buildUDPOptions(udpOptions *ociv1beta1.UdpOptions) *ocicore.UdpOptions {
 	}
 }
 
+// observedIngressRules converts the ingress rules OCI reports into their spec form.
+func observedIngressRules(rules []ocicore.IngressSecurityRule) []ociv1beta1.IngressSecurityRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	result := make([]ociv1beta1.IngressSecurityRule, len(rules))
+	for i, r := range rules {
+		result[i] = ociv1beta1.IngressSecurityRule{
+			Protocol:    safeString(r.Protocol),
+			Source:      safeString(r.Source),
+			IsStateless: r.IsStateless != nil && *r.IsStateless,
+			Description: safeString(r.Description),
+			TcpOptions:  observedTCPOptions(r.TcpOptions),
+			UdpOptions:  observedUDPOptions(r.UdpOptions),
+		}
+	}
+	return result
+}
+
+// observedEgressRules converts the egress rules OCI reports into their spec form.
+func observedEgressRules(rules []ocicore.EgressSecurityRule) []ociv1beta1.EgressSecurityRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	result := make([]ociv1beta1.EgressSecurityRule, len(rules))
+	for i, r := range rules {
+		result[i] = ociv1beta1.EgressSecurityRule{
+			Protocol:        safeString(r.Protocol),
+			Destination:     safeString(r.Destination),
+			DestinationType: string(r.DestinationType),
+			IsStateless:     r.IsStateless != nil && *r.IsStateless,
+			Description:     safeString(r.Description),
+			TcpOptions:      observedTCPOptions(r.TcpOptions),
+			UdpOptions:      observedUDPOptions(r.UdpOptions),
+		}
+	}
+	return result
+}
+
+// ingressRulesMatch reports whether the Security List already holds the desired ingress rules. Both sides
+// are compared in their spec form with CIDRs normalized, so a spec CIDR with host bits set matches the
+// network form OCI stores and does not trigger an update on every reconcile.
+func ingressRulesMatch(desired, existing []ocicore.IngressSecurityRule) bool {
+	return reflect.DeepEqual(canonicalIngressRules(desired), canonicalIngressRules(existing))
+}
+
+// egressRulesMatch is the egress counterpart of ingressRulesMatch.
+func egressRulesMatch(desired, existing []ocicore.EgressSecurityRule) bool {
+	return reflect.DeepEqual(canonicalEgressRules(desired), canonicalEgressRules(existing))
+}
+
+func canonicalIngressRules(rules []ocicore.IngressSecurityRule) []ociv1beta1.IngressSecurityRule {
+	result := observedIngressRules(rules)
+	for i := range result {
+		result[i].Source = util.NormalizeCidr(result[i].Source)
+	}
+	return result
+}
+
+func canonicalEgressRules(rules []ocicore.EgressSecurityRule) []ociv1beta1.EgressSecurityRule {
+	result := observedEgressRules(rules)
+	for i := range result {
+		result[i].Destination = util.NormalizeCidr(result[i].Destination)
+		if result[i].DestinationType == "" {
+			result[i].DestinationType = string(ocicore.EgressSecurityRuleDestinationTypeCidrBlock)
+		}
+	}
+	return result
+}
+
+func observedPortRange(portRange *ocicore.PortRange) *ociv1beta1.PortRange {
+	if portRange == nil || portRange.Min == nil || portRange.Max == nil {
+		return nil
+	}
+	return &ociv1beta1.PortRange{Min: *portRange.Min, Max: *portRange.Max}
+}
+
+func observedTCPOptions(tcpOptions *ocicore.TcpOptions) *ociv1beta1.TcpOptions {
+	if tcpOptions == nil {
+		return nil
+	}
+	return &ociv1beta1.TcpOptions{
+		DestinationPortRange: observedPortRange(tcpOptions.DestinationPortRange),
+		SourcePortRange:      observedPortRange(tcpOptions.SourcePortRange),
+	}
+}
+
+func observedUDPOptions(udpOptions *ocicore.UdpOptions) *ociv1beta1.UdpOptions {
+	if udpOptions == nil {
+		return nil
+	}
+	return &ociv1beta1.UdpOptions{
+		DestinationPortRange: observedPortRange(udpOptions.DestinationPortRange),
+		SourcePortRange:      observedPortRange(udpOptions.SourcePortRange),
+	}
+}
+
+// managedRuleDescriptionPrefix marks the security rules the operator owns when a Security List uses the
+// Merge rule management mode. Rules without it were added outside the operator and are left alone.
+const managedRuleDescriptionPrefix = "osok-managed"
+
+const securityListRuleManagementMerge = "Merge"
+
+// maxSecurityListRulesPerDirection is the OCI limit on the ingress rules of one Security List, and
+// separately on its egress rules.
+const maxSecurityListRulesPerDirection = 200
+
+// validateSecurityListRuleCount rejects rules that OCI would refuse for having too many in one direction.
+// It counts the rules as they are sent, so in Merge mode the rules kept from outside the operator count too.
+func validateSecurityListRuleCount(displayName string, ingress, egress int) error {
+	if ingress > maxSecurityListRulesPerDirection {
+		return fmt.Errorf("security list %s would hold %d ingress rules, exceeding the limit of %d",
+			displayName, ingress, maxSecurityListRulesPerDirection)
+	}
+	if egress > maxSecurityListRulesPerDirection {
+		return fmt.Errorf("security list %s would hold %d egress rules, exceeding the limit of %d",
+			displayName, egress, maxSecurityListRulesPerDirection)
+	}
+	return nil
+}
+
+// maxSecurityRuleDescriptionLength is the longest description OCI accepts on a security rule.
+const maxSecurityRuleDescriptionLength = 255
+
+// validateManagedRuleDescriptions rejects, in Merge mode, spec rules whose description would exceed the OCI
+// limit once the operator prepends managedRuleDescriptionPrefix.
+func validateManagedRuleDescriptions(sl *ociv1beta1.OciSecurityList) error {
+	if sl.Spec.RuleManagementMode != securityListRuleManagementMerge {
+		return nil
+	}
+	limit := maxSecurityRuleDescriptionLength - utf8.RuneCountInString(managedRuleDescriptionPrefix+": ")
+	for i, rule := range sl.Spec.IngressSecurityRules {
+		if n := utf8.RuneCountInString(rule.Description); n > limit {
+			return fmt.Errorf("ingress rule %d: description has %d characters, more than the %d allowed in Merge mode", i, n, limit)
+		}
+	}
+	for i, rule := range sl.Spec.EgressSecurityRules {
+		if n := utf8.RuneCountInString(rule.Description); n > limit {
+			return fmt.Errorf("egress rule %d: description has %d characters, more than the %d allowed in Merge mode", i, n, limit)
+		}
+	}
+	return nil
+}
+
+func managedRuleDescription(description *string) *string {
+	if description == nil || *description == "" {
+		return common.String(managedRuleDescriptionPrefix)
+	}
+	return common.String(managedRuleDescriptionPrefix + ": " + *description)
+}
+
+func isManagedRule(description *string) bool {
+	return description != nil && strings.HasPrefix(*description, managedRuleDescriptionPrefix)
+}
+
+// desiredIngressRules returns the ingress rules to send to OCI. In Merge mode the spec rules are marked
+// as operator-owned and the existing rules that are not owned by the operator are carried over.
+func desiredIngressRules(sl *ociv1beta1.OciSecurityList, existing []ocicore.IngressSecurityRule) []ocicore.IngressSecurityRule {
+	rules := buildIngressRules(sl.Spec.IngressSecurityRules)
+	if sl.Spec.RuleManagementMode != securityListRuleManagementMerge {
+		return rules
+	}
+	return mergeManagedRules(rules, existing,
+		func(rule *ocicore.IngressSecurityRule) **string { return &rule.Description },
+		func(rule ocicore.IngressSecurityRule) any {
+			return canonicalIngressRules([]ocicore.IngressSecurityRule{rule})[0]
+		})
+}
+
+// desiredEgressRules is the egress counterpart of desiredIngressRules.
+func desiredEgressRules(sl *ociv1beta1.OciSecurityList, existing []ocicore.EgressSecurityRule) []ocicore.EgressSecurityRule {
+	rules := buildEgressRules(sl.Spec.EgressSecurityRules)
+	if sl.Spec.RuleManagementMode != securityListRuleManagementMerge {
+		return rules
+	}
+	return mergeManagedRules(rules, existing,
+		func(rule *ocicore.EgressSecurityRule) **string { return &rule.Description },
+		func(rule ocicore.EgressSecurityRule) any {
+			return canonicalEgressRules([]ocicore.EgressSecurityRule{rule})[0]
+		})
+}
+
+// mergeManagedRules marks the spec rules as operator-owned and appends the existing rules that are not.
+// An unmarked existing rule that exactly matches a spec rule is claimed rather than kept: it was sent by
+// the operator before the Security List switched from Replace to Merge, and keeping it would leave a copy
+// of the rule next to its marked replacement.
+func mergeManagedRules[R any](rules, existing []R, description func(*R) **string, canonical func(R) any) []R {
+	specRules := make([]any, len(rules))
+	for i := range rules {
+		specRules[i] = canonical(rules[i])
+		*description(&rules[i]) = managedRuleDescription(*description(&rules[i]))
+	}
+	for _, rule := range existing {
+		if isManagedRule(*description(&rule)) {
+			continue
+		}
+		observed := canonical(rule)
+		if slices.ContainsFunc(specRules, func(spec any) bool { return reflect.DeepEqual(spec, observed) }) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 // CreateSecurityList calls the OCI API to create a new Security List.
 func (c *OciSecurityListServiceManager) CreateSecurityList(ctx context.Context, sl ociv1beta1.OciSecurityList) (*ocicore.SecurityList, error) {
-	client, err := c.getOCIClient()
+	if err := validateManagedRuleDescriptions(&sl); err != nil {
+		return nil, err
+	}
+	ingressRules, egressRules := desiredIngressRules(&sl, nil), desiredEgressRules(&sl, nil)
+	if err := validateSecurityListRuleCount(sl.Spec.DisplayName, len(ingressRules), len(egressRules)); err != nil {
+		return nil, err
+	}
+
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1352,8 +1944,8 @@ func (c *OciSecurityListServiceManager) CreateSecurityList(ctx context.Context,
 		CompartmentId:        common.String(string(sl.Spec.CompartmentId)),
 		VcnId:                common.String(string(sl.Spec.VcnId)),
 		DisplayName:          common.String(sl.Spec.DisplayName),
-		IngressSecurityRules: buildIngressRules(sl.Spec.IngressSecurityRules),
-		EgressSecurityRules:  buildEgressRules(sl.Spec.EgressSecurityRules),
+		IngressSecurityRules: ingressRules,
+		EgressSecurityRules:  egressRules,
 		FreeformTags:         sl.Spec.FreeFormTags,
 	}
 	if sl.Spec.DefinedTags != nil {
@@ -1369,7 +1961,7 @@ func (c *OciSecurityListServiceManager) CreateSecurityList(ctx context.Context,
 
 // GetSecurityList retrieves a Security List by OCID.
 func (c *OciSecurityListServiceManager) GetSecurityList(ctx context.Context, slId ociv1beta1.OCID) (*ocicore.SecurityList, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1383,7 +1975,7 @@ func (c *OciSecurityListServiceManager) GetSecurityList(ctx context.Context, slI
 
 // GetSecurityListOcid looks up an existing Security List by display name and returns its OCID if found.
 func (c *OciSecurityListServiceManager) GetSecurityListOcid(ctx context.Context, sl ociv1beta1.OciSecurityList) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1420,7 +2012,11 @@ func (c *OciSecurityListServiceManager) GetSecurityListOcid(ctx context.Context,
 
 // UpdateSecurityList updates an existing Security List's display name, tags, and rules.
 func (c *OciSecurityListServiceManager) UpdateSecurityList(ctx context.Context, sl *ociv1beta1.OciSecurityList) error {
-	client, err := c.getOCIClient()
+	if err := validateManagedRuleDescriptions(sl); err != nil {
+		return err
+	}
+
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1439,6 +2035,14 @@ func (c *OciSecurityListServiceManager) UpdateSecurityList(ctx context.Context,
 		return err
 	}
 
+	// The rules are always sent with an update, since OCI replaces both lists with what the request holds.
+	ingressRules := desiredIngressRules(sl, existing.IngressSecurityRules)
+	egressRules := desiredEgressRules(sl, existing.EgressSecurityRules)
+	if err := validateSecurityListRuleCount(sl.Spec.DisplayName, len(ingressRules), len(egressRules)); err != nil {
+		return err
+	}
+
+	kind := driftKind("OciSecurityList", sl.Generation, sl.Status.OsokStatus.ObservedGeneration)
 	if err := changeCompartmentIfNeeded(existing.CompartmentId, sl.Spec.CompartmentId, func(compartmentID ociv1beta1.OCID) error {
 		_, err := client.ChangeSecurityListCompartment(ctx, ocicore.ChangeSecurityListCompartmentRequest{
 			SecurityListId: common.String(string(targetID)),
@@ -1446,36 +2050,65 @@ func (c *OciSecurityListServiceManager) UpdateSecurityList(ctx context.Context,
 				CompartmentId: common.String(string(compartmentID)),
 			},
 		})
+		if err == nil {
+			recordDriftCorrections(kind, []string{"compartmentId"})
+		}
 		return err
 	}); err != nil {
 		return err
 	}
 
 	updateDetails := ocicore.UpdateSecurityListDetails{}
+	// changed lists the fields that differ from OCI, which are the ones counted as drift corrections.
+	var changed []string
 
 	if sl.Spec.DisplayName != "" {
 		updateDetails.DisplayName = common.String(sl.Spec.DisplayName)
+		if existing.DisplayName == nil || *existing.DisplayName != sl.Spec.DisplayName {
+			changed = append(changed, "displayName")
+		}
 	}
 	if len(sl.Spec.FreeFormTags) > 0 {
 		updateDetails.FreeformTags = sl.Spec.FreeFormTags
+		if networkingFreeformTagsChanged(sl.Spec.FreeFormTags, existing.FreeformTags) {
+			changed = append(changed, "freeformTags")
+		}
 	}
-	if sl.Spec.DefinedTags != nil {
-		updateDetails.DefinedTags = *util.ConvertToOciDefinedTags(&sl.Spec.DefinedTags)
+	if desiredTags, tagsChanged := networkingDefinedTagsChanged(sl.Spec.DefinedTags, existing.DefinedTags); desiredTags != nil {
+		updateDetails.DefinedTags = desiredTags
+		if tagsChanged {
+			changed = append(changed, "definedTags")
+		}
+	}
+	updateDetails.EgressSecurityRules = egressRules
+	updateDetails.IngressSecurityRules = ingressRules
+	if !ingressRulesMatch(ingressRules, existing.IngressSecurityRules) {
+		changed = append(changed, "ingressSecurityRules")
+	}
+	if !egressRulesMatch(egressRules, existing.EgressSecurityRules) {
+		changed = append(changed, "egressSecurityRules")
+	}
+
+	if len(changed) == 0 {
+		return nil
 	}
-	// Always reconcile egress and ingress rules so spec changes are applied on every update.
-	updateDetails.EgressSecurityRules = buildEgressRules(sl.Spec.EgressSecurityRules)
-	updateDetails.IngressSecurityRules = buildIngressRules(sl.Spec.IngressSecurityRules)
 
+	ruleDiff := diffSecurityRules(updateDetails.IngressSecurityRules, updateDetails.EgressSecurityRules, existing)
 	_, err = client.UpdateSecurityList(ctx, ocicore.UpdateSecurityListRequest{
 		SecurityListId:            common.String(string(targetID)),
 		UpdateSecurityListDetails: updateDetails,
 	})
-	return err
-}
-
+	if err != nil {
+		return err
+	}
+	recordDriftCorrections(kind, changed)
+	c.recordRuleDiff(sl, ruleDiff)
+	return nil
+}
+
 // DeleteSecurityList deletes the Security List for the given OCID.
 func (c *OciSecurityListServiceManager) DeleteSecurityList(ctx context.Context, slId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1484,11 +2117,29 @@ func (c *OciSecurityListServiceManager) DeleteSecurityList(ctx context.Context,
 	return err
 }
 
+// SubnetsUsingSecurityList returns the subnets in the Security List's compartment and VCN that still list it.
+func (c *OciSecurityListServiceManager) SubnetsUsingSecurityList(ctx context.Context, slId ociv1beta1.OCID,
+	compartmentId ociv1beta1.OCID, vcnId ociv1beta1.OCID) ([]string, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return referencingSubnets(ctx, client, compartmentId, vcnId, func(subnet ocicore.Subnet) bool {
+		for _, id := range subnet.SecurityListIds {
+			if id == string(slId) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
 // --- Network Security Group CRUD ---
 
 // CreateNetworkSecurityGroup calls the OCI API to create a new NSG.
 func (c *OciNetworkSecurityGroupServiceManager) CreateNetworkSecurityGroup(ctx context.Context, nsg ociv1beta1.OciNetworkSecurityGroup) (*ocicore.NetworkSecurityGroup, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1514,7 +2165,7 @@ func (c *OciNetworkSecurityGroupServiceManager) CreateNetworkSecurityGroup(ctx c
 
 // GetNetworkSecurityGroup retrieves an NSG by OCID.
 func (c *OciNetworkSecurityGroupServiceManager) GetNetworkSecurityGroup(ctx context.Context, nsgId ociv1beta1.OCID) (*ocicore.NetworkSecurityGroup, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1528,7 +2179,7 @@ func (c *OciNetworkSecurityGroupServiceManager) GetNetworkSecurityGroup(ctx cont
 
 // GetNetworkSecurityGroupOcid looks up an existing NSG by display name and returns its OCID if found.
 func (c *OciNetworkSecurityGroupServiceManager) GetNetworkSecurityGroupOcid(ctx context.Context, nsg ociv1beta1.OciNetworkSecurityGroup) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1565,12 +2216,13 @@ func (c *OciNetworkSecurityGroupServiceManager) GetNetworkSecurityGroupOcid(ctx
 
 // UpdateNetworkSecurityGroup updates an existing NSG's display name and tags.
 func (c *OciNetworkSecurityGroupServiceManager) UpdateNetworkSecurityGroup(ctx context.Context, nsg *ociv1beta1.OciNetworkSecurityGroup) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.NetworkSecurityGroup, ocicore.UpdateNetworkSecurityGroupDetails]{
+		Kind:                 driftKind("OciNetworkSecurityGroup", nsg.Generation, nsg.Status.OsokStatus.ObservedGeneration),
 		StatusID:             nsg.Status.OsokStatus.Ocid,
 		SpecID:               nsg.Spec.NetworkSecurityGroupId,
 		DesiredCompartmentID: nsg.Spec.CompartmentId,
@@ -1627,7 +2279,7 @@ func buildNetworkSecurityGroupUpdateDetails(nsg *ociv1beta1.OciNetworkSecurityGr
 
 // DeleteNetworkSecurityGroup deletes the NSG for the given OCID.
 func (c *OciNetworkSecurityGroupServiceManager) DeleteNetworkSecurityGroup(ctx context.Context, nsgId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1660,18 +2312,23 @@ func buildRouteRules(rules []ociv1beta1.RouteRule) []ocicore.RouteRule {
 
 // CreateRouteTable calls the OCI API to create a new Route Table.
 func (c *OciRouteTableServiceManager) CreateRouteTable(ctx context.Context, rt ociv1beta1.OciRouteTable) (*ocicore.RouteTable, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	c.Log.DebugLog("Creating OciRouteTable", "name", rt.Spec.DisplayName)
 
+	rules, err := c.applicableRouteRules(ctx, client, &rt)
+	if err != nil {
+		return nil, err
+	}
+
 	details := ocicore.CreateRouteTableDetails{
 		CompartmentId: common.String(string(rt.Spec.CompartmentId)),
 		VcnId:         common.String(string(rt.Spec.VcnId)),
 		DisplayName:   common.String(rt.Spec.DisplayName),
-		RouteRules:    buildRouteRules(rt.Spec.RouteRules),
+		RouteRules:    buildRouteRules(rules),
 		FreeformTags:  rt.Spec.FreeFormTags,
 	}
 	if rt.Spec.DefinedTags != nil {
@@ -1687,7 +2344,7 @@ func (c *OciRouteTableServiceManager) CreateRouteTable(ctx context.Context, rt o
 
 // GetRouteTable retrieves a Route Table by OCID.
 func (c *OciRouteTableServiceManager) GetRouteTable(ctx context.Context, rtId ociv1beta1.OCID) (*ocicore.RouteTable, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1701,7 +2358,7 @@ func (c *OciRouteTableServiceManager) GetRouteTable(ctx context.Context, rtId oc
 
 // GetRouteTableOcid looks up an existing Route Table by display name and returns its OCID if found.
 func (c *OciRouteTableServiceManager) GetRouteTableOcid(ctx context.Context, rt ociv1beta1.OciRouteTable) (*ociv1beta1.OCID, error) {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1738,7 +2395,7 @@ func (c *OciRouteTableServiceManager) GetRouteTableOcid(ctx context.Context, rt
 
 // UpdateRouteTable updates an existing Route Table's display name, tags, and route rules.
 func (c *OciRouteTableServiceManager) UpdateRouteTable(ctx context.Context, rt *ociv1beta1.OciRouteTable) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1757,6 +2414,7 @@ func (c *OciRouteTableServiceManager) UpdateRouteTable(ctx context.Context, rt *
 		return err
 	}
 
+	kind := driftKind("OciRouteTable", rt.Generation, rt.Status.OsokStatus.ObservedGeneration)
 	if err := changeCompartmentIfNeeded(existing.CompartmentId, rt.Spec.CompartmentId, func(compartmentID ociv1beta1.OCID) error {
 		_, err := client.ChangeRouteTableCompartment(ctx, ocicore.ChangeRouteTableCompartmentRequest{
 			RtId: common.String(string(targetID)),
@@ -1764,35 +2422,78 @@ func (c *OciRouteTableServiceManager) UpdateRouteTable(ctx context.Context, rt *
 				CompartmentId: common.String(string(compartmentID)),
 			},
 		})
+		if err == nil {
+			recordDriftCorrections(kind, []string{"compartmentId"})
+		}
 		return err
 	}); err != nil {
 		return err
 	}
 
 	updateDetails := ocicore.UpdateRouteTableDetails{}
+	// changed lists the fields that differ from OCI, which are the ones counted as drift corrections.
+	var changed []string
 
 	if rt.Spec.DisplayName != "" {
 		updateDetails.DisplayName = common.String(rt.Spec.DisplayName)
+		if existing.DisplayName == nil || *existing.DisplayName != rt.Spec.DisplayName {
+			changed = append(changed, "displayName")
+		}
 	}
 	if len(rt.Spec.FreeFormTags) > 0 {
 		updateDetails.FreeformTags = rt.Spec.FreeFormTags
+		if networkingFreeformTagsChanged(rt.Spec.FreeFormTags, existing.FreeformTags) {
+			changed = append(changed, "freeformTags")
+		}
 	}
-	if rt.Spec.DefinedTags != nil {
-		updateDetails.DefinedTags = *util.ConvertToOciDefinedTags(&rt.Spec.DefinedTags)
+	if desiredTags, tagsChanged := networkingDefinedTagsChanged(rt.Spec.DefinedTags, existing.DefinedTags); desiredTags != nil {
+		updateDetails.DefinedTags = desiredTags
+		if tagsChanged {
+			changed = append(changed, "definedTags")
+		}
 	}
 	// Always reconcile route rules so spec changes are applied on every update.
-	updateDetails.RouteRules = buildRouteRules(rt.Spec.RouteRules)
+	rules, err := c.applicableRouteRules(ctx, client, rt)
+	if err != nil {
+		return err
+	}
+	updateDetails.RouteRules = buildRouteRules(rules)
+	if !routeRulesMatch(updateDetails.RouteRules, existing.RouteRules) {
+		changed = append(changed, "routeRules")
+	}
 
 	_, err = client.UpdateRouteTable(ctx, ocicore.UpdateRouteTableRequest{
 		RtId:                    common.String(string(targetID)),
 		UpdateRouteTableDetails: updateDetails,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	recordDriftCorrections(kind, changed)
+	return nil
+}
+
+// routeRulesMatch reports whether the live route rules are the ones about to be sent. Destinations are
+// compared in their canonical CIDR form, and fields OCI fills in itself, such as the route type, are
+// ignored.
+func routeRulesMatch(desired, existing []ocicore.RouteRule) bool {
+	if len(desired) != len(existing) {
+		return false
+	}
+	for i := range desired {
+		if util.NormalizeCidr(safeString(desired[i].Destination)) != util.NormalizeCidr(safeString(existing[i].Destination)) ||
+			desired[i].DestinationType != existing[i].DestinationType ||
+			safeString(desired[i].NetworkEntityId) != safeString(existing[i].NetworkEntityId) ||
+			safeString(desired[i].Description) != safeString(existing[i].Description) {
+			return false
+		}
+	}
+	return true
 }
 
 // DeleteRouteTable deletes the Route Table for the given OCID.
 func (c *OciRouteTableServiceManager) DeleteRouteTable(ctx context.Context, rtId ociv1beta1.OCID) error {
-	client, err := c.getOCIClient()
+	client, err := c.getOCIClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1800,3 +2501,493 @@ func (c *OciRouteTableServiceManager) DeleteRouteTable(ctx context.Context, rtId
 	_, err = client.DeleteRouteTable(ctx, ocicore.DeleteRouteTableRequest{RtId: common.String(string(rtId))})
 	return err
 }
+
+// AttachRouteTableToSubnets points each listed subnet at the given Route Table.
+// Subnets already using the Route Table are left untouched.
+func (c *OciRouteTableServiceManager) AttachRouteTableToSubnets(ctx context.Context, rtId ociv1beta1.OCID, subnetIds []ociv1beta1.OCID) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, subnetID := range subnetIds {
+		subnetResp, err := client.GetSubnet(ctx, ocicore.GetSubnetRequest{SubnetId: common.String(string(subnetID))})
+		if err != nil {
+			return err
+		}
+		if err := setSubnetRouteTable(ctx, client, &subnetResp.Subnet, rtId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DetachRouteTableFromSubnets points every listed subnet that still uses the given Route Table
+// back at the VCN's default route table, so the Route Table can be deleted.
+func (c *OciRouteTableServiceManager) DetachRouteTableFromSubnets(ctx context.Context, rtId ociv1beta1.OCID,
+	vcnId ociv1beta1.OCID, subnetIds []ociv1beta1.OCID) error {
+	if len(subnetIds) == 0 {
+		return nil
+	}
+
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaultRouteTableID, err := vcnDefaultRouteTable(ctx, client, vcnId)
+	if err != nil {
+		return err
+	}
+
+	for _, subnetID := range subnetIds {
+		subnetResp, err := client.GetSubnet(ctx, ocicore.GetSubnetRequest{SubnetId: common.String(string(subnetID))})
+		if err != nil {
+			if isNotFoundServiceError(err) {
+				continue
+			}
+			return err
+		}
+		if safeString(subnetResp.RouteTableId) != string(rtId) {
+			continue
+		}
+		if err := setSubnetRouteTable(ctx, client, &subnetResp.Subnet, defaultRouteTableID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubnetsUsingRouteTable returns the subnets in the Route Table's compartment and VCN that still use it.
+func (c *OciRouteTableServiceManager) SubnetsUsingRouteTable(ctx context.Context, rtId ociv1beta1.OCID,
+	compartmentId ociv1beta1.OCID, vcnId ociv1beta1.OCID) ([]string, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return referencingSubnets(ctx, client, compartmentId, vcnId, func(subnet ocicore.Subnet) bool {
+		return safeString(subnet.RouteTableId) == string(rtId)
+	})
+}
+
+// setSubnetRouteTable updates a subnet to use routeTableID, reusing the subnet update rules.
+func setSubnetRouteTable(ctx context.Context, client VirtualNetworkClientInterface, existing *ocicore.Subnet,
+	routeTableID ociv1beta1.OCID) error {
+	updateDetails := ocicore.UpdateSubnetDetails{}
+	if !applySubnetRouteTableUpdate(&updateDetails, routeTableID, existing) {
+		return nil
+	}
+
+	_, err := client.UpdateSubnet(ctx, ocicore.UpdateSubnetRequest{
+		SubnetId:            existing.Id,
+		UpdateSubnetDetails: updateDetails,
+	})
+	return err
+}
+
+// --- DRG Route Table CRUD ---
+
+func isDrgRouteTable(rt ociv1beta1.OciRouteTable) bool {
+	return rt.Spec.RouteTableType == "DRG"
+}
+
+func drgRouteRuleKey(destination, destinationType, nextHop string) string {
+	return destinationType + "|" + destination + "|" + nextHop
+}
+
+func buildDrgRouteRules(rules []ociv1beta1.RouteRule) []ocicore.AddDrgRouteRuleDetails {
+	result := make([]ocicore.AddDrgRouteRuleDetails, len(rules))
+	for i, r := range rules {
+		result[i] = ocicore.AddDrgRouteRuleDetails{
+			DestinationType:        ocicore.AddDrgRouteRuleDetailsDestinationTypeCidrBlock,
+			Destination:            common.String(r.Destination),
+			NextHopDrgAttachmentId: common.String(r.NetworkEntityId),
+		}
+	}
+	return result
+}
+
+// CreateDrgRouteTable calls the OCI API to create a new DRG Route Table and adds its static route rules.
+func (c *OciRouteTableServiceManager) CreateDrgRouteTable(ctx context.Context, rt ociv1beta1.OciRouteTable) (*ocicore.DrgRouteTable, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.DebugLog("Creating DRG OciRouteTable", "name", rt.Spec.DisplayName)
+
+	details := ocicore.CreateDrgRouteTableDetails{
+		DrgId:        common.String(string(rt.Spec.DrgId)),
+		DisplayName:  common.String(rt.Spec.DisplayName),
+		FreeformTags: rt.Spec.FreeFormTags,
+	}
+	if rt.Spec.DefinedTags != nil {
+		details.DefinedTags = *util.ConvertToOciDefinedTags(&rt.Spec.DefinedTags)
+	}
+
+	resp, err := client.CreateDrgRouteTable(ctx, ocicore.CreateDrgRouteTableRequest{CreateDrgRouteTableDetails: details})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rt.Spec.RouteRules) > 0 {
+		if _, err := client.AddDrgRouteRules(ctx, ocicore.AddDrgRouteRulesRequest{
+			DrgRouteTableId:         resp.Id,
+			AddDrgRouteRulesDetails: ocicore.AddDrgRouteRulesDetails{RouteRules: buildDrgRouteRules(rt.Spec.RouteRules)},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &resp.DrgRouteTable, nil
+}
+
+// GetDrgRouteTable retrieves a DRG Route Table by OCID.
+func (c *OciRouteTableServiceManager) GetDrgRouteTable(ctx context.Context, rtId ociv1beta1.OCID) (*ocicore.DrgRouteTable, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetDrgRouteTable(ctx, ocicore.GetDrgRouteTableRequest{DrgRouteTableId: common.String(string(rtId))})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.DrgRouteTable, nil
+}
+
+// GetDrgRouteTableOcid looks up an existing DRG Route Table by display name and returns its OCID if found.
+func (c *OciRouteTableServiceManager) GetDrgRouteTableOcid(ctx context.Context, rt ociv1beta1.OciRouteTable) (*ociv1beta1.OCID, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := ocicore.ListDrgRouteTablesRequest{
+		DrgId:       common.String(string(rt.Spec.DrgId)),
+		DisplayName: common.String(rt.Spec.DisplayName),
+		Limit:       common.Int(100),
+	}
+	for {
+		resp, err := client.ListDrgRouteTables(ctx, req)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error listing DRG Route Tables")
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			if networkingLookupStateMatches(string(item.LifecycleState)) {
+				c.Log.DebugLog(fmt.Sprintf("DRG OciRouteTable %s exists with OCID %s", rt.Spec.DisplayName, *item.Id))
+				return (*ociv1beta1.OCID)(item.Id), nil
+			}
+		}
+
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	c.Log.DebugLog(fmt.Sprintf("DRG OciRouteTable %s does not exist", rt.Spec.DisplayName))
+	return nil, nil
+}
+
+// UpdateDrgRouteTable updates an existing DRG Route Table's display name and tags, and reconciles its static route rules.
+func (c *OciRouteTableServiceManager) UpdateDrgRouteTable(ctx context.Context, rt *ociv1beta1.OciRouteTable) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	targetID, err := resolveResourceID(rt.Status.OsokStatus.Ocid, rt.Spec.RouteTableId)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.GetDrgRouteTable(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	if err := rejectUnsupportedOCIDChange("drgId", existing.DrgId, rt.Spec.DrgId); err != nil {
+		return err
+	}
+
+	updateDetails := ocicore.UpdateDrgRouteTableDetails{}
+	updateNeeded := false
+
+	if rt.Spec.DisplayName != "" && (existing.DisplayName == nil || *existing.DisplayName != rt.Spec.DisplayName) {
+		updateDetails.DisplayName = common.String(rt.Spec.DisplayName)
+		updateNeeded = true
+	}
+	if networkingFreeformTagsChanged(rt.Spec.FreeFormTags, existing.FreeformTags) {
+		updateDetails.FreeformTags = rt.Spec.FreeFormTags
+		updateNeeded = true
+	}
+	if desiredDefinedTags, changed := networkingDefinedTagsChanged(rt.Spec.DefinedTags, existing.DefinedTags); changed {
+		updateDetails.DefinedTags = desiredDefinedTags
+		updateNeeded = true
+	}
+
+	if updateNeeded {
+		if _, err := client.UpdateDrgRouteTable(ctx, ocicore.UpdateDrgRouteTableRequest{
+			DrgRouteTableId:            common.String(string(targetID)),
+			UpdateDrgRouteTableDetails: updateDetails,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return c.reconcileDrgRouteRules(ctx, client, targetID, rt.Spec.RouteRules)
+}
+
+// reconcileDrgRouteRules removes static rules that are no longer in the spec and adds the missing ones.
+func (c *OciRouteTableServiceManager) reconcileDrgRouteRules(ctx context.Context, client VirtualNetworkClientInterface,
+	rtId ociv1beta1.OCID, desired []ociv1beta1.RouteRule) error {
+	existingByKey := map[string]string{}
+	req := ocicore.ListDrgRouteRulesRequest{
+		DrgRouteTableId: common.String(string(rtId)),
+		RouteType:       ocicore.ListDrgRouteRulesRouteTypeStatic,
+	}
+	for {
+		resp, err := client.ListDrgRouteRules(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, item := range resp.Items {
+			key := drgRouteRuleKey(safeString(item.Destination), string(item.DestinationType), safeString(item.NextHopDrgAttachmentId))
+			existingByKey[key] = safeString(item.Id)
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	var toAdd []ociv1beta1.RouteRule
+	desiredKeys := map[string]bool{}
+	for _, r := range desired {
+		key := drgRouteRuleKey(r.Destination, string(ocicore.AddDrgRouteRuleDetailsDestinationTypeCidrBlock), r.NetworkEntityId)
+		desiredKeys[key] = true
+		if _, ok := existingByKey[key]; !ok {
+			toAdd = append(toAdd, r)
+		}
+	}
+
+	var toRemove []string
+	for key, id := range existingByKey {
+		if !desiredKeys[key] {
+			toRemove = append(toRemove, id)
+		}
+	}
+	sort.Strings(toRemove)
+
+	if len(toRemove) > 0 {
+		if _, err := client.RemoveDrgRouteRules(ctx, ocicore.RemoveDrgRouteRulesRequest{
+			DrgRouteTableId:            common.String(string(rtId)),
+			RemoveDrgRouteRulesDetails: ocicore.RemoveDrgRouteRulesDetails{RouteRuleIds: toRemove},
+		}); err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		if _, err := client.AddDrgRouteRules(ctx, ocicore.AddDrgRouteRulesRequest{
+			DrgRouteTableId:         common.String(string(rtId)),
+			AddDrgRouteRulesDetails: ocicore.AddDrgRouteRulesDetails{RouteRules: buildDrgRouteRules(toAdd)},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteDrgRouteTable deletes the DRG Route Table for the given OCID.
+func (c *OciRouteTableServiceManager) DeleteDrgRouteTable(ctx context.Context, rtId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteDrgRouteTable(ctx, ocicore.DeleteDrgRouteTableRequest{DrgRouteTableId: common.String(string(rtId))})
+	return err
+}
+
+// --- Local Peering Gateway CRUD ---
+
+// CreateLocalPeeringGateway calls the OCI API to create a new Local Peering Gateway.
+func (c *OciLocalPeeringGatewayServiceManager) CreateLocalPeeringGateway(ctx context.Context,
+	lpg ociv1beta1.OciLocalPeeringGateway) (*ocicore.LocalPeeringGateway, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.DebugLog("Creating OciLocalPeeringGateway", "name", lpg.Spec.DisplayName)
+
+	details := ocicore.CreateLocalPeeringGatewayDetails{
+		CompartmentId: common.String(string(lpg.Spec.CompartmentId)),
+		VcnId:         common.String(string(lpg.Spec.VcnId)),
+		DisplayName:   common.String(lpg.Spec.DisplayName),
+		FreeformTags:  lpg.Spec.FreeFormTags,
+	}
+	if lpg.Spec.RouteTableId != "" {
+		details.RouteTableId = common.String(string(lpg.Spec.RouteTableId))
+	}
+	if lpg.Spec.DefinedTags != nil {
+		details.DefinedTags = *util.ConvertToOciDefinedTags(&lpg.Spec.DefinedTags)
+	}
+
+	resp, err := client.CreateLocalPeeringGateway(ctx, ocicore.CreateLocalPeeringGatewayRequest{CreateLocalPeeringGatewayDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.LocalPeeringGateway, nil
+}
+
+// GetLocalPeeringGateway retrieves a Local Peering Gateway by OCID.
+func (c *OciLocalPeeringGatewayServiceManager) GetLocalPeeringGateway(ctx context.Context, lpgId ociv1beta1.OCID) (*ocicore.LocalPeeringGateway, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetLocalPeeringGateway(ctx, ocicore.GetLocalPeeringGatewayRequest{LocalPeeringGatewayId: common.String(string(lpgId))})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.LocalPeeringGateway, nil
+}
+
+// GetLocalPeeringGatewayOcid looks up an existing Local Peering Gateway by display name and returns its OCID if found.
+// OCI cannot filter Local Peering Gateways by display name, so the VCN's gateways are matched here.
+func (c *OciLocalPeeringGatewayServiceManager) GetLocalPeeringGatewayOcid(ctx context.Context,
+	lpg ociv1beta1.OciLocalPeeringGateway) (*ociv1beta1.OCID, error) {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := ocicore.ListLocalPeeringGatewaysRequest{
+		CompartmentId: common.String(string(lpg.Spec.CompartmentId)),
+		VcnId:         common.String(string(lpg.Spec.VcnId)),
+		Limit:         common.Int(100),
+	}
+	for {
+		resp, err := client.ListLocalPeeringGateways(ctx, req)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error listing Local Peering Gateways")
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			if safeString(item.DisplayName) == lpg.Spec.DisplayName && networkingLookupStateMatches(string(item.LifecycleState)) {
+				c.Log.DebugLog(fmt.Sprintf("OciLocalPeeringGateway %s exists with OCID %s", lpg.Spec.DisplayName, *item.Id))
+				return (*ociv1beta1.OCID)(item.Id), nil
+			}
+		}
+
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	c.Log.DebugLog(fmt.Sprintf("OciLocalPeeringGateway %s does not exist", lpg.Spec.DisplayName))
+	return nil, nil
+}
+
+// UpdateLocalPeeringGateway updates an existing Local Peering Gateway's display name, route table, and tags.
+func (c *OciLocalPeeringGatewayServiceManager) UpdateLocalPeeringGateway(ctx context.Context, lpg *ociv1beta1.OciLocalPeeringGateway) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return updateSimpleNetworkingResource(networkingUpdateOps[ocicore.LocalPeeringGateway, ocicore.UpdateLocalPeeringGatewayDetails]{
+		Kind:                 driftKind("OciLocalPeeringGateway", lpg.Generation, lpg.Status.OsokStatus.ObservedGeneration),
+		StatusID:             lpg.Status.OsokStatus.Ocid,
+		SpecID:               lpg.Spec.LocalPeeringGatewayId,
+		DesiredCompartmentID: lpg.Spec.CompartmentId,
+		Get: func(id ociv1beta1.OCID) (*ocicore.LocalPeeringGateway, error) {
+			return c.GetLocalPeeringGateway(ctx, id)
+		},
+		ExistingCompartment: func(existing *ocicore.LocalPeeringGateway) *string {
+			return existing.CompartmentId
+		},
+		ValidateUnsupported: func(existing *ocicore.LocalPeeringGateway) error {
+			return rejectUnsupportedOCIDChange("vcnId", existing.VcnId, lpg.Spec.VcnId)
+		},
+		ChangeCompartment: func(targetID, compartmentID ociv1beta1.OCID) error {
+			_, err := client.ChangeLocalPeeringGatewayCompartment(ctx, ocicore.ChangeLocalPeeringGatewayCompartmentRequest{
+				LocalPeeringGatewayId: common.String(string(targetID)),
+				ChangeLocalPeeringGatewayCompartmentDetails: ocicore.ChangeLocalPeeringGatewayCompartmentDetails{
+					CompartmentId: common.String(string(compartmentID)),
+				},
+			})
+			return err
+		},
+		BuildDetails: func(existing *ocicore.LocalPeeringGateway) (ocicore.UpdateLocalPeeringGatewayDetails, bool) {
+			return buildLocalPeeringGatewayUpdateDetails(lpg, existing)
+		},
+		Update: func(targetID ociv1beta1.OCID, updateDetails ocicore.UpdateLocalPeeringGatewayDetails) error {
+			_, err := client.UpdateLocalPeeringGateway(ctx, ocicore.UpdateLocalPeeringGatewayRequest{
+				LocalPeeringGatewayId:            common.String(string(targetID)),
+				UpdateLocalPeeringGatewayDetails: updateDetails,
+			})
+			return err
+		},
+	})
+}
+
+func buildLocalPeeringGatewayUpdateDetails(lpg *ociv1beta1.OciLocalPeeringGateway,
+	existing *ocicore.LocalPeeringGateway) (ocicore.UpdateLocalPeeringGatewayDetails, bool) {
+	updateDetails := ocicore.UpdateLocalPeeringGatewayDetails{}
+	updateNeeded := false
+
+	if lpg.Spec.DisplayName != "" && safeString(existing.DisplayName) != lpg.Spec.DisplayName {
+		updateDetails.DisplayName = common.String(lpg.Spec.DisplayName)
+		updateNeeded = true
+	}
+	if lpg.Spec.RouteTableId != "" && safeString(existing.RouteTableId) != string(lpg.Spec.RouteTableId) {
+		updateDetails.RouteTableId = common.String(string(lpg.Spec.RouteTableId))
+		updateNeeded = true
+	}
+	if networkingFreeformTagsChanged(lpg.Spec.FreeFormTags, existing.FreeformTags) {
+		updateDetails.FreeformTags = lpg.Spec.FreeFormTags
+		updateNeeded = true
+	}
+	if desiredTags, changed := networkingDefinedTagsChanged(lpg.Spec.DefinedTags, existing.DefinedTags); changed {
+		updateDetails.DefinedTags = desiredTags
+		updateNeeded = true
+	}
+
+	return updateDetails, updateNeeded
+}
+
+// ConnectLocalPeeringGateways peers the Local Peering Gateway with peerId.
+func (c *OciLocalPeeringGatewayServiceManager) ConnectLocalPeeringGateways(ctx context.Context, lpgId ociv1beta1.OCID,
+	peerId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ConnectLocalPeeringGateways(ctx, ocicore.ConnectLocalPeeringGatewaysRequest{
+		LocalPeeringGatewayId: common.String(string(lpgId)),
+		ConnectLocalPeeringGatewaysDetails: ocicore.ConnectLocalPeeringGatewaysDetails{
+			PeerId: common.String(string(peerId)),
+		},
+	})
+	return err
+}
+
+// DeleteLocalPeeringGateway deletes the Local Peering Gateway for the given OCID.
+func (c *OciLocalPeeringGatewayServiceManager) DeleteLocalPeeringGateway(ctx context.Context, lpgId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteLocalPeeringGateway(ctx, ocicore.DeleteLocalPeeringGatewayRequest{LocalPeeringGatewayId: common.String(string(lpgId))})
+	return err
+}
@@ -7,7 +7,9 @@ package networking
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ocicore "github.com/oracle/oci-go-sdk/v65/core"
@@ -18,6 +20,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -30,7 +33,18 @@ type OciVcnServiceManager struct {
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
-	ociClient        VirtualNetworkClientInterface
+	Recorder         record.EventRecorder
+	RetentionTag     RetentionTag
+	// DeleteTimeout is how long a delete may run before the finalizer can be force-removed. Zero disables it.
+	DeleteTimeout time.Duration
+	// QuietWindow lists the UTC time ranges in which creates, updates and deletes are deferred, in the
+	// format of util.ValidateQuietWindow. Empty disables it.
+	QuietWindow string
+	DefaultTags ociv1beta1.TagResources
+	// ProtectedTagNamespaces are defined-tag namespaces that updates keep as they are in OCI.
+	ProtectedTagNamespaces []string
+	ociClient              VirtualNetworkClientInterface
+	compartments           compartmentNameResolver
 }
 
 // NewOciVcnServiceManager creates a new OciVcnServiceManager.
@@ -52,7 +66,27 @@ func (c *OciVcnServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
-	vcnInstance, err := reconcileNetworkingResource(networkingCreateOrUpdateOps[ocicore.Vcn]{
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, vcn.Namespace, vcn.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, vcn.Spec.Region)
+
+	restoreCompartment, err := c.compartments.resolveSpecCompartment(ctx, c.Provider, &vcn.Spec.CompartmentId, vcn.Spec.CompartmentName)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving compartment name failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreCompartment()
+
+	if response, reserved := reserveCreateRetryToken(vcn.Spec.VcnId, &vcn.Status.OsokStatus, &vcn.Status.CreateRetryToken,
+		"OciVcn", vcn.Spec.DisplayName, c.Log); reserved {
+		return response, nil
+	}
+
+	quietUntil, quiet := quietWindowEnd(c.QuietWindow)
+	vcnInstance, err := reconcileNetworkingResource(withQuietWindow(networkingCreateOrUpdateOps[ocicore.Vcn]{
 		SpecID: vcn.Spec.VcnId,
 		Status: &vcn.Status.OsokStatus,
 		Get: func(id ociv1beta1.OCID) (*ocicore.Vcn, error) {
@@ -67,6 +101,9 @@ func (c *OciVcnServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 		Create: func() (*ocicore.Vcn, error) {
 			return c.CreateVcn(ctx, *vcn)
 		},
+		IsTerminal: func(instance *ocicore.Vcn) bool {
+			return isTerminalLifecycleState(string(instance.LifecycleState))
+		},
 		OnCreateError: func(err error) {
 			vcn.Status.OsokStatus = util.UpdateOSOKStatusCondition(vcn.Status.OsokStatus,
 				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
@@ -77,13 +114,46 @@ func (c *OciVcnServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.O
 		GetStatusMsg:   "Error while getting existing OciVcn from status OCID",
 		GetByOCIDMsg:   "Error while getting OciVcn by OCID",
 		UpdateMsg:      "Error while updating OciVcn",
-	})
+	}, quiet))
+	if errors.Is(err, errQuietWindow) {
+		return deferredCreateResponse(c.Recorder, vcn, &vcn.Status.OsokStatus, "OciVcn", vcn.Spec.DisplayName, quietUntil, c.Log), nil
+	}
 	if err != nil {
+		if response, limited := reconcileLimitExceeded(c.Recorder, vcn, &vcn.Status.OsokStatus, "OciVcn",
+			vcn.Spec.DisplayName, err, c.Log); limited {
+			return response, err
+		}
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
+	vcn.Status.CreateRetryToken = ""
+
+	if isTerminalLifecycleState(string(vcnInstance.LifecycleState)) {
+		return reconcileTerminalLifecycleStatus(c.Recorder, vcn, &vcn.Status.OsokStatus, "OciVcn",
+			safeString(vcnInstance.DisplayName), string(vcnInstance.LifecycleState), ociv1beta1.OCID(*vcnInstance.Id), c.Log), nil
+	}
+
+	setVcnDefaultResourceIDs(vcn, vcnInstance)
 
-	return reconcileLifecycleStatus(&vcn.Status.OsokStatus, "OciVcn", safeString(vcnInstance.DisplayName),
-		string(vcnInstance.LifecycleState), ociv1beta1.OCID(*vcnInstance.Id), c.Log), nil
+	response := reconcileLifecycleStatus(&vcn.Status.OsokStatus, "OciVcn", safeString(vcnInstance.DisplayName),
+		string(vcnInstance.LifecycleState), ociv1beta1.OCID(*vcnInstance.Id), c.Log)
+	if quiet {
+		response = requeueAfterQuietWindow(response, quietUntil)
+	}
+	return response, nil
+}
+
+// setVcnDefaultResourceIDs records the OCIDs of the route table, security list, and DHCP options
+// that OCI creates alongside the VCN so other resources can reference them.
+func setVcnDefaultResourceIDs(vcn *ociv1beta1.OciVcn, instance *ocicore.Vcn) {
+	if instance.DefaultRouteTableId != nil {
+		vcn.Status.DefaultRouteTableId = ociv1beta1.OCID(*instance.DefaultRouteTableId)
+	}
+	if instance.DefaultSecurityListId != nil {
+		vcn.Status.DefaultSecurityListId = ociv1beta1.OCID(*instance.DefaultSecurityListId)
+	}
+	if instance.DefaultDhcpOptionsId != nil {
+		vcn.Status.DefaultDhcpOptionsId = ociv1beta1.OCID(*instance.DefaultDhcpOptionsId)
+	}
 }
 
 // Delete handles deletion of the VCN (called by the finalizer).
@@ -93,6 +163,13 @@ func (c *OciVcnServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 		return false, err
 	}
 
+	ctx, err = withAuthSecretProvider(ctx, c.CredentialClient, vcn.Namespace, vcn.Spec.AuthSecretRef)
+	if err != nil {
+		c.Log.ErrorLog(err, "Resolving auth secret failed")
+		return false, err
+	}
+	ctx = servicemanager.WithRequestRegion(ctx, c.Provider, vcn.Spec.Region)
+
 	resourceID := vcn.Status.OsokStatus.Ocid
 	if resourceID == "" {
 		resourceID = vcn.Spec.VcnId
@@ -102,6 +179,21 @@ func (c *OciVcnServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 		return true, nil
 	}
 
+	if c.RetentionTag.Enabled() {
+		live, err := c.GetVcn(ctx, resourceID)
+		if err != nil && !isNotFoundServiceError(err) {
+			c.Log.ErrorLog(err, "Error while checking the OciVcn retention tag")
+			return false, err
+		}
+		if err == nil && blockDeleteForRetention(c.Recorder, vcn, c.Log, "OciVcn", resourceID, c.RetentionTag, live.DefinedTags) {
+			return false, nil
+		}
+	}
+
+	if deferDeleteForQuietWindow(c.Recorder, vcn, c.Log, "OciVcn", resourceID, c.QuietWindow) {
+		return false, nil
+	}
+
 	c.Log.InfoLog(fmt.Sprintf("Deleting OciVcn %s", resourceID))
 	done, err := deleteResourceAndWait(
 		func() error { return c.DeleteVcn(ctx, resourceID) },
@@ -110,6 +202,10 @@ func (c *OciVcnServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 			return getErr
 		},
 	)
+	// A delete that keeps failing, such as a 409 while dependents remain, is subject to the timeout too.
+	if !done && releaseAfterDeleteTimeout(c.Recorder, vcn, c.Log, "OciVcn", resourceID, c.DeleteTimeout) {
+		return true, nil
+	}
 	if err != nil {
 		c.Log.ErrorLog(err, "Error while deleting OciVcn")
 		return false, err
@@ -118,6 +214,11 @@ func (c *OciVcnServiceManager) Delete(ctx context.Context, obj runtime.Object) (
 	return done, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec; every input of a VCN is in its spec.
+func (c *OciVcnServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciVcnServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convertVcn(obj)
@@ -202,6 +202,8 @@ func TestPropertyDeleteUsesTableWorkRequestProgress(t *testing.T) {
 		done, err := mgr.Delete(context.Background(), db)
 		assert.NoError(t, err)
 		assert.False(t, done)
+		assert.Equal(t, "ocid1.nosqlworkrequest.oc1..progress", db.Status.OsokStatus.WorkRequestId)
+		assert.Equal(t, "IN_PROGRESS", db.Status.OsokStatus.WorkRequestState)
 	})
 
 	t.Run("succeeded work request completes delete", func(t *testing.T) {
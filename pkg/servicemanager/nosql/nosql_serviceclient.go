@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/nosql"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -28,7 +29,12 @@ type NosqlClientInterface interface {
 }
 
 func getNosqlClient(provider common.ConfigurationProvider) (nosql.NosqlClient, error) {
-	return nosql.NewNosqlClientWithConfigurationProvider(provider)
+	client, err := nosql.NewNosqlClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -290,6 +296,19 @@ func (c *NoSQLDatabaseServiceManager) getTableWorkRequest(ctx context.Context, w
 	return &resp.WorkRequest, nil
 }
 
+// tableWorkRequests adapts the NoSQL work request API to core.WorkRequestClient.
+type tableWorkRequests struct {
+	manager *NoSQLDatabaseServiceManager
+}
+
+func (w tableWorkRequests) GetWorkRequestStatus(ctx context.Context, workRequestID string) (string, error) {
+	workRequest, err := w.manager.getTableWorkRequest(ctx, workRequestID)
+	if err != nil {
+		return "", err
+	}
+	return string(workRequest.Status), nil
+}
+
 func buildUpdateTableDetails(db *ociv1beta1.NoSQLDatabase, existingTable *nosql.Table) (nosql.UpdateTableDetails, bool) {
 	updateDetails := nosql.UpdateTableDetails{}
 	updateNeeded := false
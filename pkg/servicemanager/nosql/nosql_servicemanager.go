@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/nosql"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/core"
 	"github.com/oracle/oci-service-operator/pkg/credhelper"
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
@@ -152,7 +153,7 @@ func (c *NoSQLDatabaseServiceManager) handleExistingDeleteTableWorkRequest(
 		return false, false, nil
 	}
 
-	completed, inProgress, err := c.handleDeleteTableWorkRequest(ctx, *workRequestID)
+	completed, inProgress, err := c.handleDeleteTableWorkRequest(ctx, &db.Status.OsokStatus, *workRequestID)
 	if err != nil {
 		return false, true, err
 	}
@@ -174,22 +175,20 @@ func resolveDeleteTableCompartmentID(db *ociv1beta1.NoSQLDatabase, currentTable
 	return ""
 }
 
-func (c *NoSQLDatabaseServiceManager) handleDeleteTableWorkRequest(ctx context.Context, workRequestID string) (bool, bool, error) {
-	workRequest, err := c.getTableWorkRequest(ctx, workRequestID)
+func (c *NoSQLDatabaseServiceManager) handleDeleteTableWorkRequest(ctx context.Context, status *ociv1beta1.OSOKStatus, workRequestID string) (bool, bool, error) {
+	decision, err := core.PollWorkRequest(ctx, tableWorkRequests{c}, status, workRequestID)
 	if err != nil {
+		if decision == servicemanager.LifecycleFailed {
+			return false, false, fmt.Errorf("NoSQL delete %w", err)
+		}
 		return false, false, err
 	}
 
-	switch workRequest.Status {
-	case nosql.WorkRequestStatusAccepted,
-		nosql.WorkRequestStatusInProgress,
-		nosql.WorkRequestStatusCanceling:
+	switch decision {
+	case servicemanager.LifecycleInProgress:
 		return false, true, nil
-	case nosql.WorkRequestStatusSucceeded:
+	case servicemanager.LifecycleReady:
 		return true, false, nil
-	case nosql.WorkRequestStatusFailed,
-		nosql.WorkRequestStatusCanceled:
-		return false, false, fmt.Errorf("NoSQL delete work request %s ended with status %s", workRequestID, workRequest.Status)
 	default:
 		return false, false, nil
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ociobjectstorage "github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 )
 
 // ObjectStorageClientInterface defines the OCI operations used by ObjectStorageBucketServiceManager.
@@ -22,7 +23,12 @@ type ObjectStorageClientInterface interface {
 }
 
 func getObjectStorageClient(provider common.ConfigurationProvider) (ociobjectstorage.ObjectStorageClient, error) {
-	return ociobjectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
+	client, err := ociobjectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
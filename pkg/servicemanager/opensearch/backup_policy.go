@@ -0,0 +1,99 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/opensearch"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// defaultBackupFrequency is the time between two backups when the policy does not set one.
+const defaultBackupFrequency = 24 * time.Hour
+
+// backupNameInfix sits between the cluster's display name and the timestamp in the names of backups
+// the operator takes. Retention only deletes backups named this way.
+const backupNameInfix = "-osok-backup-"
+
+// applyBackupPolicy starts a backup of the ACTIVE cluster when one is due under its backup policy and
+// deletes the backups the operator took that are past their retention. While the policy is enabled the
+// response requeues the cluster for when the next backup is due.
+func (c *OpenSearchClusterServiceManager) applyBackupPolicy(ctx context.Context, kind string, req ctrl.Request,
+	clusterObj *ociv1beta1.OpenSearchCluster, clusterInstance *opensearch.OpensearchCluster) (servicemanager.OSOKResponse, error) {
+	policy := clusterObj.Spec.BackupPolicy
+	if policy == nil || !policy.IsEnabled {
+		return servicemanager.OSOKResponse{IsSuccessful: true}, nil
+	}
+
+	frequency := defaultBackupFrequency
+	if policy.FrequencyInHours > 0 {
+		frequency = time.Duration(policy.FrequencyInHours) * time.Hour
+	}
+
+	if last := clusterObj.Status.LastBackupAt; last == nil || time.Since(last.Time) >= frequency {
+		now := metav1.Now()
+		name := safeString(clusterInstance.DisplayName) + backupNameInfix + now.UTC().Format("20060102-150405")
+		if err := c.BackupOpenSearchCluster(ctx, clusterInstance, name); err != nil {
+			return c.backupPolicyFailed(ctx, kind, req, clusterObj, "Error while backing up OpenSearch cluster", err)
+		}
+		clusterObj.Status.LastBackupAt = &now
+		c.Log.InfoLog(fmt.Sprintf("OpenSearch cluster %s backup %s started", safeString(clusterInstance.DisplayName), name))
+	}
+
+	if policy.RetentionInDays > 0 {
+		retention := time.Duration(policy.RetentionInDays) * 24 * time.Hour
+		if err := c.deleteExpiredBackups(ctx, clusterInstance, retention); err != nil {
+			return c.backupPolicyFailed(ctx, kind, req, clusterObj, "Error while deleting expired OpenSearch cluster backups", err)
+		}
+	}
+
+	return servicemanager.OSOKResponse{
+		IsSuccessful:    true,
+		ShouldRequeue:   true,
+		RequeueDuration: frequency - time.Since(clusterObj.Status.LastBackupAt.Time),
+	}, nil
+}
+
+// deleteExpiredBackups deletes the backups of the cluster the operator took more than retention ago.
+func (c *OpenSearchClusterServiceManager) deleteExpiredBackups(ctx context.Context, clusterInstance *opensearch.OpensearchCluster,
+	retention time.Duration) error {
+	backups, err := c.ListOpenSearchClusterBackups(ctx, clusterInstance)
+	if err != nil {
+		return err
+	}
+
+	prefix := safeString(clusterInstance.DisplayName) + backupNameInfix
+	for _, backup := range backups {
+		if backup.BackupType != opensearch.OpensearchClusterBackupBackupTypeManual ||
+			!strings.HasPrefix(safeString(backup.DisplayName), prefix) ||
+			backup.TimeCreated == nil || time.Since(backup.TimeCreated.Time) < retention {
+			continue
+		}
+		if err := c.DeleteOpenSearchClusterBackup(ctx, safeString(backup.Id)); err != nil && !isNotFoundServiceError(err) {
+			return err
+		}
+		c.Log.InfoLog(fmt.Sprintf("Deleted expired OpenSearch cluster backup %s", safeString(backup.DisplayName)))
+	}
+	return nil
+}
+
+func (c *OpenSearchClusterServiceManager) backupPolicyFailed(ctx context.Context, kind string, req ctrl.Request,
+	clusterObj *ociv1beta1.OpenSearchCluster, message string, err error) (servicemanager.OSOKResponse, error) {
+	clusterObj.Status.OsokStatus = util.UpdateOSOKStatusCondition(clusterObj.Status.OsokStatus,
+		ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+	c.Log.ErrorLog(err, message)
+	c.recordFaultMetric(ctx, kind, req, message)
+	return servicemanager.OSOKResponse{IsSuccessful: false}, err
+}
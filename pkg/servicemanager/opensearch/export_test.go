@@ -9,3 +9,8 @@ package opensearch
 func SetClientForTest(mgr *OpenSearchClusterServiceManager, client OpensearchClusterClientInterface) {
 	mgr.ociClient = client
 }
+
+// SetBackupClientForTest injects a fake OCI backup client into the service manager for unit testing.
+func SetBackupClientForTest(mgr *OpenSearchClusterServiceManager, client OpensearchClusterBackupClientInterface) {
+	mgr.backupClient = client
+}
@@ -0,0 +1,94 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package opensearch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	masterUserNameKey         = "username"
+	masterUserPasswordHashKey = "passwordHash"
+)
+
+// masterUserCredentials is the OpenSearch master user read from Spec.MasterUserSecretRef.
+type masterUserCredentials struct {
+	userName     string
+	passwordHash string
+}
+
+// hash returns a SHA-256 of the credentials, recorded in status so a changed secret can be detected
+// without storing the password hash itself.
+func (m masterUserCredentials) hash() string {
+	sum := sha256.Sum256([]byte(m.userName + "\x00" + m.passwordHash))
+	return hex.EncodeToString(sum[:])
+}
+
+// readMasterUserCredentials reads the master user from Spec.MasterUserSecretRef. It returns nil when no
+// secret is referenced.
+func (c *OpenSearchClusterServiceManager) readMasterUserCredentials(ctx context.Context,
+	cluster *ociv1beta1.OpenSearchCluster) (*masterUserCredentials, error) {
+	secretName := cluster.Spec.MasterUserSecretRef.SecretName
+	if secretName == "" {
+		return nil, nil
+	}
+
+	c.Log.DebugLog("Getting OpenSearch master user from Secret")
+	data, err := c.CredentialClient.GetSecret(ctx, secretName, cluster.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("reading master user secret %s/%s: %w", cluster.Namespace, secretName, err)
+	}
+	userName, ok := data[masterUserNameKey]
+	if !ok {
+		return nil, fmt.Errorf("%s key in master user secret %s/%s is not found", masterUserNameKey, cluster.Namespace, secretName)
+	}
+	passwordHash, ok := data[masterUserPasswordHashKey]
+	if !ok {
+		return nil, fmt.Errorf("%s key in master user secret %s/%s is not found", masterUserPasswordHashKey, cluster.Namespace, secretName)
+	}
+	return &masterUserCredentials{userName: string(userName), passwordHash: string(passwordHash)}, nil
+}
+
+// applyMasterUserSecret copies the master user from Spec.MasterUserSecretRef into the spec's security
+// fields for the duration of a reconcile, so the create and update paths send it like inline values. The
+// returned function restores the spec, which keeps the credentials out of the applied spec. The
+// credentials are nil when no secret is referenced.
+func (c *OpenSearchClusterServiceManager) applyMasterUserSecret(ctx context.Context,
+	cluster *ociv1beta1.OpenSearchCluster) (func(), *masterUserCredentials, error) {
+	credentials, err := c.readMasterUserCredentials(ctx, cluster)
+	if err != nil || credentials == nil {
+		return func() {}, nil, err
+	}
+
+	userName, passwordHash := cluster.Spec.SecurityMasterUserName, cluster.Spec.SecurityMasterUserPasswordHash
+	cluster.Spec.SecurityMasterUserName = credentials.userName
+	cluster.Spec.SecurityMasterUserPasswordHash = credentials.passwordHash
+	return func() {
+		cluster.Spec.SecurityMasterUserName = userName
+		cluster.Spec.SecurityMasterUserPasswordHash = passwordHash
+	}, credentials, nil
+}
+
+// HasPendingAction reports whether the master user secret has changed since it was last applied, so the
+// reconciler rotates the credentials even though the spec is unchanged. An unreadable secret also counts,
+// so the error is reported on the resource.
+func (c *OpenSearchClusterServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	cluster, err := c.convert(obj)
+	if err != nil || cluster.Spec.MasterUserSecretRef.SecretName == "" {
+		return false
+	}
+	credentials, err := c.readMasterUserCredentials(ctx, cluster)
+	if err != nil {
+		return true
+	}
+	return credentials.hash() != cluster.Status.MasterUserSecretHash
+}
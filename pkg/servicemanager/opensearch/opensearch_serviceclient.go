@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/opensearch"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -27,10 +28,16 @@ type OpensearchClusterClientInterface interface {
 	ResizeOpensearchClusterVertical(ctx context.Context, request opensearch.ResizeOpensearchClusterVerticalRequest) (opensearch.ResizeOpensearchClusterVerticalResponse, error)
 	UpdateOpensearchCluster(ctx context.Context, request opensearch.UpdateOpensearchClusterRequest) (opensearch.UpdateOpensearchClusterResponse, error)
 	DeleteOpensearchCluster(ctx context.Context, request opensearch.DeleteOpensearchClusterRequest) (opensearch.DeleteOpensearchClusterResponse, error)
+	BackupOpensearchCluster(ctx context.Context, request opensearch.BackupOpensearchClusterRequest) (opensearch.BackupOpensearchClusterResponse, error)
 }
 
 func getOpenSearchClusterClient(provider common.ConfigurationProvider) (OpensearchClusterClientInterface, error) {
-	return opensearch.NewOpensearchClusterClientWithConfigurationProvider(provider)
+	client, err := opensearch.NewOpensearchClusterClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -41,6 +48,26 @@ func (c *OpenSearchClusterServiceManager) getOCIClient() (OpensearchClusterClien
 	return getOpenSearchClusterClient(c.Provider)
 }
 
+// OpensearchClusterBackupClientInterface defines the OCI backup operations used to apply a backup
+// policy's retention. It is satisfied by opensearch.OpensearchClusterBackupClient.
+type OpensearchClusterBackupClientInterface interface {
+	ListOpensearchClusterBackups(ctx context.Context, request opensearch.ListOpensearchClusterBackupsRequest) (opensearch.ListOpensearchClusterBackupsResponse, error)
+	DeleteOpensearchClusterBackup(ctx context.Context, request opensearch.DeleteOpensearchClusterBackupRequest) (opensearch.DeleteOpensearchClusterBackupResponse, error)
+}
+
+// getBackupClient returns the injected backup client if set, otherwise creates one from the provider.
+func (c *OpenSearchClusterServiceManager) getBackupClient() (OpensearchClusterBackupClientInterface, error) {
+	if c.backupClient != nil {
+		return c.backupClient, nil
+	}
+	client, err := opensearch.NewOpensearchClusterBackupClientWithConfigurationProvider(c.Provider)
+	if err != nil {
+		return nil, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
 func (c *OpenSearchClusterServiceManager) CreateOpenSearchCluster(ctx context.Context, cluster ociv1beta1.OpenSearchCluster) (opensearch.CreateOpensearchClusterResponse, error) {
 	client, err := c.getOCIClient()
 	if err != nil {
@@ -365,6 +392,9 @@ func validateUnsupportedOpenSearchChanges(cluster *ociv1beta1.OpenSearchCluster,
 	if err := validateOpenSearchDataNodeHostType(cluster, existing); err != nil {
 		return err
 	}
+	if err := validateOpenSearchDataNodeStorage(cluster, existing); err != nil {
+		return err
+	}
 	if err := validateOpenSearchMasterNodeHostShape(cluster, existing); err != nil {
 		return err
 	}
@@ -397,6 +427,13 @@ func validateOpenSearchDataNodeHostShape(cluster *ociv1beta1.OpenSearchCluster,
 	return nil
 }
 
+func validateOpenSearchDataNodeStorage(cluster *ociv1beta1.OpenSearchCluster, existing *opensearch.OpensearchCluster) error {
+	if cluster.Spec.DataNodeStorageGB > 0 && existing.DataNodeStorageGB != nil && cluster.Spec.DataNodeStorageGB < *existing.DataNodeStorageGB {
+		return fmt.Errorf("dataNodeStorageGB cannot be decreased from %d to %d", *existing.DataNodeStorageGB, cluster.Spec.DataNodeStorageGB)
+	}
+	return nil
+}
+
 func validateOpenSearchDataNodeHostType(cluster *ociv1beta1.OpenSearchCluster, existing *opensearch.OpensearchCluster) error {
 	if cluster.Spec.DataNodeHostType != "" && string(existing.DataNodeHostType) != cluster.Spec.DataNodeHostType {
 		return fmt.Errorf("dataNodeHostType cannot be updated in place")
@@ -458,6 +495,64 @@ func (c *OpenSearchClusterServiceManager) DeleteOpenSearchCluster(ctx context.Co
 	return err
 }
 
+// BackupOpenSearchCluster starts a backup of the cluster under the given display name.
+func (c *OpenSearchClusterServiceManager) BackupOpenSearchCluster(ctx context.Context, cluster *opensearch.OpensearchCluster,
+	displayName string) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.BackupOpensearchCluster(ctx, opensearch.BackupOpensearchClusterRequest{
+		OpensearchClusterId: cluster.Id,
+		BackupOpensearchClusterDetails: opensearch.BackupOpensearchClusterDetails{
+			CompartmentId: cluster.CompartmentId,
+			DisplayName:   common.String(displayName),
+		},
+	})
+	return err
+}
+
+// ListOpenSearchClusterBackups lists the ACTIVE backups of the cluster.
+func (c *OpenSearchClusterServiceManager) ListOpenSearchClusterBackups(ctx context.Context,
+	cluster *opensearch.OpensearchCluster) ([]opensearch.OpensearchClusterBackupSummary, error) {
+	client, err := c.getBackupClient()
+	if err != nil {
+		return nil, err
+	}
+
+	req := opensearch.ListOpensearchClusterBackupsRequest{
+		CompartmentId:             cluster.CompartmentId,
+		SourceOpensearchClusterId: cluster.Id,
+		LifecycleState:            opensearch.OpensearchClusterBackupLifecycleStateActive,
+	}
+	var backups []opensearch.OpensearchClusterBackupSummary
+	for {
+		resp, err := client.ListOpensearchClusterBackups(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, resp.Items...)
+		if resp.OpcNextPage == nil {
+			return backups, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+// DeleteOpenSearchClusterBackup deletes a cluster backup.
+func (c *OpenSearchClusterServiceManager) DeleteOpenSearchClusterBackup(ctx context.Context, backupID string) error {
+	client, err := c.getBackupClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteOpensearchClusterBackup(ctx, opensearch.DeleteOpensearchClusterBackupRequest{
+		OpensearchClusterBackupId: common.String(backupID),
+	})
+	return err
+}
+
 func (c *OpenSearchClusterServiceManager) GetOpenSearchClusterOCID(ctx context.Context, cluster ociv1beta1.OpenSearchCluster) (*ociv1beta1.OCID, error) {
 	client, err := c.getOCIClient()
 	if err != nil {
@@ -23,13 +23,17 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// Compile-time check that OpenSearchClusterServiceManager reports master user secret changes to the reconciler.
+var _ servicemanager.PendingActionReporter = &OpenSearchClusterServiceManager{}
+
 type OpenSearchClusterServiceManager struct {
 	Provider         common.ConfigurationProvider
 	CredentialClient credhelper.CredentialClient
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
 	Metrics          *metrics.Metrics
-	ociClient        OpensearchClusterClientInterface // injectable for testing; nil uses Provider
+	ociClient        OpensearchClusterClientInterface       // injectable for testing; nil uses Provider
+	backupClient     OpensearchClusterBackupClientInterface // injectable for testing; nil uses Provider
 }
 
 func NewOpenSearchClusterServiceManager(provider common.ConfigurationProvider, credClient credhelper.CredentialClient,
@@ -50,13 +54,32 @@ func (c *OpenSearchClusterServiceManager) CreateOrUpdate(ctx context.Context, ob
 		return servicemanager.OSOKResponse{IsSuccessful: false}, err
 	}
 
+	restoreMasterUser, masterUser, err := c.applyMasterUserSecret(ctx, clusterObj)
+	if err != nil {
+		clusterObj.Status.OsokStatus = util.UpdateOSOKStatusCondition(clusterObj.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Reading OpenSearch master user secret failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	defer restoreMasterUser()
+
 	kind := obj.GetObjectKind().GroupVersionKind().Kind
 	clusterInstance, response, done, err := c.prepareClusterForReconcile(ctx, clusterObj, kind, req)
 	if err != nil || done {
 		return response, err
 	}
 
-	return c.finishClusterReconcile(ctx, kind, req, clusterObj, clusterInstance), nil
+	response = c.finishClusterReconcile(ctx, kind, req, clusterObj, clusterInstance)
+	if response.IsSuccessful {
+		response, err = c.applyBackupPolicy(ctx, kind, req, clusterObj, clusterInstance)
+		if err != nil {
+			return response, err
+		}
+	}
+	if response.IsSuccessful && masterUser != nil {
+		clusterObj.Status.MasterUserSecretHash = masterUser.hash()
+	}
+	return response, nil
 }
 
 func isValidUpdate(clusterObj ociv1beta1.OpenSearchCluster, clusterInstance opensearch.OpensearchCluster) bool {
@@ -136,30 +159,56 @@ func (c *OpenSearchClusterServiceManager) fetchClusterForReconcile(ctx context.C
 	}
 
 	clusterObj.Status.OsokStatus.Ocid = clusterID
-	if err := c.updateClusterIfNeeded(ctx, clusterObj, clusterInstance, kind, req); err != nil {
+	resized, err := c.updateClusterIfNeeded(ctx, clusterObj, clusterInstance, kind, req)
+	if err != nil {
 		return nil, servicemanager.OSOKResponse{IsSuccessful: false}, true, err
 	}
+	if resized {
+		// A resize moves the cluster to UPDATING; requeue until it settles instead of reporting the stale ACTIVE read.
+		return nil, servicemanager.OSOKResponse{
+			IsSuccessful:    false,
+			ShouldRequeue:   true,
+			RequeueDuration: openSearchRequeueDuration,
+		}, true, nil
+	}
 
 	return clusterInstance, servicemanager.OSOKResponse{}, false, nil
 }
 
+func isResizeNeeded(clusterObj *ociv1beta1.OpenSearchCluster, clusterInstance *opensearch.OpensearchCluster) bool {
+	_, horizontalUpdateNeeded := buildHorizontalResizeDetails(clusterObj, clusterInstance)
+	_, verticalUpdateNeeded := buildVerticalResizeDetails(clusterObj, clusterInstance)
+	return horizontalUpdateNeeded || verticalUpdateNeeded
+}
+
+// updateClusterIfNeeded applies spec drift to an ACTIVE cluster and reports whether a resize was issued.
 func (c *OpenSearchClusterServiceManager) updateClusterIfNeeded(ctx context.Context, clusterObj *ociv1beta1.OpenSearchCluster,
-	clusterInstance *opensearch.OpensearchCluster, kind string, req ctrl.Request) error {
+	clusterInstance *opensearch.OpensearchCluster, kind string, req ctrl.Request) (bool, error) {
+	if clusterInstance.LifecycleState != opensearch.OpensearchClusterLifecycleStateActive {
+		return false, nil
+	}
 	if !isValidUpdate(*clusterObj, *clusterInstance) {
-		return nil
+		return false, nil
 	}
 
+	resized := isResizeNeeded(clusterObj, clusterInstance)
 	if err := c.UpdateOpenSearchCluster(ctx, clusterObj); err != nil {
+		clusterObj.Status.OsokStatus = util.UpdateOSOKStatusCondition(clusterObj.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
 		c.Log.ErrorLog(err, "Error while updating OpenSearch cluster")
 		c.recordFaultMetric(ctx, kind, req, "Error while updating OpenSearch cluster")
-		return err
+		return false, err
 	}
 
+	message := "OpenSearch cluster update success"
+	if resized {
+		message = "OpenSearch cluster resize in progress"
+	}
 	clusterObj.Status.OsokStatus = util.UpdateOSOKStatusCondition(clusterObj.Status.OsokStatus,
-		ociv1beta1.Updating, v1.ConditionTrue, "", "OpenSearch cluster update success", c.Log)
+		ociv1beta1.Updating, v1.ConditionTrue, "", message, c.Log)
 	c.Log.InfoLog(fmt.Sprintf("OpenSearch cluster %s updated successfully", safeString(clusterInstance.DisplayName)))
 
-	return nil
+	return resized, nil
 }
 
 func (c *OpenSearchClusterServiceManager) finishClusterReconcile(ctx context.Context, kind string, req ctrl.Request,
@@ -237,6 +286,12 @@ func (c *OpenSearchClusterServiceManager) Delete(ctx context.Context, obj runtim
 	return false, nil
 }
 
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec. HasPendingAction reports a
+// rotated master user secret.
+func (c *OpenSearchClusterServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 func (c *OpenSearchClusterServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convert(obj)
 	if err != nil {
@@ -9,7 +9,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ociopensearch "github.com/oracle/oci-go-sdk/v65/opensearch"
@@ -17,6 +19,7 @@ import (
 	"github.com/oracle/oci-service-operator/pkg/loggerutil"
 	. "github.com/oracle/oci-service-operator/pkg/servicemanager/opensearch"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -34,8 +37,10 @@ func (f *fakeServiceError) Error() string {
 	return fmt.Sprintf("%d %s: %s", f.statusCode, f.code, f.message)
 }
 
-// fakeCredentialClient implements credhelper.CredentialClient for testing.
-type fakeCredentialClient struct{}
+// fakeCredentialClient implements credhelper.CredentialClient for testing. GetSecret serves secrets by name.
+type fakeCredentialClient struct {
+	secrets map[string]map[string][]byte
+}
 
 func (f *fakeCredentialClient) CreateSecret(_ context.Context, _, _ string, _ map[string]string, _ map[string][]byte) (bool, error) {
 	return true, nil
@@ -43,8 +48,15 @@ func (f *fakeCredentialClient) CreateSecret(_ context.Context, _, _ string, _ ma
 func (f *fakeCredentialClient) DeleteSecret(_ context.Context, _, _ string) (bool, error) {
 	return true, nil
 }
-func (f *fakeCredentialClient) GetSecret(_ context.Context, _, _ string) (map[string][]byte, error) {
-	return nil, nil
+func (f *fakeCredentialClient) GetSecret(_ context.Context, name, _ string) (map[string][]byte, error) {
+	if f.secrets == nil {
+		return nil, nil
+	}
+	data, ok := f.secrets[name]
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", name)
+	}
+	return data, nil
 }
 func (f *fakeCredentialClient) UpdateSecret(_ context.Context, _, _ string, _ map[string]string, _ map[string][]byte) (bool, error) {
 	return true, nil
@@ -59,6 +71,7 @@ type fakeOciClient struct {
 	updateFn           func(ctx context.Context, req ociopensearch.UpdateOpensearchClusterRequest) (ociopensearch.UpdateOpensearchClusterResponse, error)
 	deleteFn           func(ctx context.Context, req ociopensearch.DeleteOpensearchClusterRequest) (ociopensearch.DeleteOpensearchClusterResponse, error)
 	listFn             func(ctx context.Context, req ociopensearch.ListOpensearchClustersRequest) (ociopensearch.ListOpensearchClustersResponse, error)
+	backupFn           func(ctx context.Context, req ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error)
 }
 
 func (f *fakeOciClient) CreateOpensearchCluster(ctx context.Context, req ociopensearch.CreateOpensearchClusterRequest) (ociopensearch.CreateOpensearchClusterResponse, error) {
@@ -110,6 +123,32 @@ func (f *fakeOciClient) ListOpensearchClusters(ctx context.Context, req ociopens
 	return ociopensearch.ListOpensearchClustersResponse{}, nil
 }
 
+func (f *fakeOciClient) BackupOpensearchCluster(ctx context.Context, req ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error) {
+	if f.backupFn != nil {
+		return f.backupFn(ctx, req)
+	}
+	return ociopensearch.BackupOpensearchClusterResponse{}, nil
+}
+
+type fakeBackupClient struct {
+	listBackupsFn  func(ctx context.Context, req ociopensearch.ListOpensearchClusterBackupsRequest) (ociopensearch.ListOpensearchClusterBackupsResponse, error)
+	deleteBackupFn func(ctx context.Context, req ociopensearch.DeleteOpensearchClusterBackupRequest) (ociopensearch.DeleteOpensearchClusterBackupResponse, error)
+}
+
+func (f *fakeBackupClient) ListOpensearchClusterBackups(ctx context.Context, req ociopensearch.ListOpensearchClusterBackupsRequest) (ociopensearch.ListOpensearchClusterBackupsResponse, error) {
+	if f.listBackupsFn != nil {
+		return f.listBackupsFn(ctx, req)
+	}
+	return ociopensearch.ListOpensearchClusterBackupsResponse{}, nil
+}
+
+func (f *fakeBackupClient) DeleteOpensearchClusterBackup(ctx context.Context, req ociopensearch.DeleteOpensearchClusterBackupRequest) (ociopensearch.DeleteOpensearchClusterBackupResponse, error) {
+	if f.deleteBackupFn != nil {
+		return f.deleteBackupFn(ctx, req)
+	}
+	return ociopensearch.DeleteOpensearchClusterBackupResponse{}, nil
+}
+
 // helpers
 
 func makeManager() *OpenSearchClusterServiceManager {
@@ -683,3 +722,374 @@ func TestCreateOrUpdate_NoUpdateNeeded(t *testing.T) {
 	assert.True(t, resp.IsSuccessful)
 	assert.Equal(t, ociv1beta1.OCID(clusterID), cluster.Status.OsokStatus.Ocid)
 }
+
+// ---- Resize tests ----
+
+// TestCreateOrUpdate_ScaleUpIssuesResizeAndRequeues verifies node-count and storage growth issue resizes and requeue.
+func TestCreateOrUpdate_ScaleUpIssuesResizeAndRequeues(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..scale"
+	existing := makeActiveCluster(clusterID, "my-cluster")
+	var horizontalReq ociopensearch.ResizeOpensearchClusterHorizontalRequest
+	var verticalReq ociopensearch.ResizeOpensearchClusterVerticalRequest
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: existing}, nil
+		},
+		resizeHorizontalFn: func(_ context.Context, req ociopensearch.ResizeOpensearchClusterHorizontalRequest) (ociopensearch.ResizeOpensearchClusterHorizontalResponse, error) {
+			horizontalReq = req
+			return ociopensearch.ResizeOpensearchClusterHorizontalResponse{}, nil
+		},
+		resizeVerticalFn: func(_ context.Context, req ociopensearch.ResizeOpensearchClusterVerticalRequest) (ociopensearch.ResizeOpensearchClusterVerticalResponse, error) {
+			verticalReq = req
+			return ociopensearch.ResizeOpensearchClusterVerticalResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Spec.OpenSearchClusterId = ociv1beta1.OCID(clusterID)
+	cluster.Spec.DisplayName = "my-cluster"
+	cluster.Spec.MasterNodeCount = 5
+	cluster.Spec.DataNodeCount = 6
+	cluster.Spec.DataNodeStorageGB = 100
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue)
+	assert.Equal(t, 5, *horizontalReq.MasterNodeCount)
+	assert.Equal(t, 6, *horizontalReq.DataNodeCount)
+	assert.Equal(t, 100, *verticalReq.DataNodeStorageGB)
+	conds := cluster.Status.OsokStatus.Conditions
+	assert.Equal(t, ociv1beta1.Updating, conds[len(conds)-1].Type)
+}
+
+// TestCreateOrUpdate_StorageShrinkRejected verifies a storage decrease fails without issuing any resize.
+func TestCreateOrUpdate_StorageShrinkRejected(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..shrink"
+	existing := makeActiveCluster(clusterID, "my-cluster")
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: existing}, nil
+		},
+		resizeHorizontalFn: func(_ context.Context, _ ociopensearch.ResizeOpensearchClusterHorizontalRequest) (ociopensearch.ResizeOpensearchClusterHorizontalResponse, error) {
+			t.Fatal("horizontal resize must not be issued for an invalid storage change")
+			return ociopensearch.ResizeOpensearchClusterHorizontalResponse{}, nil
+		},
+		resizeVerticalFn: func(_ context.Context, _ ociopensearch.ResizeOpensearchClusterVerticalRequest) (ociopensearch.ResizeOpensearchClusterVerticalResponse, error) {
+			t.Fatal("vertical resize must not be issued for an invalid storage change")
+			return ociopensearch.ResizeOpensearchClusterVerticalResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Spec.OpenSearchClusterId = ociv1beta1.OCID(clusterID)
+	cluster.Spec.DisplayName = "my-cluster"
+	cluster.Spec.DataNodeCount = 6
+	cluster.Spec.DataNodeStorageGB = 20
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dataNodeStorageGB cannot be decreased")
+	assert.False(t, resp.IsSuccessful)
+	conds := cluster.Status.OsokStatus.Conditions
+	assert.Equal(t, ociv1beta1.Failed, conds[len(conds)-1].Type)
+}
+
+// TestCreateOrUpdate_UpdatingClusterDefersResize verifies no resize is issued while the cluster is UPDATING.
+func TestCreateOrUpdate_UpdatingClusterDefersResize(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..busy"
+	existing := makeActiveCluster(clusterID, "my-cluster")
+	existing.LifecycleState = ociopensearch.OpensearchClusterLifecycleStateUpdating
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: existing}, nil
+		},
+		resizeHorizontalFn: func(_ context.Context, _ ociopensearch.ResizeOpensearchClusterHorizontalRequest) (ociopensearch.ResizeOpensearchClusterHorizontalResponse, error) {
+			t.Fatal("resize must wait for the cluster to leave UPDATING")
+			return ociopensearch.ResizeOpensearchClusterHorizontalResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Spec.OpenSearchClusterId = ociv1beta1.OCID(clusterID)
+	cluster.Spec.DisplayName = "my-cluster"
+	cluster.Spec.DataNodeCount = 6
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue)
+}
+
+// ---- Master user secret tests ----
+
+func makeManagerWithSecrets(fake *fakeOciClient, credClient *fakeCredentialClient) *OpenSearchClusterServiceManager {
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	mgr := NewOpenSearchClusterServiceManager(common.NewRawConfigurationProvider("", "", "", "", "", nil),
+		credClient, nil, log, nil)
+	SetClientForTest(mgr, fake)
+	return mgr
+}
+
+func masterUserSecret(userName, passwordHash string) map[string][]byte {
+	return map[string][]byte{"username": []byte(userName), "passwordHash": []byte(passwordHash)}
+}
+
+// TestCreateOrUpdate_MasterUserSecretForwardedOnCreate verifies the master user is read from the
+// referenced secret and sent on create without being written into the spec.
+func TestCreateOrUpdate_MasterUserSecretForwardedOnCreate(t *testing.T) {
+	var captured ociopensearch.CreateOpensearchClusterDetails
+	fake := &fakeOciClient{
+		createFn: func(_ context.Context, req ociopensearch.CreateOpensearchClusterRequest) (ociopensearch.CreateOpensearchClusterResponse, error) {
+			captured = req.CreateOpensearchClusterDetails
+			return ociopensearch.CreateOpensearchClusterResponse{}, nil
+		},
+	}
+	credClient := &fakeCredentialClient{secrets: map[string]map[string][]byte{
+		"search-admin": masterUserSecret("admin", "pbkdf2_stretch_1000$hash-1"),
+	}}
+	mgr := makeManagerWithSecrets(fake, credClient)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Namespace = "default"
+	cluster.Spec.DisplayName = "secured-cluster"
+	cluster.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+	cluster.Spec.SecurityMode = "ENFORCING"
+	cluster.Spec.MasterUserSecretRef.SecretName = "search-admin"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.ShouldRequeue)
+	assert.Equal(t, "admin", *captured.SecurityMasterUserName)
+	assert.Equal(t, "pbkdf2_stretch_1000$hash-1", *captured.SecurityMasterUserPasswordHash)
+	assert.Empty(t, cluster.Spec.SecurityMasterUserName, "the spec must not keep the secret's values")
+	assert.Empty(t, cluster.Spec.SecurityMasterUserPasswordHash)
+}
+
+// TestCreateOrUpdate_MasterUserSecretRotation verifies a changed secret is reported as a pending action
+// and applied with an update, and that the applied credentials are then recorded.
+func TestCreateOrUpdate_MasterUserSecretRotation(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..secured"
+	existing := makeActiveCluster(clusterID, "secured-cluster")
+	existing.SecurityMode = ociopensearch.SecurityModeEnforcing
+	existing.SecurityMasterUserName = common.String("admin")
+	existing.SecurityMasterUserPasswordHash = common.String("pbkdf2_stretch_1000$hash-1")
+
+	var updates []ociopensearch.UpdateOpensearchClusterDetails
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: existing}, nil
+		},
+		updateFn: func(_ context.Context, req ociopensearch.UpdateOpensearchClusterRequest) (ociopensearch.UpdateOpensearchClusterResponse, error) {
+			updates = append(updates, req.UpdateOpensearchClusterDetails)
+			return ociopensearch.UpdateOpensearchClusterResponse{}, nil
+		},
+	}
+	credClient := &fakeCredentialClient{secrets: map[string]map[string][]byte{
+		"search-admin": masterUserSecret("admin", "pbkdf2_stretch_1000$hash-1"),
+	}}
+	mgr := makeManagerWithSecrets(fake, credClient)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Namespace = "default"
+	cluster.Spec.OpenSearchClusterId = ociv1beta1.OCID(clusterID)
+	cluster.Spec.MasterUserSecretRef.SecretName = "search-admin"
+
+	assert.True(t, mgr.HasPendingAction(context.Background(), cluster), "credentials not yet recorded")
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Empty(t, updates, "unchanged credentials must not be sent again")
+	assert.NotEmpty(t, cluster.Status.MasterUserSecretHash)
+	assert.False(t, mgr.HasPendingAction(context.Background(), cluster))
+
+	credClient.secrets["search-admin"] = masterUserSecret("admin", "pbkdf2_stretch_1000$hash-2")
+	assert.True(t, mgr.HasPendingAction(context.Background(), cluster))
+	previousHash := cluster.Status.MasterUserSecretHash
+
+	resp, err = mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "pbkdf2_stretch_1000$hash-2", *updates[0].SecurityMasterUserPasswordHash)
+		assert.Nil(t, updates[0].SecurityMasterUserName, "an unchanged user name is not resent")
+	}
+	assert.NotEqual(t, previousHash, cluster.Status.MasterUserSecretHash)
+	assert.False(t, mgr.HasPendingAction(context.Background(), cluster))
+	assert.Empty(t, cluster.Spec.SecurityMasterUserPasswordHash)
+}
+
+// TestCreateOrUpdate_MasterUserSecretMissingKey verifies a secret without the password hash fails the
+// resource before any OCI call.
+func TestCreateOrUpdate_MasterUserSecretMissingKey(t *testing.T) {
+	fake := &fakeOciClient{
+		listFn: func(_ context.Context, _ ociopensearch.ListOpensearchClustersRequest) (ociopensearch.ListOpensearchClustersResponse, error) {
+			t.Fatal("OCI must not be called when the master user secret is incomplete")
+			return ociopensearch.ListOpensearchClustersResponse{}, nil
+		},
+	}
+	credClient := &fakeCredentialClient{secrets: map[string]map[string][]byte{
+		"search-admin": {"username": []byte("admin")},
+	}}
+	mgr := makeManagerWithSecrets(fake, credClient)
+
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Namespace = "default"
+	cluster.Spec.DisplayName = "secured-cluster"
+	cluster.Spec.MasterUserSecretRef.SecretName = "search-admin"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "passwordHash")
+	assert.False(t, resp.IsSuccessful)
+	assert.Equal(t, ociv1beta1.Failed, cluster.Status.OsokStatus.Conditions[0].Type)
+	assert.True(t, mgr.HasPendingAction(context.Background(), cluster))
+}
+
+// ---- Backup policy tests ----
+
+func backupPolicyCluster(clusterID string, lastBackupAgo time.Duration) *ociv1beta1.OpenSearchCluster {
+	cluster := &ociv1beta1.OpenSearchCluster{}
+	cluster.Spec.OpenSearchClusterId = ociv1beta1.OCID(clusterID)
+	cluster.Spec.DisplayName = "backed-up"
+	cluster.Spec.BackupPolicy = &ociv1beta1.OpenSearchBackupPolicy{IsEnabled: true, FrequencyInHours: 6, RetentionInDays: 7}
+	if lastBackupAgo > 0 {
+		cluster.Status.LastBackupAt = &metav1.Time{Time: time.Now().Add(-lastBackupAgo)}
+	}
+	return cluster
+}
+
+// TestCreateOrUpdate_BackupPolicyStartsDueBackup verifies a backup is started when none was taken yet,
+// expired operator backups are deleted, and the cluster is requeued for the next backup.
+func TestCreateOrUpdate_BackupPolicyStartsDueBackup(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..backup"
+	var backupReq *ociopensearch.BackupOpensearchClusterRequest
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: makeActiveCluster(clusterID, "backed-up")}, nil
+		},
+		backupFn: func(_ context.Context, req ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error) {
+			backupReq = &req
+			return ociopensearch.BackupOpensearchClusterResponse{}, nil
+		},
+	}
+	old := common.SDKTime{Time: time.Now().Add(-8 * 24 * time.Hour)}
+	recent := common.SDKTime{Time: time.Now().Add(-time.Hour)}
+	var deleted []string
+	backups := &fakeBackupClient{
+		listBackupsFn: func(_ context.Context, req ociopensearch.ListOpensearchClusterBackupsRequest) (ociopensearch.ListOpensearchClusterBackupsResponse, error) {
+			assert.Equal(t, clusterID, *req.SourceOpensearchClusterId)
+			return ociopensearch.ListOpensearchClusterBackupsResponse{
+				OpensearchClusterBackupCollection: ociopensearch.OpensearchClusterBackupCollection{
+					Items: []ociopensearch.OpensearchClusterBackupSummary{
+						{Id: common.String("expired"), DisplayName: common.String("backed-up-osok-backup-1"),
+							BackupType: ociopensearch.OpensearchClusterBackupBackupTypeManual, TimeCreated: &old},
+						{Id: common.String("recent"), DisplayName: common.String("backed-up-osok-backup-2"),
+							BackupType: ociopensearch.OpensearchClusterBackupBackupTypeManual, TimeCreated: &recent},
+						{Id: common.String("scheduled"), DisplayName: common.String("backed-up-osok-backup-3"),
+							BackupType: ociopensearch.OpensearchClusterBackupBackupTypeScheduled, TimeCreated: &old},
+						{Id: common.String("by-hand"), DisplayName: common.String("before-upgrade"),
+							BackupType: ociopensearch.OpensearchClusterBackupBackupTypeManual, TimeCreated: &old},
+					},
+				},
+			}, nil
+		},
+		deleteBackupFn: func(_ context.Context, req ociopensearch.DeleteOpensearchClusterBackupRequest) (ociopensearch.DeleteOpensearchClusterBackupResponse, error) {
+			deleted = append(deleted, *req.OpensearchClusterBackupId)
+			return ociopensearch.DeleteOpensearchClusterBackupResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+	SetBackupClientForTest(mgr, backups)
+	cluster := backupPolicyCluster(clusterID, 0)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	if assert.NotNil(t, backupReq) {
+		assert.Equal(t, clusterID, *backupReq.OpensearchClusterId)
+		assert.True(t, strings.HasPrefix(*backupReq.DisplayName, "backed-up-osok-backup-"))
+	}
+	assert.NotNil(t, cluster.Status.LastBackupAt)
+	assert.Equal(t, []string{"expired"}, deleted)
+	assert.True(t, resp.ShouldRequeue)
+	assert.InDelta(t, float64(6*time.Hour), float64(resp.RequeueDuration), float64(time.Minute))
+}
+
+// TestCreateOrUpdate_BackupPolicyWaitsForFrequency verifies no backup is started before the frequency
+// has elapsed, and a frequency change takes effect on the next reconcile.
+func TestCreateOrUpdate_BackupPolicyWaitsForFrequency(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..recent"
+	backupCalls := 0
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: makeActiveCluster(clusterID, "backed-up")}, nil
+		},
+		backupFn: func(_ context.Context, _ ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error) {
+			backupCalls++
+			return ociopensearch.BackupOpensearchClusterResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+	SetBackupClientForTest(mgr, &fakeBackupClient{})
+	cluster := backupPolicyCluster(clusterID, 2*time.Hour)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, 0, backupCalls)
+	assert.InDelta(t, float64(4*time.Hour), float64(resp.RequeueDuration), float64(time.Minute))
+
+	cluster.Spec.BackupPolicy.FrequencyInHours = 1
+	_, err = mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, backupCalls)
+}
+
+// TestCreateOrUpdate_BackupPolicyDisabled verifies no backup is taken and no requeue is scheduled
+// when the policy is off.
+func TestCreateOrUpdate_BackupPolicyDisabled(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..nobackup"
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: makeActiveCluster(clusterID, "backed-up")}, nil
+		},
+		backupFn: func(_ context.Context, _ ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error) {
+			t.Fatal("no backup should be started")
+			return ociopensearch.BackupOpensearchClusterResponse{}, nil
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+	cluster := backupPolicyCluster(clusterID, 0)
+	cluster.Spec.BackupPolicy.IsEnabled = false
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.False(t, resp.ShouldRequeue)
+	assert.Nil(t, cluster.Status.LastBackupAt)
+}
+
+// TestCreateOrUpdate_BackupPolicyBackupFails verifies a failed backup fails the reconcile.
+func TestCreateOrUpdate_BackupPolicyBackupFails(t *testing.T) {
+	clusterID := "ocid1.opensearchcluster.oc1..backupfail"
+	fake := &fakeOciClient{
+		getFn: func(_ context.Context, _ ociopensearch.GetOpensearchClusterRequest) (ociopensearch.GetOpensearchClusterResponse, error) {
+			return ociopensearch.GetOpensearchClusterResponse{OpensearchCluster: makeActiveCluster(clusterID, "backed-up")}, nil
+		},
+		backupFn: func(_ context.Context, _ ociopensearch.BackupOpensearchClusterRequest) (ociopensearch.BackupOpensearchClusterResponse, error) {
+			return ociopensearch.BackupOpensearchClusterResponse{}, errors.New("backup refused")
+		},
+	}
+	mgr := makeManagerWithFake(fake)
+	cluster := backupPolicyCluster(clusterID, 0)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), cluster, ctrl.Request{})
+	assert.Error(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.Nil(t, cluster.Status.LastBackupAt)
+	assert.Equal(t, ociv1beta1.Failed, cluster.Status.OsokStatus.Conditions[len(cluster.Status.OsokStatus.Conditions)-1].Type)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/psql"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 )
 
@@ -28,7 +29,12 @@ type PostgresClientInterface interface {
 }
 
 func getPostgresClient(provider common.ConfigurationProvider) (psql.PostgresqlClient, error) {
-	return psql.NewPostgresqlClientWithConfigurationProvider(provider)
+	client, err := psql.NewPostgresqlClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
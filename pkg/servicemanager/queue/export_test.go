@@ -13,6 +13,15 @@ func GetCredentialMapForTest(q ociqueue.Queue) map[string][]byte {
 }
 
 // ExportSetClientForTest sets the OCI client on the service manager for unit testing.
+// A client that also serves GetStats is used as the stats client.
 func ExportSetClientForTest(m *OciQueueServiceManager, c QueueAdminClientInterface) {
 	m.ociClient = c
+	if stats, ok := c.(QueueStatsClientInterface); ok {
+		m.statsClient = stats
+	}
+}
+
+// ExportQueueSaturationForTest exports queueSaturation for unit testing.
+func ExportQueueSaturationForTest(sizeInBytes int64) int32 {
+	return queueSaturation(sizeInBytes)
 }
@@ -25,10 +25,17 @@ type QueueAdminClientInterface interface {
 	ChangeQueueCompartment(ctx context.Context, request ociqueue.ChangeQueueCompartmentRequest) (ociqueue.ChangeQueueCompartmentResponse, error)
 	UpdateQueue(ctx context.Context, request ociqueue.UpdateQueueRequest) (ociqueue.UpdateQueueResponse, error)
 	DeleteQueue(ctx context.Context, request ociqueue.DeleteQueueRequest) (ociqueue.DeleteQueueResponse, error)
+	PurgeQueue(ctx context.Context, request ociqueue.PurgeQueueRequest) (ociqueue.PurgeQueueResponse, error)
+	GetWorkRequest(ctx context.Context, request ociqueue.GetWorkRequestRequest) (ociqueue.GetWorkRequestResponse, error)
 }
 
 func getQueueAdminClient(provider common.ConfigurationProvider) (ociqueue.QueueAdminClient, error) {
-	return ociqueue.NewQueueAdminClientWithConfigurationProvider(provider)
+	client, err := ociqueue.NewQueueAdminClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
@@ -39,6 +46,30 @@ func (c *OciQueueServiceManager) getOCIClient() (QueueAdminClientInterface, erro
 	return getQueueAdminClient(c.Provider)
 }
 
+// QueueStatsClientInterface defines the OCI messages operation used to read queue statistics.
+type QueueStatsClientInterface interface {
+	GetStats(ctx context.Context, request ociqueue.GetStatsRequest) (ociqueue.GetStatsResponse, error)
+}
+
+// getQueueStatsClient returns a messages client for the queue's messages endpoint, where OCI serves its stats.
+func getQueueStatsClient(provider common.ConfigurationProvider, messagesEndpoint string) (ociqueue.QueueClient, error) {
+	client, err := ociqueue.NewQueueClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	client.Host = messagesEndpoint
+	return client, nil
+}
+
+// getStatsClient returns the injected stats client if set, otherwise creates one for the messages endpoint.
+func (c *OciQueueServiceManager) getStatsClient(messagesEndpoint string) (QueueStatsClientInterface, error) {
+	if c.statsClient != nil {
+		return c.statsClient, nil
+	}
+	return getQueueStatsClient(c.Provider, messagesEndpoint)
+}
+
 // CreateQueue calls the OCI API to create a new Queue and returns the work request ID.
 func (c *OciQueueServiceManager) CreateQueue(ctx context.Context, q ociv1beta1.OciQueue) (string, error) {
 	client, err := c.getOCIClient()
@@ -63,6 +94,9 @@ func (c *OciQueueServiceManager) CreateQueue(ctx context.Context, q ociv1beta1.O
 	if q.Spec.TimeoutInSeconds > 0 {
 		details.TimeoutInSeconds = common.Int(q.Spec.TimeoutInSeconds)
 	}
+	if q.Spec.ChannelConsumptionLimit > 0 {
+		details.ChannelConsumptionLimit = common.Int(q.Spec.ChannelConsumptionLimit)
+	}
 	if q.Spec.DeadLetterQueueDeliveryCount > 0 {
 		details.DeadLetterQueueDeliveryCount = common.Int(q.Spec.DeadLetterQueueDeliveryCount)
 	}
@@ -105,6 +139,46 @@ func (c *OciQueueServiceManager) GetQueue(ctx context.Context, queueId ociv1beta
 	return &resp.Queue, nil
 }
 
+// GetQueueStats retrieves the message statistics of a queue from its messages endpoint.
+func (c *OciQueueServiceManager) GetQueueStats(ctx context.Context, queueInstance *ociqueue.Queue) (*ociqueue.Stats, error) {
+	if safeString(queueInstance.MessagesEndpoint) == "" {
+		return nil, fmt.Errorf("OciQueue %s has no messages endpoint", safeString(queueInstance.Id))
+	}
+	client, err := c.getStatsClient(safeString(queueInstance.MessagesEndpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetStats(ctx, ociqueue.GetStatsRequest{QueueId: queueInstance.Id})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Queue == nil {
+		return nil, fmt.Errorf("GetStats returned no stats for OciQueue %s", safeString(queueInstance.Id))
+	}
+	return resp.Queue, nil
+}
+
+// queueWorkRequests adapts the Queue work request API to core.WorkRequestClient.
+type queueWorkRequests struct {
+	manager *OciQueueServiceManager
+}
+
+func (w queueWorkRequests) GetWorkRequestStatus(ctx context.Context, workRequestID string) (string, error) {
+	client, err := w.manager.getOCIClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.GetWorkRequest(ctx, ociqueue.GetWorkRequestRequest{
+		WorkRequestId: common.String(workRequestID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(resp.Status), nil
+}
+
 // GetQueueOcid looks up an existing Queue by display name and returns its OCID if found.
 // Returns nil if no matching queue in CREATING, UPDATING, or ACTIVE state is found.
 func (c *OciQueueServiceManager) GetQueueOcid(ctx context.Context, q ociv1beta1.OciQueue) (*ociv1beta1.OCID, error) {
@@ -210,6 +284,7 @@ func buildQueueUpdateRequest(targetID ociv1beta1.OCID, q *ociv1beta1.OciQueue,
 	updateNeeded := applyQueueDisplayNameUpdate(&updateDetails, q, existing)
 	updateNeeded = applyQueueVisibilityUpdate(&updateDetails, q, existing) || updateNeeded
 	updateNeeded = applyQueueTimeoutUpdate(&updateDetails, q, existing) || updateNeeded
+	updateNeeded = applyQueueChannelConsumptionLimitUpdate(&updateDetails, q, existing) || updateNeeded
 	updateNeeded = applyQueueDeadLetterCountUpdate(&updateDetails, q, existing) || updateNeeded
 	updateNeeded = applyQueueCustomEncryptionKeyUpdate(&updateDetails, q, existing) || updateNeeded
 	updateNeeded = applyQueueFreeformTagsUpdate(&updateDetails, q, existing) || updateNeeded
@@ -248,6 +323,16 @@ func applyQueueTimeoutUpdate(updateDetails *ociqueue.UpdateQueueDetails, q *ociv
 	return true
 }
 
+func applyQueueChannelConsumptionLimitUpdate(updateDetails *ociqueue.UpdateQueueDetails, q *ociv1beta1.OciQueue, existing *ociqueue.Queue) bool {
+	if q.Spec.ChannelConsumptionLimit <= 0 ||
+		(existing.ChannelConsumptionLimit != nil && *existing.ChannelConsumptionLimit == q.Spec.ChannelConsumptionLimit) {
+		return false
+	}
+
+	updateDetails.ChannelConsumptionLimit = common.Int(q.Spec.ChannelConsumptionLimit)
+	return true
+}
+
 func applyQueueDeadLetterCountUpdate(updateDetails *ociqueue.UpdateQueueDetails, q *ociv1beta1.OciQueue, existing *ociqueue.Queue) bool {
 	if q.Spec.DeadLetterQueueDeliveryCount <= 0 ||
 		(existing.DeadLetterQueueDeliveryCount != nil && *existing.DeadLetterQueueDeliveryCount == q.Spec.DeadLetterQueueDeliveryCount) {
@@ -308,3 +393,22 @@ func (c *OciQueueServiceManager) DeleteQueue(ctx context.Context, queueId ociv1b
 	_, err = client.DeleteQueue(ctx, req)
 	return err
 }
+
+// PurgeQueue deletes all messages in the Queue for the given OCID, across all of its channels. Messages in
+// the dead letter queue are kept.
+func (c *OciQueueServiceManager) PurgeQueue(ctx context.Context, queueId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+
+	req := ociqueue.PurgeQueueRequest{
+		QueueId: common.String(string(queueId)),
+		PurgeQueueDetails: ociqueue.PurgeQueueDetails{
+			PurgeType: ociqueue.PurgeQueueDetailsPurgeTypeNormal,
+		},
+	}
+
+	_, err = client.PurgeQueue(ctx, req)
+	return err
+}
@@ -22,6 +22,9 @@ import (
 // Compile-time check that OciQueueServiceManager implements OSOKServiceManager.
 var _ servicemanager.OSOKServiceManager = &OciQueueServiceManager{}
 
+// Compile-time check that OciQueueServiceManager reports requested purges to the reconciler.
+var _ servicemanager.PendingActionReporter = &OciQueueServiceManager{}
+
 // OciQueueServiceManager implements OSOKServiceManager for OCI Queue.
 type OciQueueServiceManager struct {
 	Provider         common.ConfigurationProvider
@@ -29,6 +32,7 @@ type OciQueueServiceManager struct {
 	Scheme           *runtime.Scheme
 	Log              loggerutil.OSOKLogger
 	ociClient        QueueAdminClientInterface
+	statsClient      QueueStatsClientInterface
 }
 
 // NewOciQueueServiceManager creates a new OciQueueServiceManager.
@@ -107,6 +111,22 @@ func (c *OciQueueServiceManager) Delete(ctx context.Context, obj runtime.Object)
 	return false, nil
 }
 
+// HasPendingAction reports whether the OciQueue requests a purge that has not been sent to OCI yet.
+func (c *OciQueueServiceManager) HasPendingAction(_ context.Context, obj runtime.Object) bool {
+	q, err := c.convert(obj)
+	if err != nil {
+		return false
+	}
+	purgeAt, err := requestedPurgeTime(q)
+	return err != nil || purgeAt != nil
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec. A requested purge is reported
+// by HasPendingAction.
+func (c *OciQueueServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
 // GetCrdStatus returns the OSOK status from the resource.
 func (c *OciQueueServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
 	resource, err := c.convert(obj)
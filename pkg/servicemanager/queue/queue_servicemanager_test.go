@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	ociqueue "github.com/oracle/oci-go-sdk/v65/queue"
@@ -20,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -92,6 +94,9 @@ type fakeQueueAdminClient struct {
 	changeQueueCompartmentFn func(ctx context.Context, req ociqueue.ChangeQueueCompartmentRequest) (ociqueue.ChangeQueueCompartmentResponse, error)
 	updateQueueFn            func(ctx context.Context, req ociqueue.UpdateQueueRequest) (ociqueue.UpdateQueueResponse, error)
 	deleteQueueFn            func(ctx context.Context, req ociqueue.DeleteQueueRequest) (ociqueue.DeleteQueueResponse, error)
+	purgeQueueFn             func(ctx context.Context, req ociqueue.PurgeQueueRequest) (ociqueue.PurgeQueueResponse, error)
+	getWorkRequestFn         func(ctx context.Context, req ociqueue.GetWorkRequestRequest) (ociqueue.GetWorkRequestResponse, error)
+	getStatsFn               func(ctx context.Context, req ociqueue.GetStatsRequest) (ociqueue.GetStatsResponse, error)
 }
 
 func (f *fakeQueueAdminClient) CreateQueue(ctx context.Context, req ociqueue.CreateQueueRequest) (ociqueue.CreateQueueResponse, error) {
@@ -136,6 +141,31 @@ func (f *fakeQueueAdminClient) DeleteQueue(ctx context.Context, req ociqueue.Del
 	return ociqueue.DeleteQueueResponse{}, nil
 }
 
+func (f *fakeQueueAdminClient) PurgeQueue(ctx context.Context, req ociqueue.PurgeQueueRequest) (ociqueue.PurgeQueueResponse, error) {
+	if f.purgeQueueFn != nil {
+		return f.purgeQueueFn(ctx, req)
+	}
+	return ociqueue.PurgeQueueResponse{}, nil
+}
+
+func (f *fakeQueueAdminClient) GetWorkRequest(ctx context.Context, req ociqueue.GetWorkRequestRequest) (ociqueue.GetWorkRequestResponse, error) {
+	if f.getWorkRequestFn != nil {
+		return f.getWorkRequestFn(ctx, req)
+	}
+	return ociqueue.GetWorkRequestResponse{WorkRequest: ociqueue.WorkRequest{Status: ociqueue.OperationStatusAccepted}}, nil
+}
+
+func (f *fakeQueueAdminClient) GetStats(ctx context.Context, req ociqueue.GetStatsRequest) (ociqueue.GetStatsResponse, error) {
+	if f.getStatsFn != nil {
+		return f.getStatsFn(ctx, req)
+	}
+	return ociqueue.GetStatsResponse{QueueStats: ociqueue.QueueStats{Queue: &ociqueue.Stats{
+		VisibleMessages:  common.Int64(0),
+		InFlightMessages: common.Int64(0),
+		SizeInBytes:      common.Int64(0),
+	}}}, nil
+}
+
 // ---------------------------------------------------------------------------
 // Helpers
 // ---------------------------------------------------------------------------
@@ -700,6 +730,30 @@ func TestCreateQueue_MinimalFields(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, capturedReq.RetentionInSeconds)
 	assert.Nil(t, capturedReq.DeadLetterQueueDeliveryCount)
+	assert.Nil(t, capturedReq.CustomEncryptionKeyId)
+}
+
+// TestCreateQueue_ForwardsCustomEncryptionKey verifies a customer-managed key is sent on create.
+func TestCreateQueue_ForwardsCustomEncryptionKey(t *testing.T) {
+	var capturedReq ociqueue.CreateQueueRequest
+
+	fake := &fakeQueueAdminClient{
+		createQueueFn: func(_ context.Context, req ociqueue.CreateQueueRequest) (ociqueue.CreateQueueResponse, error) {
+			capturedReq = req
+			return ociqueue.CreateQueueResponse{OpcWorkRequestId: common.String("wr-cmk-001")}, nil
+		},
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := ociv1beta1.OciQueue{}
+	q.Spec.DisplayName = "cmk-queue"
+	q.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+	q.Spec.CustomEncryptionKeyId = "ocid1.key.oc1..cmk"
+
+	_, err := mgr.CreateQueue(context.Background(), q)
+	assert.NoError(t, err)
+	assert.NotNil(t, capturedReq.CustomEncryptionKeyId)
+	assert.Equal(t, "ocid1.key.oc1..cmk", *capturedReq.CustomEncryptionKeyId)
 }
 
 // ---------------------------------------------------------------------------
@@ -732,6 +786,82 @@ func TestCreateOrUpdate_NoId_QueueNotFound_Provisioning(t *testing.T) {
 	assert.False(t, resp.IsSuccessful, "should be Provisioning (not successful) while creating")
 }
 
+// createWorkRequestFake lists no queue, counts creates, and reports the queued work request statuses.
+func createWorkRequestFake(creates *int, statuses ...ociqueue.OperationStatusEnum) *fakeQueueAdminClient {
+	return &fakeQueueAdminClient{
+		listQueuesFn: func(_ context.Context, _ ociqueue.ListQueuesRequest) (ociqueue.ListQueuesResponse, error) {
+			return ociqueue.ListQueuesResponse{}, nil
+		},
+		createQueueFn: func(_ context.Context, _ ociqueue.CreateQueueRequest) (ociqueue.CreateQueueResponse, error) {
+			*creates++
+			return ociqueue.CreateQueueResponse{OpcWorkRequestId: common.String(fmt.Sprintf("wr-%d", *creates))}, nil
+		},
+		getWorkRequestFn: func(_ context.Context, _ ociqueue.GetWorkRequestRequest) (ociqueue.GetWorkRequestResponse, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return ociqueue.GetWorkRequestResponse{WorkRequest: ociqueue.WorkRequest{Status: status}}, nil
+		},
+	}
+}
+
+// TestCreateOrUpdate_NoId_TracksCreateWorkRequest verifies that the create work request is recorded in
+// status and followed from ACCEPTED through IN_PROGRESS without submitting the create again.
+func TestCreateOrUpdate_NoId_TracksCreateWorkRequest(t *testing.T) {
+	creates := 0
+	fake := createWorkRequestFake(&creates,
+		ociqueue.OperationStatusAccepted, ociqueue.OperationStatusInProgress, ociqueue.OperationStatusSucceeded)
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := &ociv1beta1.OciQueue{}
+	q.Spec.DisplayName = "wr-queue"
+	q.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.ShouldRequeue)
+	assert.Equal(t, "wr-1", q.Status.OsokStatus.WorkRequestId)
+	assert.Equal(t, "ACCEPTED", q.Status.OsokStatus.WorkRequestState)
+
+	resp, err = mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.ShouldRequeue)
+	assert.Equal(t, "IN_PROGRESS", q.Status.OsokStatus.WorkRequestState)
+	assert.Equal(t, 1, creates, "a create still in flight is not submitted again")
+
+	_, _ = mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.Equal(t, "SUCCEEDED", q.Status.OsokStatus.WorkRequestState)
+}
+
+// TestCreateOrUpdate_NoId_FailedCreateWorkRequest verifies that a failed create work request is surfaced
+// as a Failed condition once, and the next reconcile submits a new create.
+func TestCreateOrUpdate_NoId_FailedCreateWorkRequest(t *testing.T) {
+	creates := 0
+	fake := createWorkRequestFake(&creates, ociqueue.OperationStatusAccepted, ociqueue.OperationStatusFailed)
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := &ociv1beta1.OciQueue{}
+	q.Spec.DisplayName = "wr-queue"
+	q.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+
+	_, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.Error(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.Equal(t, "FAILED", q.Status.OsokStatus.WorkRequestState)
+	last := q.Status.OsokStatus.Conditions[len(q.Status.OsokStatus.Conditions)-1]
+	assert.Equal(t, ociv1beta1.Failed, last.Type)
+	assert.Equal(t, 1, creates)
+
+	_, err = mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, creates, "the create is retried after a failed work request")
+	assert.Equal(t, "wr-2", q.Status.OsokStatus.WorkRequestId)
+}
+
 // TestCreateOrUpdate_NoId_QueueCreating verifies that a CREATING queue triggers
 // a Provisioning status and early return.
 func TestCreateOrUpdate_NoId_QueueCreating(t *testing.T) {
@@ -1002,3 +1132,238 @@ func TestCreateOrUpdate_SecretAlreadyExists(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, resp.IsSuccessful, "AlreadyExists on secret should be treated as success")
 }
+
+// purgeQueueFake returns a fake whose queue is ACTIVE and which counts PurgeQueue calls.
+func purgeQueueFake(queueID string, purges *int) *fakeQueueAdminClient {
+	return &fakeQueueAdminClient{
+		getQueueFn: func(_ context.Context, _ ociqueue.GetQueueRequest) (ociqueue.GetQueueResponse, error) {
+			return ociqueue.GetQueueResponse{Queue: makeActiveQueue(queueID, "purged-queue", "https://endpoint")}, nil
+		},
+		purgeQueueFn: func(_ context.Context, req ociqueue.PurgeQueueRequest) (ociqueue.PurgeQueueResponse, error) {
+			*purges++
+			if *req.QueueId != queueID || req.PurgeType != ociqueue.PurgeQueueDetailsPurgeTypeNormal {
+				return ociqueue.PurgeQueueResponse{}, fmt.Errorf("unexpected purge request %+v", req)
+			}
+			return ociqueue.PurgeQueueResponse{}, nil
+		},
+	}
+}
+
+func purgeQueueResource(queueID, purgeAt string) *ociv1beta1.OciQueue {
+	q := &ociv1beta1.OciQueue{}
+	q.Name = "purged-queue"
+	q.Namespace = "default"
+	q.Annotations = map[string]string{PurgeAnnotation: purgeAt}
+	q.Spec.DisplayName = "purged-queue"
+	q.Status.OsokStatus.Ocid = ociv1beta1.OCID(queueID)
+	return q
+}
+
+// TestCreateOrUpdate_PurgeAnnotation_PurgesOncePerTimestamp verifies a new purge timestamp purges the
+// queue once and a repeat reconcile with the same timestamp does not purge again.
+func TestCreateOrUpdate_PurgeAnnotation_PurgesOncePerTimestamp(t *testing.T) {
+	queueID := "ocid1.queue.oc1..purge"
+	purges := 0
+	mgr := mgrWithFake(&fakeCredentialClient{}, purgeQueueFake(queueID, &purges))
+	q := purgeQueueResource(queueID, "2026-10-17T10:00:00Z")
+
+	assert.True(t, mgr.HasPendingAction(context.Background(), q))
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, 1, purges)
+	if assert.NotNil(t, q.Status.LastPurgedAt) {
+		assert.Equal(t, "2026-10-17T10:00:00Z", q.Status.LastPurgedAt.UTC().Format(time.RFC3339))
+	}
+
+	assert.False(t, mgr.HasPendingAction(context.Background(), q))
+	_, err = mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purges, "the same timestamp must not purge again")
+
+	q.Annotations[PurgeAnnotation] = "2026-10-17T11:00:00Z"
+	assert.True(t, mgr.HasPendingAction(context.Background(), q))
+	_, err = mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purges, "a newer timestamp purges again")
+}
+
+// TestCreateOrUpdate_PurgeAnnotation_OlderTimestampSkipped verifies a timestamp no newer than the last
+// recorded purge is ignored.
+func TestCreateOrUpdate_PurgeAnnotation_OlderTimestampSkipped(t *testing.T) {
+	queueID := "ocid1.queue.oc1..purge"
+	purges := 0
+	mgr := mgrWithFake(&fakeCredentialClient{}, purgeQueueFake(queueID, &purges))
+	q := purgeQueueResource(queueID, "2026-10-17T09:00:00Z")
+	lastPurgedAt := metav1.NewTime(time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC))
+	q.Status.LastPurgedAt = &lastPurgedAt
+
+	assert.False(t, mgr.HasPendingAction(context.Background(), q))
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, 0, purges)
+}
+
+// TestCreateOrUpdate_PurgeAnnotation_InvalidTimestampFails verifies a malformed annotation is reported
+// rather than ignored, and no purge is sent.
+func TestCreateOrUpdate_PurgeAnnotation_InvalidTimestampFails(t *testing.T) {
+	queueID := "ocid1.queue.oc1..purge"
+	purges := 0
+	mgr := mgrWithFake(&fakeCredentialClient{}, purgeQueueFake(queueID, &purges))
+	q := purgeQueueResource(queueID, "yesterday")
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), PurgeAnnotation)
+	assert.False(t, resp.IsSuccessful)
+	assert.Equal(t, 0, purges)
+	assert.Nil(t, q.Status.LastPurgedAt)
+}
+
+// TestCreateOrUpdate_PurgeAnnotation_FailedPurgeRetried verifies the timestamp is only recorded after
+// OCI accepts the purge, so a failed purge is retried.
+func TestCreateOrUpdate_PurgeAnnotation_FailedPurgeRetried(t *testing.T) {
+	queueID := "ocid1.queue.oc1..purge"
+	purges := 0
+	fake := purgeQueueFake(queueID, &purges)
+	fake.purgeQueueFn = func(_ context.Context, _ ociqueue.PurgeQueueRequest) (ociqueue.PurgeQueueResponse, error) {
+		purges++
+		return ociqueue.PurgeQueueResponse{}, errors.New("purge failed")
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+	q := purgeQueueResource(queueID, "2026-10-17T10:00:00Z")
+
+	_, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.Error(t, err)
+	assert.Equal(t, 1, purges)
+	assert.Nil(t, q.Status.LastPurgedAt)
+	assert.True(t, mgr.HasPendingAction(context.Background(), q))
+}
+
+// TestCreateOrUpdate_RecordsQueueStats verifies that the live message stats and the storage saturation
+// are recorded in the status of an active queue.
+func TestCreateOrUpdate_RecordsQueueStats(t *testing.T) {
+	queueID := "ocid1.queue.oc1..stats"
+	var statsQueueID string
+	fake := &fakeQueueAdminClient{
+		getQueueFn: func(_ context.Context, _ ociqueue.GetQueueRequest) (ociqueue.GetQueueResponse, error) {
+			return ociqueue.GetQueueResponse{Queue: makeActiveQueue(queueID, "stats-queue",
+				"https://cell1.queue.messaging.us-ashburn-1.oci.oraclecloud.com")}, nil
+		},
+		getStatsFn: func(_ context.Context, req ociqueue.GetStatsRequest) (ociqueue.GetStatsResponse, error) {
+			statsQueueID = *req.QueueId
+			return ociqueue.GetStatsResponse{QueueStats: ociqueue.QueueStats{Queue: &ociqueue.Stats{
+				VisibleMessages:  common.Int64(1200),
+				InFlightMessages: common.Int64(35),
+				SizeInBytes:      common.Int64(1 << 30),
+			}}}, nil
+		},
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := &ociv1beta1.OciQueue{}
+	q.Name = "stats-queue"
+	q.Namespace = "default"
+	q.Spec.QueueId = ociv1beta1.OCID(queueID)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, queueID, statsQueueID)
+	assert.Equal(t, int64(1200), q.Status.VisibleMessages)
+	assert.Equal(t, int64(35), q.Status.InFlightMessages)
+	assert.Equal(t, int32(50), q.Status.Saturation)
+}
+
+// TestCreateOrUpdate_QueueStatsErrorIsAdvisory verifies that failing to read the stats neither fails the
+// reconcile nor clears the previously recorded values.
+func TestCreateOrUpdate_QueueStatsErrorIsAdvisory(t *testing.T) {
+	queueID := "ocid1.queue.oc1..stats"
+	fake := &fakeQueueAdminClient{
+		getQueueFn: func(_ context.Context, _ ociqueue.GetQueueRequest) (ociqueue.GetQueueResponse, error) {
+			return ociqueue.GetQueueResponse{Queue: makeActiveQueue(queueID, "stats-queue",
+				"https://cell1.queue.messaging.us-ashburn-1.oci.oraclecloud.com")}, nil
+		},
+		getStatsFn: func(_ context.Context, _ ociqueue.GetStatsRequest) (ociqueue.GetStatsResponse, error) {
+			return ociqueue.GetStatsResponse{}, errors.New("stats unavailable")
+		},
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := &ociv1beta1.OciQueue{}
+	q.Name = "stats-queue"
+	q.Namespace = "default"
+	q.Spec.QueueId = ociv1beta1.OCID(queueID)
+	q.Status.VisibleMessages = 7
+	q.Status.Saturation = 3
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), q, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, int64(7), q.Status.VisibleMessages)
+	assert.Equal(t, int32(3), q.Status.Saturation)
+}
+
+// TestQueueSaturation verifies the storage percentage rounds up and is capped at 100.
+func TestQueueSaturation(t *testing.T) {
+	assert.Equal(t, int32(0), ExportQueueSaturationForTest(0))
+	assert.Equal(t, int32(1), ExportQueueSaturationForTest(1))
+	assert.Equal(t, int32(50), ExportQueueSaturationForTest(1<<30))
+	assert.Equal(t, int32(100), ExportQueueSaturationForTest(2<<30))
+	assert.Equal(t, int32(100), ExportQueueSaturationForTest(3<<30))
+}
+
+func TestCreateQueue_ForwardsChannelConsumptionLimit(t *testing.T) {
+	var capturedReq ociqueue.CreateQueueRequest
+	fake := &fakeQueueAdminClient{
+		createQueueFn: func(_ context.Context, req ociqueue.CreateQueueRequest) (ociqueue.CreateQueueResponse, error) {
+			capturedReq = req
+			return ociqueue.CreateQueueResponse{OpcWorkRequestId: common.String("wr-channel-001")}, nil
+		},
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+
+	q := ociv1beta1.OciQueue{}
+	q.Spec.DisplayName = "channel-queue"
+	q.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+	q.Spec.ChannelConsumptionLimit = 25
+
+	_, err := mgr.CreateQueue(context.Background(), q)
+	assert.NoError(t, err)
+	assert.NotNil(t, capturedReq.ChannelConsumptionLimit)
+	assert.Equal(t, 25, *capturedReq.ChannelConsumptionLimit)
+}
+
+func TestUpdateQueue_SendsChannelConsumptionLimitUpdate(t *testing.T) {
+	queueID := "ocid1.queue.oc1..channel-update"
+	var updateReq ociqueue.UpdateQueueRequest
+	updateCalled := false
+	fake := &fakeQueueAdminClient{
+		getQueueFn: func(_ context.Context, _ ociqueue.GetQueueRequest) (ociqueue.GetQueueResponse, error) {
+			queue := makeActiveQueue(queueID, "queue", "")
+			queue.ChannelConsumptionLimit = common.Int(100)
+			return ociqueue.GetQueueResponse{Queue: queue}, nil
+		},
+		updateQueueFn: func(_ context.Context, req ociqueue.UpdateQueueRequest) (ociqueue.UpdateQueueResponse, error) {
+			updateCalled = true
+			updateReq = req
+			return ociqueue.UpdateQueueResponse{}, nil
+		},
+	}
+	mgr := mgrWithFake(&fakeCredentialClient{}, fake)
+	q := &ociv1beta1.OciQueue{}
+	q.Status.OsokStatus.Ocid = ociv1beta1.OCID(queueID)
+	q.Spec.DisplayName = "queue"
+	q.Spec.ChannelConsumptionLimit = 10
+
+	assert.NoError(t, mgr.UpdateQueue(context.Background(), q))
+	assert.True(t, updateCalled)
+	assert.NotNil(t, updateReq.ChannelConsumptionLimit)
+	assert.Equal(t, 10, *updateReq.ChannelConsumptionLimit)
+
+	updateCalled = false
+	q.Spec.ChannelConsumptionLimit = 100
+	assert.NoError(t, mgr.UpdateQueue(context.Background(), q))
+	assert.False(t, updateCalled, "a matching limit should not trigger an update")
+}
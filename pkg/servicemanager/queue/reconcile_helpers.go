@@ -13,6 +13,7 @@ import (
 
 	ociqueue "github.com/oracle/oci-go-sdk/v65/queue"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/core"
 	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +24,13 @@ import (
 
 const queueRequeueDuration = 30 * time.Second
 
+// queueStorageLimitBytes is the OCI storage limit of a single queue, which Status.Saturation is measured against.
+const queueStorageLimitBytes int64 = 2 << 30
+
+// PurgeAnnotation requests a one-time purge of the queue's messages. Its value is an RFC 3339 timestamp;
+// the queue is purged when the timestamp is newer than Status.LastPurgedAt.
+const PurgeAnnotation = "osok.oracle.com/purge"
+
 func (c *OciQueueServiceManager) resolveQueueForReconcile(ctx context.Context, q *ociv1beta1.OciQueue) (*ociqueue.Queue, *servicemanager.OSOKResponse, error) {
 	if strings.TrimSpace(string(q.Spec.QueueId)) != "" {
 		return c.bindQueueByID(ctx, q)
@@ -54,13 +62,23 @@ func (c *OciQueueServiceManager) createOrLookupQueue(ctx context.Context, q *oci
 		return nil, nil, err
 	}
 	if queueOcid == nil {
-		if _, err := c.CreateQueue(ctx, *q); err != nil {
+		if response, err := c.checkCreateWorkRequest(ctx, q); response != nil || err != nil {
+			return nil, response, err
+		}
+		workRequestID, err := c.CreateQueue(ctx, *q)
+		if err != nil {
 			q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
 				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
 			c.Log.ErrorLog(err, "Create OciQueue failed")
 			return nil, nil, err
 		}
-		c.Log.InfoLog(fmt.Sprintf("OciQueue %s creation submitted, waiting for provisioning", q.Spec.DisplayName))
+		c.Log.InfoLog(fmt.Sprintf("OciQueue %s creation submitted as work request %s, waiting for provisioning",
+			q.Spec.DisplayName, workRequestID))
+		q.Status.OsokStatus.WorkRequestId = workRequestID
+		q.Status.OsokStatus.WorkRequestState = ""
+		if _, err := core.PollWorkRequest(ctx, queueWorkRequests{c}, &q.Status.OsokStatus, workRequestID); err != nil {
+			c.Log.ErrorLog(err, "Error while reading the OciQueue create work request")
+		}
 		q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
 			ociv1beta1.Provisioning, v1.ConditionTrue, "", "OciQueue Provisioning", c.Log)
 		response := servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true, RequeueDuration: queueRequeueDuration}
@@ -86,6 +104,40 @@ func (c *OciQueueServiceManager) createOrLookupQueue(ctx context.Context, q *oci
 	return queueInstance, nil, nil
 }
 
+// checkCreateWorkRequest follows the create work request recorded in status while the queue is not yet
+// listed, so a create still in flight is not submitted twice and a failed one is surfaced once. It
+// returns nil when a new create may be submitted.
+func (c *OciQueueServiceManager) checkCreateWorkRequest(ctx context.Context, q *ociv1beta1.OciQueue) (*servicemanager.OSOKResponse, error) {
+	workRequestID := q.Status.OsokStatus.WorkRequestId
+	if workRequestID == "" {
+		return nil, nil
+	}
+	switch core.WorkRequestStates.Evaluate(q.Status.OsokStatus.WorkRequestState) {
+	case servicemanager.LifecycleReady, servicemanager.LifecycleFailed:
+		return nil, nil
+	}
+
+	decision, err := core.PollWorkRequest(ctx, queueWorkRequests{c}, &q.Status.OsokStatus, workRequestID)
+	switch {
+	case decision == servicemanager.LifecycleFailed:
+		q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Create OciQueue failed")
+		return nil, err
+	case err != nil:
+		c.Log.ErrorLog(err, "Error while reading the OciQueue create work request")
+		return nil, err
+	case decision == servicemanager.LifecycleInProgress:
+		c.Log.InfoLog(fmt.Sprintf("OciQueue %s create work request %s is %s", q.Spec.DisplayName,
+			workRequestID, q.Status.OsokStatus.WorkRequestState))
+		q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
+			ociv1beta1.Provisioning, v1.ConditionTrue, "", "OciQueue Provisioning", c.Log)
+		return &servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true, RequeueDuration: queueRequeueDuration}, nil
+	default:
+		return nil, nil
+	}
+}
+
 func (c *OciQueueServiceManager) bindQueueByID(ctx context.Context, q *ociv1beta1.OciQueue) (*ociqueue.Queue, *servicemanager.OSOKResponse, error) {
 	queueInstance, err := c.GetQueue(ctx, q.Spec.QueueId)
 	if err != nil {
@@ -121,6 +173,13 @@ func (c *OciQueueServiceManager) finalizeQueueReconcile(ctx context.Context, q *
 		q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
 			ociv1beta1.Active, v1.ConditionTrue, "",
 			fmt.Sprintf("OciQueue %s is %s", safeString(queueInstance.DisplayName), queueInstance.LifecycleState), c.Log)
+		if err := c.purgeQueueIfRequested(ctx, q, queueInstance); err != nil {
+			q.Status.OsokStatus = util.UpdateOSOKStatusCondition(q.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+			c.Log.ErrorLog(err, "Purge OciQueue failed")
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		c.recordQueueStats(ctx, q, queueInstance)
 		_, err := c.addToSecret(ctx, q.Namespace, q.Name, *queueInstance)
 		if err != nil {
 			if apierrors.IsAlreadyExists(err) {
@@ -138,3 +197,73 @@ func (c *OciQueueServiceManager) finalizeQueueReconcile(ctx context.Context, q *
 		return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true, RequeueDuration: queueRequeueDuration}, nil
 	}
 }
+
+// recordQueueStats copies the live message statistics into the status. The stats are advisory, so a
+// failure to read them is logged and leaves the previous values in place.
+func (c *OciQueueServiceManager) recordQueueStats(ctx context.Context, q *ociv1beta1.OciQueue, queueInstance *ociqueue.Queue) {
+	stats, err := c.GetQueueStats(ctx, queueInstance)
+	if err != nil {
+		c.Log.ErrorLog(err, "Reading OciQueue stats failed")
+		return
+	}
+	q.Status.VisibleMessages = int64Value(stats.VisibleMessages)
+	q.Status.InFlightMessages = int64Value(stats.InFlightMessages)
+	q.Status.Saturation = queueSaturation(int64Value(stats.SizeInBytes))
+}
+
+// queueSaturation returns the percentage of the queue storage limit used by sizeInBytes, rounded up so
+// that any stored message shows, and capped at 100.
+func queueSaturation(sizeInBytes int64) int32 {
+	if sizeInBytes <= 0 {
+		return 0
+	}
+	percent := (sizeInBytes*100 + queueStorageLimitBytes - 1) / queueStorageLimitBytes
+	if percent > 100 {
+		percent = 100
+	}
+	return int32(percent)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// purgeQueueIfRequested purges the queue once for each new PurgeAnnotation timestamp and records the
+// timestamp in Status.LastPurgedAt, so the same annotation never purges twice.
+func (c *OciQueueServiceManager) purgeQueueIfRequested(ctx context.Context, q *ociv1beta1.OciQueue, queueInstance *ociqueue.Queue) error {
+	purgeAt, err := requestedPurgeTime(q)
+	if err != nil || purgeAt == nil {
+		return err
+	}
+
+	c.Log.InfoLog(fmt.Sprintf("Purging OciQueue %s as requested at %s", safeString(queueInstance.DisplayName),
+		purgeAt.Format(time.RFC3339)))
+	if err := c.PurgeQueue(ctx, ociv1beta1.OCID(safeString(queueInstance.Id))); err != nil {
+		return err
+	}
+	q.Status.LastPurgedAt = purgeAt
+	return nil
+}
+
+// requestedPurgeTime returns the PurgeAnnotation timestamp when it is newer than Status.LastPurgedAt, or
+// nil when no purge is pending.
+func requestedPurgeTime(q *ociv1beta1.OciQueue) (*metav1.Time, error) {
+	value, ok := q.GetAnnotations()[PurgeAnnotation]
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation %q: expected an RFC 3339 timestamp", PurgeAnnotation, value)
+	}
+	// Status keeps whole seconds, so compare at that precision or a fractional timestamp would purge again.
+	parsed = parsed.Truncate(time.Second)
+	if q.Status.LastPurgedAt != nil && !parsed.After(q.Status.LastPurgedAt.Time) {
+		return nil, nil
+	}
+	purgeAt := metav1.NewTime(parsed)
+	return &purgeAt, nil
+}
@@ -0,0 +1,60 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+)
+
+type requestProviderKey struct{}
+
+// WithRequestProvider returns a context carrying a configuration provider that
+// overrides the service manager's default provider for the current request.
+func WithRequestProvider(ctx context.Context, provider common.ConfigurationProvider) context.Context {
+	if provider == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, requestProviderKey{}, provider)
+}
+
+// RequestProvider returns the request-scoped provider stored in ctx, or fallback
+// when none has been set.
+func RequestProvider(ctx context.Context, fallback common.ConfigurationProvider) common.ConfigurationProvider {
+	if ctx == nil {
+		return fallback
+	}
+	if provider, ok := ctx.Value(requestProviderKey{}).(common.ConfigurationProvider); ok {
+		return provider
+	}
+	return fallback
+}
+
+// regionProvider overrides the region reported by the wrapped provider, so clients built from it
+// call the service endpoints of that region with the wrapped provider's credentials.
+type regionProvider struct {
+	common.ConfigurationProvider
+	region string
+}
+
+func (p regionProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// WithRequestRegion returns a context whose request-scoped provider targets region. The provider
+// already scoped to ctx, or fallback when there is none, supplies the credentials. An empty region
+// leaves ctx unchanged so the provider's own region stays in effect.
+func WithRequestRegion(ctx context.Context, fallback common.ConfigurationProvider, region string) context.Context {
+	if region == "" {
+		return ctx
+	}
+	provider := RequestProvider(ctx, fallback)
+	if provider == nil {
+		return ctx
+	}
+	return WithRequestProvider(ctx, regionProvider{ConfigurationProvider: provider, region: region})
+}
@@ -0,0 +1,111 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package servicemanager_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocicore "github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateTestPEM generates a throwaway RSA private key in PEM format for unit tests.
+func generateTestPEM(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	block := &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}
+	return string(pem.EncodeToMemory(block))
+}
+
+func TestWithRequestRegion_OverridesRegion(t *testing.T) {
+	fallback := common.NewRawConfigurationProvider("tenancy", "user", "us-ashburn-1", "fp", "pk", nil)
+
+	ctx := servicemanager.WithRequestRegion(context.Background(), fallback, "eu-frankfurt-1")
+	provider := servicemanager.RequestProvider(ctx, fallback)
+
+	region, err := provider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-frankfurt-1", region)
+	tenancy, err := provider.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, "tenancy", tenancy)
+}
+
+func TestWithRequestRegion_EmptyRegionKeepsProvider(t *testing.T) {
+	fallback := common.NewRawConfigurationProvider("tenancy", "user", "us-ashburn-1", "fp", "pk", nil)
+
+	ctx := servicemanager.WithRequestRegion(context.Background(), fallback, "")
+	assert.Equal(t, fallback, servicemanager.RequestProvider(ctx, fallback))
+}
+
+func TestWithRequestRegion_WrapsRequestProvider(t *testing.T) {
+	fallback := common.NewRawConfigurationProvider("tenancy", "user", "us-ashburn-1", "fp", "pk", nil)
+	secretProvider := common.NewRawConfigurationProvider("other-tenancy", "user", "us-ashburn-1", "fp", "pk", nil)
+
+	ctx := servicemanager.WithRequestProvider(context.Background(), secretProvider)
+	ctx = servicemanager.WithRequestRegion(ctx, fallback, "ap-tokyo-1")
+	provider := servicemanager.RequestProvider(ctx, fallback)
+
+	region, _ := provider.Region()
+	assert.Equal(t, "ap-tokyo-1", region)
+	tenancy, _ := provider.TenancyOCID()
+	assert.Equal(t, "other-tenancy", tenancy)
+}
+
+func TestWithRequestRegion_ClientUsesRegionEndpoint(t *testing.T) {
+	fallback := common.NewRawConfigurationProvider("tenancy", "user", "us-ashburn-1", "fp", generateTestPEM(t), nil)
+
+	ctx := servicemanager.WithRequestRegion(context.Background(), fallback, "us-phoenix-1")
+	client, err := ocicore.NewVirtualNetworkClientWithConfigurationProvider(servicemanager.RequestProvider(ctx, fallback))
+	assert.NoError(t, err)
+	assert.Contains(t, client.Host, "us-phoenix-1")
+}
+
+func TestCorrelationIDInterceptor_SetsClientRequestID(t *testing.T) {
+	ctx := servicemanager.WithCorrelationID(context.Background(), "uid-1234-3")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, servicemanager.CorrelationIDInterceptor(request))
+	assert.Equal(t, "uid-1234-3", request.Header.Get("opc-client-request-id"))
+}
+
+func TestCorrelationIDInterceptor_NoCorrelationID(t *testing.T) {
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, servicemanager.CorrelationIDInterceptor(request))
+	assert.Empty(t, request.Header.Get("opc-client-request-id"))
+}
+
+func TestTraceRequests_InstallsCorrelationIDInterceptor(t *testing.T) {
+	var client common.BaseClient
+	servicemanager.TraceRequests(&client)
+	if !assert.NotNil(t, client.Interceptor) {
+		return
+	}
+
+	ctx := servicemanager.WithCorrelationID(context.Background(), "uid-1234-4")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.Interceptor(request))
+	assert.Equal(t, "uid-1234-4", request.Header.Get("opc-client-request-id"))
+}
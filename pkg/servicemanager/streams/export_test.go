@@ -41,3 +41,8 @@ func ExportGetStreamNextDuration(m *StreamServiceManager) func(common.OCIOperati
 func ExportDeleteStreamNextDuration(m *StreamServiceManager) func(common.OCIOperationResponse) time.Duration {
 	return m.deleteStreamRetryPolicy(1).NextDuration
 }
+
+// ExportSetStreamPoolClientForTest sets the OCI client on the stream pool service manager for unit testing.
+func ExportSetStreamPoolClientForTest(m *StreamPoolServiceManager, c StreamPoolClientInterface) {
+	m.ociClient = c
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/streaming"
 	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
 	"github.com/oracle/oci-service-operator/pkg/util"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,12 @@ type StreamAdminClientInterface interface {
 }
 
 func getStreamClient(provider common.ConfigurationProvider) (streaming.StreamAdminClient, error) {
-	return streaming.NewStreamAdminClientWithConfigurationProvider(provider)
+	client, err := streaming.NewStreamAdminClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
 }
 
 // getOCIClient returns the injected client if set, otherwise creates one from the provider.
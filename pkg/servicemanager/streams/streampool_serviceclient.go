@@ -0,0 +1,178 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package streams
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/streaming"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+// StreamPoolClientInterface defines the OCI operations used by StreamPoolServiceManager.
+type StreamPoolClientInterface interface {
+	CreateStreamPool(ctx context.Context, request streaming.CreateStreamPoolRequest) (streaming.CreateStreamPoolResponse, error)
+	GetStreamPool(ctx context.Context, request streaming.GetStreamPoolRequest) (streaming.GetStreamPoolResponse, error)
+	ListStreamPools(ctx context.Context, request streaming.ListStreamPoolsRequest) (streaming.ListStreamPoolsResponse, error)
+	UpdateStreamPool(ctx context.Context, request streaming.UpdateStreamPoolRequest) (streaming.UpdateStreamPoolResponse, error)
+	DeleteStreamPool(ctx context.Context, request streaming.DeleteStreamPoolRequest) (streaming.DeleteStreamPoolResponse, error)
+}
+
+// getOCIClient returns the injected client if set, otherwise creates one from the provider.
+func (c *StreamPoolServiceManager) getOCIClient() (StreamPoolClientInterface, error) {
+	if c.ociClient != nil {
+		return c.ociClient, nil
+	}
+	return getStreamClient(c.Provider)
+}
+
+// CreateStreamPool creates a stream pool from the CR spec.
+func (c *StreamPoolServiceManager) CreateStreamPool(ctx context.Context, pool ociv1beta1.OciStreamPool) (*streaming.StreamPool, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+	c.Log.DebugLog("Creating StreamPool ", "name", pool.Spec.Name)
+
+	details := streaming.CreateStreamPoolDetails{
+		CompartmentId: common.String(string(pool.Spec.CompartmentId)),
+		Name:          common.String(pool.Spec.Name),
+		FreeformTags:  pool.Spec.FreeFormTags,
+	}
+	if pool.Spec.DefinedTags != nil {
+		details.DefinedTags = *util.ConvertToOciDefinedTags(&pool.Spec.DefinedTags)
+	}
+	if pool.Spec.KmsKeyId != "" {
+		details.CustomEncryptionKeyDetails = &streaming.CustomEncryptionKeyDetails{
+			KmsKeyId: common.String(string(pool.Spec.KmsKeyId)),
+		}
+	}
+	if endpoint := pool.Spec.PrivateEndpoint; endpoint != nil {
+		privateEndpoint := &streaming.PrivateEndpointDetails{
+			SubnetId: common.String(string(endpoint.SubnetId)),
+		}
+		if endpoint.PrivateEndpointIp != "" {
+			privateEndpoint.PrivateEndpointIp = common.String(endpoint.PrivateEndpointIp)
+		}
+		for _, nsgID := range endpoint.NsgIds {
+			privateEndpoint.NsgIds = append(privateEndpoint.NsgIds, string(nsgID))
+		}
+		details.PrivateEndpointDetails = privateEndpoint
+	}
+
+	resp, err := client.CreateStreamPool(ctx, streaming.CreateStreamPoolRequest{CreateStreamPoolDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.StreamPool, nil
+}
+
+// GetStreamPool retrieves a stream pool by OCID.
+func (c *StreamPoolServiceManager) GetStreamPool(ctx context.Context, streamPoolID ociv1beta1.OCID) (*streaming.StreamPool, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetStreamPool(ctx, streaming.GetStreamPoolRequest{
+		StreamPoolId: common.String(string(streamPoolID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.StreamPool, nil
+}
+
+// GetStreamPoolOcid looks up a live stream pool by name in the spec compartment.
+func (c *StreamPoolServiceManager) GetStreamPoolOcid(ctx context.Context, pool ociv1beta1.OciStreamPool) (*ociv1beta1.OCID, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListStreamPools(ctx, streaming.ListStreamPoolsRequest{
+		CompartmentId: common.String(string(pool.Spec.CompartmentId)),
+		Name:          common.String(pool.Spec.Name),
+	})
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while listing StreamPools")
+		return nil, err
+	}
+	for _, item := range resp.Items {
+		switch item.LifecycleState {
+		case streaming.StreamPoolSummaryLifecycleStateActive,
+			streaming.StreamPoolSummaryLifecycleStateCreating,
+			streaming.StreamPoolSummaryLifecycleStateUpdating:
+			c.Log.DebugLog("StreamPool exists", "name", pool.Spec.Name)
+			id := ociv1beta1.OCID(*item.Id)
+			return &id, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateStreamPool applies name, encryption key and tag changes to an existing stream pool.
+func (c *StreamPoolServiceManager) UpdateStreamPool(ctx context.Context, pool *ociv1beta1.OciStreamPool, existing *streaming.StreamPool) error {
+	details, updateNeeded := buildStreamPoolUpdateDetails(pool, existing)
+	if !updateNeeded {
+		return nil
+	}
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.UpdateStreamPool(ctx, streaming.UpdateStreamPoolRequest{
+		StreamPoolId:            existing.Id,
+		UpdateStreamPoolDetails: details,
+	})
+	return err
+}
+
+// DeleteStreamPool deletes the stream pool with the given OCID.
+func (c *StreamPoolServiceManager) DeleteStreamPool(ctx context.Context, streamPoolID ociv1beta1.OCID) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteStreamPool(ctx, streaming.DeleteStreamPoolRequest{
+		StreamPoolId: common.String(string(streamPoolID)),
+	})
+	return err
+}
+
+func buildStreamPoolUpdateDetails(pool *ociv1beta1.OciStreamPool, existing *streaming.StreamPool) (streaming.UpdateStreamPoolDetails, bool) {
+	details := streaming.UpdateStreamPoolDetails{}
+	updateNeeded := false
+
+	if pool.Spec.Name != "" && safeStreamString(existing.Name) != pool.Spec.Name {
+		details.Name = common.String(pool.Spec.Name)
+		updateNeeded = true
+	}
+	if pool.Spec.KmsKeyId != "" {
+		var existingKey string
+		if existing.CustomEncryptionKey != nil {
+			existingKey = safeStreamString(existing.CustomEncryptionKey.KmsKeyId)
+		}
+		if existingKey != string(pool.Spec.KmsKeyId) {
+			details.CustomEncryptionKeyDetails = &streaming.CustomEncryptionKeyDetails{
+				KmsKeyId: common.String(string(pool.Spec.KmsKeyId)),
+			}
+			updateNeeded = true
+		}
+	}
+	if pool.Spec.FreeFormTags != nil && !reflect.DeepEqual(existing.FreeformTags, pool.Spec.FreeFormTags) {
+		details.FreeformTags = pool.Spec.FreeFormTags
+		updateNeeded = true
+	}
+	if pool.Spec.DefinedTags != nil {
+		if defTag := *util.ConvertToOciDefinedTags(&pool.Spec.DefinedTags); !reflect.DeepEqual(existing.DefinedTags, defTag) {
+			details.DefinedTags = defTag
+			updateNeeded = true
+		}
+	}
+	return details, updateNeeded
+}
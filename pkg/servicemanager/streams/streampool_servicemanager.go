@@ -0,0 +1,203 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package streams
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/streaming"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Compile-time check that StreamPoolServiceManager implements OSOKServiceManager.
+var _ servicemanager.OSOKServiceManager = &StreamPoolServiceManager{}
+
+// StreamPoolServiceManager implements OSOKServiceManager for OCI stream pools.
+type StreamPoolServiceManager struct {
+	Provider         common.ConfigurationProvider
+	CredentialClient credhelper.CredentialClient
+	Scheme           *runtime.Scheme
+	Log              loggerutil.OSOKLogger
+	Metrics          *metrics.Metrics
+	ociClient        StreamPoolClientInterface
+}
+
+// NewStreamPoolServiceManager creates a new StreamPoolServiceManager.
+func NewStreamPoolServiceManager(provider common.ConfigurationProvider, credClient credhelper.CredentialClient,
+	scheme *runtime.Scheme, log loggerutil.OSOKLogger, metrics *metrics.Metrics) *StreamPoolServiceManager {
+	return &StreamPoolServiceManager{
+		Provider:         provider,
+		CredentialClient: credClient,
+		Scheme:           scheme,
+		Log:              log,
+		Metrics:          metrics,
+	}
+}
+
+// CreateOrUpdate reconciles the OciStreamPool resource against OCI.
+func (c *StreamPoolServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	pool, err := c.convert(obj)
+	if err != nil {
+		c.Log.ErrorLog(err, "Conversion of object failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	poolInstance, err := c.resolveStreamPoolInstance(ctx, pool)
+	if err != nil {
+		c.Metrics.AddCRFaultMetrics(ctx, kind, "Error while reconciling StreamPool", req.Name, req.Namespace)
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	pool.Status.OsokStatus.Ocid = ociv1beta1.OCID(*poolInstance.Id)
+	servicemanager.SetCreatedAtIfUnset(&pool.Status.OsokStatus)
+
+	if poolInstance.LifecycleState == streaming.StreamPoolLifecycleStateActive {
+		if err := c.UpdateStreamPool(ctx, pool, poolInstance); err != nil {
+			c.Log.ErrorLog(err, "Error while updating StreamPool")
+			c.Metrics.AddCRFaultMetrics(ctx, kind, "Error while updating StreamPool", req.Name, req.Namespace)
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+	}
+
+	return c.reconcileStreamPoolLifecycle(ctx, pool, poolInstance, kind, req), nil
+}
+
+func (c *StreamPoolServiceManager) resolveStreamPoolInstance(ctx context.Context, pool *ociv1beta1.OciStreamPool) (*streaming.StreamPool, error) {
+	if strings.TrimSpace(string(pool.Spec.StreamPoolId)) != "" {
+		poolInstance, err := c.GetStreamPool(ctx, pool.Spec.StreamPoolId)
+		if err != nil {
+			c.Log.ErrorLog(err, "Error while getting StreamPool by OCID")
+			return nil, err
+		}
+		return poolInstance, nil
+	}
+	if strings.TrimSpace(string(pool.Status.OsokStatus.Ocid)) != "" {
+		poolInstance, err := c.GetStreamPool(ctx, pool.Status.OsokStatus.Ocid)
+		if err == nil {
+			return poolInstance, nil
+		}
+		if !isStreamNotFound(err) {
+			c.Log.ErrorLog(err, "Error while getting StreamPool from status OCID")
+			return nil, err
+		}
+		pool.Status.OsokStatus.Ocid = ""
+	}
+
+	if pool.Spec.Name == "" || pool.Spec.CompartmentId == "" {
+		return nil, errors.New("name and compartmentId are required to create a StreamPool")
+	}
+	poolOcid, err := c.GetStreamPoolOcid(ctx, *pool)
+	if err != nil {
+		return nil, err
+	}
+	if poolOcid != nil {
+		return c.GetStreamPool(ctx, *poolOcid)
+	}
+
+	poolInstance, err := c.CreateStreamPool(ctx, *pool)
+	if err != nil {
+		pool.Status.OsokStatus = util.UpdateOSOKStatusCondition(pool.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Create StreamPool failed")
+		return nil, err
+	}
+	c.Log.InfoLog(fmt.Sprintf("StreamPool %s is getting Provisioned", pool.Spec.Name))
+	pool.Status.OsokStatus = util.UpdateOSOKStatusCondition(pool.Status.OsokStatus,
+		ociv1beta1.Provisioning, v1.ConditionTrue, "", "StreamPool is getting Provisioned", c.Log)
+	return poolInstance, nil
+}
+
+func (c *StreamPoolServiceManager) reconcileStreamPoolLifecycle(ctx context.Context, pool *ociv1beta1.OciStreamPool,
+	poolInstance *streaming.StreamPool, kind string, req ctrl.Request) servicemanager.OSOKResponse {
+	displayName := safeStreamString(poolInstance.Name)
+	state := string(poolInstance.LifecycleState)
+
+	switch poolInstance.LifecycleState {
+	case streaming.StreamPoolLifecycleStateFailed, streaming.StreamPoolLifecycleStateDeleted:
+		pool.Status.OsokStatus = util.UpdateOSOKStatusCondition(pool.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "",
+			fmt.Sprintf("StreamPool %s is %s", displayName, state), c.Log)
+		c.Metrics.AddCRFaultMetrics(ctx, kind, "Failed to Create the StreamPool", req.Name, req.Namespace)
+		return servicemanager.OSOKResponse{IsSuccessful: false}
+	case streaming.StreamPoolLifecycleStateActive:
+		pool.Status.OsokStatus = util.UpdateOSOKStatusCondition(pool.Status.OsokStatus,
+			ociv1beta1.Active, v1.ConditionTrue, "",
+			fmt.Sprintf("StreamPool %s is Active", displayName), c.Log)
+		c.Metrics.AddCRSuccessMetrics(ctx, kind, "StreamPool in Active state", req.Name, req.Namespace)
+		return servicemanager.OSOKResponse{IsSuccessful: true}
+	default:
+		pool.Status.OsokStatus = util.UpdateOSOKStatusCondition(pool.Status.OsokStatus,
+			ociv1beta1.Provisioning, v1.ConditionTrue, "",
+			fmt.Sprintf("StreamPool %s is %s", displayName, state), c.Log)
+		c.Log.InfoLog(fmt.Sprintf("StreamPool %s is %s, requeueing", displayName, state))
+		return servicemanager.OSOKResponse{IsSuccessful: false, ShouldRequeue: true}
+	}
+}
+
+// Delete handles deletion of the stream pool (called by the finalizer).
+func (c *StreamPoolServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	pool, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	poolID := pool.Status.OsokStatus.Ocid
+	if poolID == "" {
+		poolID = pool.Spec.StreamPoolId
+	}
+	if poolID == "" {
+		c.Log.InfoLog("OciStreamPool has no OCID, nothing to delete")
+		return true, nil
+	}
+
+	c.Log.InfoLog(fmt.Sprintf("Deleting StreamPool %s", poolID))
+	if err := c.DeleteStreamPool(ctx, poolID); err != nil {
+		if isStreamNotFound(err) {
+			return true, nil
+		}
+		c.Log.ErrorLog(err, "Error while deleting StreamPool")
+		return false, err
+	}
+
+	poolInstance, err := c.GetStreamPool(ctx, poolID)
+	if err != nil {
+		if isStreamNotFound(err) {
+			return true, nil
+		}
+		c.Log.ErrorLog(err, "Error while getting StreamPool after delete")
+		return false, err
+	}
+	return poolInstance.LifecycleState == streaming.StreamPoolLifecycleStateDeleted, nil
+}
+
+// GetCrdStatus returns the OSOK status from the resource.
+func (c *StreamPoolServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &resource.Status.OsokStatus, nil
+}
+
+func (c *StreamPoolServiceManager) convert(obj runtime.Object) (*ociv1beta1.OciStreamPool, error) {
+	pool, ok := obj.(*ociv1beta1.OciStreamPool)
+	if !ok {
+		return nil, fmt.Errorf("failed type assertion for OciStreamPool")
+	}
+	return pool, nil
+}
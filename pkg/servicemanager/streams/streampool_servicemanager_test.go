@@ -0,0 +1,182 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package streams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/streaming"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	. "github.com/oracle/oci-service-operator/pkg/servicemanager/streams"
+	"github.com/stretchr/testify/assert"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// mockStreamPoolClient implements StreamPoolClientInterface for testing.
+type mockStreamPoolClient struct {
+	createStreamPoolFn func(ctx context.Context, req streaming.CreateStreamPoolRequest) (streaming.CreateStreamPoolResponse, error)
+	getStreamPoolFn    func(ctx context.Context, req streaming.GetStreamPoolRequest) (streaming.GetStreamPoolResponse, error)
+	listStreamPoolsFn  func(ctx context.Context, req streaming.ListStreamPoolsRequest) (streaming.ListStreamPoolsResponse, error)
+	updateStreamPoolFn func(ctx context.Context, req streaming.UpdateStreamPoolRequest) (streaming.UpdateStreamPoolResponse, error)
+	deleteStreamPoolFn func(ctx context.Context, req streaming.DeleteStreamPoolRequest) (streaming.DeleteStreamPoolResponse, error)
+}
+
+func (m *mockStreamPoolClient) CreateStreamPool(ctx context.Context, req streaming.CreateStreamPoolRequest) (streaming.CreateStreamPoolResponse, error) {
+	if m.createStreamPoolFn != nil {
+		return m.createStreamPoolFn(ctx, req)
+	}
+	return streaming.CreateStreamPoolResponse{}, nil
+}
+
+func (m *mockStreamPoolClient) GetStreamPool(ctx context.Context, req streaming.GetStreamPoolRequest) (streaming.GetStreamPoolResponse, error) {
+	if m.getStreamPoolFn != nil {
+		return m.getStreamPoolFn(ctx, req)
+	}
+	return streaming.GetStreamPoolResponse{}, nil
+}
+
+func (m *mockStreamPoolClient) ListStreamPools(ctx context.Context, req streaming.ListStreamPoolsRequest) (streaming.ListStreamPoolsResponse, error) {
+	if m.listStreamPoolsFn != nil {
+		return m.listStreamPoolsFn(ctx, req)
+	}
+	return streaming.ListStreamPoolsResponse{}, nil
+}
+
+func (m *mockStreamPoolClient) UpdateStreamPool(ctx context.Context, req streaming.UpdateStreamPoolRequest) (streaming.UpdateStreamPoolResponse, error) {
+	if m.updateStreamPoolFn != nil {
+		return m.updateStreamPoolFn(ctx, req)
+	}
+	return streaming.UpdateStreamPoolResponse{}, nil
+}
+
+func (m *mockStreamPoolClient) DeleteStreamPool(ctx context.Context, req streaming.DeleteStreamPoolRequest) (streaming.DeleteStreamPoolResponse, error) {
+	if m.deleteStreamPoolFn != nil {
+		return m.deleteStreamPoolFn(ctx, req)
+	}
+	return streaming.DeleteStreamPoolResponse{}, nil
+}
+
+func makeStreamPoolManager(mockClient *mockStreamPoolClient) *StreamPoolServiceManager {
+	log := loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+	mgr := NewStreamPoolServiceManager(
+		common.NewRawConfigurationProvider("", "", "", "", "", nil),
+		&fakeCredentialClient{}, nil, log, &metrics.Metrics{Logger: log})
+	ExportSetStreamPoolClientForTest(mgr, mockClient)
+	return mgr
+}
+
+func makeStreamPool(id, name string, state streaming.StreamPoolLifecycleStateEnum) streaming.StreamPool {
+	return streaming.StreamPool{
+		Id:             common.String(id),
+		Name:           common.String(name),
+		CompartmentId:  common.String("ocid1.compartment.oc1..xxx"),
+		LifecycleState: state,
+	}
+}
+
+// TestStreamPool_CreateOrUpdate_CreateNew verifies a pool is created when none exists with the spec name.
+func TestStreamPool_CreateOrUpdate_CreateNew(t *testing.T) {
+	poolID := "ocid1.streampool.oc1..new"
+	var created streaming.CreateStreamPoolDetails
+	mockClient := &mockStreamPoolClient{
+		listStreamPoolsFn: func(_ context.Context, req streaming.ListStreamPoolsRequest) (streaming.ListStreamPoolsResponse, error) {
+			assert.Equal(t, "test-pool", *req.Name)
+			return streaming.ListStreamPoolsResponse{}, nil
+		},
+		createStreamPoolFn: func(_ context.Context, req streaming.CreateStreamPoolRequest) (streaming.CreateStreamPoolResponse, error) {
+			created = req.CreateStreamPoolDetails
+			return streaming.CreateStreamPoolResponse{
+				StreamPool: makeStreamPool(poolID, "test-pool", streaming.StreamPoolLifecycleStateCreating),
+			}, nil
+		},
+	}
+	mgr := makeStreamPoolManager(mockClient)
+
+	pool := &ociv1beta1.OciStreamPool{}
+	pool.Spec.Name = "test-pool"
+	pool.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+	pool.Spec.KmsKeyId = "ocid1.key.oc1..xxx"
+	pool.Spec.PrivateEndpoint = &ociv1beta1.OciStreamPoolPrivateEndpoint{
+		SubnetId: "ocid1.subnet.oc1..xxx",
+		NsgIds:   []ociv1beta1.OCID{"ocid1.nsg.oc1..xxx"},
+	}
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), pool, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue, "a CREATING pool should requeue")
+	assert.Equal(t, ociv1beta1.OCID(poolID), pool.Status.OsokStatus.Ocid)
+	assert.Equal(t, "ocid1.key.oc1..xxx", *created.CustomEncryptionKeyDetails.KmsKeyId)
+	assert.Equal(t, "ocid1.subnet.oc1..xxx", *created.PrivateEndpointDetails.SubnetId)
+	assert.Equal(t, []string{"ocid1.nsg.oc1..xxx"}, created.PrivateEndpointDetails.NsgIds)
+}
+
+// TestStreamPool_CreateOrUpdate_AdoptById verifies an existing pool is bound by spec OCID without a create.
+func TestStreamPool_CreateOrUpdate_AdoptById(t *testing.T) {
+	poolID := "ocid1.streampool.oc1..existing"
+	mockClient := &mockStreamPoolClient{
+		getStreamPoolFn: func(_ context.Context, req streaming.GetStreamPoolRequest) (streaming.GetStreamPoolResponse, error) {
+			assert.Equal(t, poolID, *req.StreamPoolId)
+			return streaming.GetStreamPoolResponse{
+				StreamPool: makeStreamPool(poolID, "existing-pool", streaming.StreamPoolLifecycleStateActive),
+			}, nil
+		},
+		createStreamPoolFn: func(_ context.Context, _ streaming.CreateStreamPoolRequest) (streaming.CreateStreamPoolResponse, error) {
+			t.Fatal("CreateStreamPool should not be called when adopting by OCID")
+			return streaming.CreateStreamPoolResponse{}, nil
+		},
+		updateStreamPoolFn: func(_ context.Context, _ streaming.UpdateStreamPoolRequest) (streaming.UpdateStreamPoolResponse, error) {
+			t.Fatal("UpdateStreamPool should not be called without spec changes")
+			return streaming.UpdateStreamPoolResponse{}, nil
+		},
+	}
+	mgr := makeStreamPoolManager(mockClient)
+
+	pool := &ociv1beta1.OciStreamPool{}
+	pool.Spec.StreamPoolId = ociv1beta1.OCID(poolID)
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), pool, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.Equal(t, ociv1beta1.OCID(poolID), pool.Status.OsokStatus.Ocid)
+}
+
+// TestStreamPool_Delete_NoOcid verifies Delete is a no-op when the pool was never created.
+func TestStreamPool_Delete_NoOcid(t *testing.T) {
+	mockClient := &mockStreamPoolClient{
+		deleteStreamPoolFn: func(_ context.Context, _ streaming.DeleteStreamPoolRequest) (streaming.DeleteStreamPoolResponse, error) {
+			t.Fatal("DeleteStreamPool should not be called without an OCID")
+			return streaming.DeleteStreamPoolResponse{}, nil
+		},
+	}
+	mgr := makeStreamPoolManager(mockClient)
+
+	done, err := mgr.Delete(context.Background(), &ociv1beta1.OciStreamPool{})
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+// TestStreamPool_Delete_Error verifies Delete surfaces OCI delete failures and keeps the finalizer.
+func TestStreamPool_Delete_Error(t *testing.T) {
+	mockClient := &mockStreamPoolClient{
+		deleteStreamPoolFn: func(_ context.Context, _ streaming.DeleteStreamPoolRequest) (streaming.DeleteStreamPoolResponse, error) {
+			return streaming.DeleteStreamPoolResponse{}, errors.New("delete failed")
+		},
+	}
+	mgr := makeStreamPoolManager(mockClient)
+
+	pool := &ociv1beta1.OciStreamPool{}
+	pool.Status.OsokStatus.Ocid = "ocid1.streampool.oc1..xxx"
+
+	done, err := mgr.Delete(context.Background(), pool)
+	assert.Error(t, err)
+	assert.False(t, done)
+}
@@ -0,0 +1,185 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package vaultsecret
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocivault "github.com/oracle/oci-go-sdk/v65/vault"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+)
+
+// VaultsClientInterface defines the OCI operations used by OciVaultSecretServiceManager.
+type VaultsClientInterface interface {
+	CreateSecret(ctx context.Context, request ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error)
+	GetSecret(ctx context.Context, request ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error)
+	ListSecrets(ctx context.Context, request ocivault.ListSecretsRequest) (ocivault.ListSecretsResponse, error)
+	UpdateSecret(ctx context.Context, request ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error)
+	ScheduleSecretDeletion(ctx context.Context, request ocivault.ScheduleSecretDeletionRequest) (ocivault.ScheduleSecretDeletionResponse, error)
+}
+
+func getVaultsClient(provider common.ConfigurationProvider) (ocivault.VaultsClient, error) {
+	client, err := ocivault.NewVaultsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, err
+	}
+	servicemanager.TraceRequests(&client.BaseClient)
+	return client, nil
+}
+
+// getOCIClient returns the injected client if set, otherwise creates one from the provider.
+func (c *OciVaultSecretServiceManager) getOCIClient() (VaultsClientInterface, error) {
+	if c.ociClient != nil {
+		return c.ociClient, nil
+	}
+	return getVaultsClient(c.Provider)
+}
+
+// CreateSecret creates the secret in the vault with content as its first version.
+func (c *OciVaultSecretServiceManager) CreateSecret(ctx context.Context, s ociv1beta1.OciVaultSecret, content string) (*ocivault.Secret, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.DebugLog("Creating OciVaultSecret", "name", s.Spec.SecretName)
+
+	details := ocivault.CreateSecretDetails{
+		CompartmentId: common.String(string(s.Spec.CompartmentId)),
+		VaultId:       common.String(string(s.Spec.VaultId)),
+		KeyId:         common.String(string(s.Spec.KeyId)),
+		SecretName:    common.String(s.Spec.SecretName),
+		SecretContent: ocivault.Base64SecretContentDetails{Content: common.String(content)},
+		FreeformTags:  s.Spec.FreeFormTags,
+	}
+	if s.Spec.Description != "" {
+		details.Description = common.String(s.Spec.Description)
+	}
+	if s.Spec.DefinedTags != nil {
+		details.DefinedTags = *util.ConvertToOciDefinedTags(&s.Spec.DefinedTags)
+	}
+
+	resp, err := client.CreateSecret(ctx, ocivault.CreateSecretRequest{CreateSecretDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Secret, nil
+}
+
+// GetSecret retrieves a secret by OCID.
+func (c *OciVaultSecretServiceManager) GetSecret(ctx context.Context, secretId ociv1beta1.OCID) (*ocivault.Secret, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSecret(ctx, ocivault.GetSecretRequest{
+		SecretId: common.String(string(secretId)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Secret, nil
+}
+
+// GetSecretOcid looks up an existing secret by name in the vault and returns its OCID if found.
+// Returns nil if no matching secret in CREATING, UPDATING, or ACTIVE state is found.
+func (c *OciVaultSecretServiceManager) GetSecretOcid(ctx context.Context, s ociv1beta1.OciVaultSecret) (*ociv1beta1.OCID, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListSecrets(ctx, ocivault.ListSecretsRequest{
+		CompartmentId: common.String(string(s.Spec.CompartmentId)),
+		VaultId:       common.String(string(s.Spec.VaultId)),
+		Name:          common.String(s.Spec.SecretName),
+	})
+	if err != nil {
+		c.Log.ErrorLog(err, "Error listing vault secrets")
+		return nil, err
+	}
+
+	for _, item := range resp.Items {
+		state := string(item.LifecycleState)
+		if state == "ACTIVE" || state == "CREATING" || state == "UPDATING" {
+			c.Log.DebugLog(fmt.Sprintf("OciVaultSecret %s exists with OCID %s", s.Spec.SecretName, *item.Id))
+			return (*ociv1beta1.OCID)(item.Id), nil
+		}
+	}
+
+	c.Log.DebugLog(fmt.Sprintf("OciVaultSecret %s does not exist", s.Spec.SecretName))
+	return nil, nil
+}
+
+// UpdateSecret updates the description and tags of an existing secret. A non-empty content is written as a
+// new secret version, which OCI makes the current version. Returns the existing secret when nothing changed.
+func (c *OciVaultSecretServiceManager) UpdateSecret(ctx context.Context, secretId ociv1beta1.OCID, s *ociv1beta1.OciVaultSecret,
+	existing *ocivault.Secret, content string) (*ocivault.Secret, error) {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	details, updateNeeded := buildSecretUpdateDetails(s, existing)
+	if content != "" {
+		details.SecretContent = ocivault.Base64SecretContentDetails{Content: common.String(content)}
+		updateNeeded = true
+	}
+	if !updateNeeded {
+		return existing, nil
+	}
+
+	resp, err := client.UpdateSecret(ctx, ocivault.UpdateSecretRequest{
+		SecretId:            common.String(string(secretId)),
+		UpdateSecretDetails: details,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Secret, nil
+}
+
+func buildSecretUpdateDetails(s *ociv1beta1.OciVaultSecret, existing *ocivault.Secret) (ocivault.UpdateSecretDetails, bool) {
+	details := ocivault.UpdateSecretDetails{}
+	updateNeeded := false
+
+	if s.Spec.Description != "" && (existing.Description == nil || *existing.Description != s.Spec.Description) {
+		details.Description = common.String(s.Spec.Description)
+		updateNeeded = true
+	}
+	if s.Spec.FreeFormTags != nil && !reflect.DeepEqual(existing.FreeformTags, s.Spec.FreeFormTags) {
+		details.FreeformTags = s.Spec.FreeFormTags
+		updateNeeded = true
+	}
+	if s.Spec.DefinedTags != nil {
+		desiredDefinedTags := *util.ConvertToOciDefinedTags(&s.Spec.DefinedTags)
+		if !reflect.DeepEqual(existing.DefinedTags, desiredDefinedTags) {
+			details.DefinedTags = desiredDefinedTags
+			updateNeeded = true
+		}
+	}
+
+	return details, updateNeeded
+}
+
+// ScheduleSecretDeletion schedules the secret for deletion using the vault's default pending period.
+func (c *OciVaultSecretServiceManager) ScheduleSecretDeletion(ctx context.Context, secretId ociv1beta1.OCID) error {
+	client, err := c.getOCIClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ScheduleSecretDeletion(ctx, ocivault.ScheduleSecretDeletionRequest{
+		SecretId: common.String(string(secretId)),
+	})
+	return err
+}
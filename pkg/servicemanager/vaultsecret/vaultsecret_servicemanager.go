@@ -0,0 +1,249 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package vaultsecret
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocivault "github.com/oracle/oci-go-sdk/v65/vault"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/credhelper"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/servicemanager"
+	"github.com/oracle/oci-service-operator/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Compile-time checks that OciVaultSecretServiceManager implements the reconciler interfaces.
+var (
+	_ servicemanager.OSOKServiceManager    = &OciVaultSecretServiceManager{}
+	_ servicemanager.PendingActionReporter = &OciVaultSecretServiceManager{}
+	_ servicemanager.UnchangedSpecSkipper  = &OciVaultSecretServiceManager{}
+)
+
+// OciVaultSecretServiceManager implements OSOKServiceManager for OCI Vault secrets.
+type OciVaultSecretServiceManager struct {
+	Provider         common.ConfigurationProvider
+	CredentialClient credhelper.CredentialClient
+	Scheme           *runtime.Scheme
+	Log              loggerutil.OSOKLogger
+	ociClient        VaultsClientInterface
+}
+
+// NewOciVaultSecretServiceManager creates a new OciVaultSecretServiceManager.
+func NewOciVaultSecretServiceManager(provider common.ConfigurationProvider, credClient credhelper.CredentialClient,
+	scheme *runtime.Scheme, log loggerutil.OSOKLogger) *OciVaultSecretServiceManager {
+	return &OciVaultSecretServiceManager{
+		Provider:         provider,
+		CredentialClient: credClient,
+		Scheme:           scheme,
+		Log:              log,
+	}
+}
+
+// CreateOrUpdate reconciles the OciVaultSecret resource against OCI.
+//
+// The secret content is read from Spec.Content on every reconcile. Status.ContentHash records what was last
+// written to the vault, so a changed source value is pushed as a new secret version and an unchanged one
+// leaves the vault alone.
+func (c *OciVaultSecretServiceManager) CreateOrUpdate(ctx context.Context, obj runtime.Object, req ctrl.Request) (servicemanager.OSOKResponse, error) {
+	s, err := c.convert(obj)
+	if err != nil {
+		c.Log.ErrorLog(err, "Conversion of object failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	content, err := c.readSourceContent(ctx, s)
+	if err != nil {
+		s.Status.OsokStatus = util.UpdateOSOKStatusCondition(s.Status.OsokStatus,
+			ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+		c.Log.ErrorLog(err, "Reading OciVaultSecret content failed")
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+	hash := contentHash(content)
+
+	secretInstance, err := c.resolveSecret(ctx, s)
+	if err != nil {
+		return servicemanager.OSOKResponse{IsSuccessful: false}, err
+	}
+
+	if secretInstance == nil {
+		secretInstance, err = c.CreateSecret(ctx, *s, content)
+		if err != nil {
+			s.Status.OsokStatus = util.UpdateOSOKStatusCondition(s.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+			c.Log.ErrorLog(err, "Create OciVaultSecret failed")
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		s.Status.ContentHash = hash
+	} else if secretInstance.LifecycleState == ocivault.SecretLifecycleStateActive {
+		newContent := ""
+		if hash != s.Status.ContentHash {
+			c.Log.InfoLog(fmt.Sprintf("OciVaultSecret %s content changed, creating a new secret version", s.Spec.SecretName))
+			newContent = content
+		}
+		secretInstance, err = c.UpdateSecret(ctx, ociv1beta1.OCID(*secretInstance.Id), s, secretInstance, newContent)
+		if err != nil {
+			s.Status.OsokStatus = util.UpdateOSOKStatusCondition(s.Status.OsokStatus,
+				ociv1beta1.Failed, v1.ConditionFalse, "", err.Error(), c.Log)
+			c.Log.ErrorLog(err, "Error while updating OciVaultSecret")
+			return servicemanager.OSOKResponse{IsSuccessful: false}, err
+		}
+		s.Status.ContentHash = hash
+	}
+
+	if secretInstance.CurrentVersionNumber != nil {
+		s.Status.CurrentVersionNumber = *secretInstance.CurrentVersionNumber
+	}
+	return servicemanager.ReconcileLifecycleStatus(&s.Status.OsokStatus, "OciVaultSecret", safeString(secretInstance.SecretName),
+		string(secretInstance.LifecycleState), ociv1beta1.OCID(*secretInstance.Id), c.Log,
+		[]string{string(ocivault.SecretLifecycleStateActive)},
+		[]string{string(ocivault.SecretLifecycleStateCreating), string(ocivault.SecretLifecycleStateUpdating)}), nil
+}
+
+// resolveSecret returns the secret tracked in status, bound through Spec.SecretId, or found by name in the
+// vault. Returns nil when the secret does not exist yet.
+func (c *OciVaultSecretServiceManager) resolveSecret(ctx context.Context, s *ociv1beta1.OciVaultSecret) (*ocivault.Secret, error) {
+	secretID, err := servicemanager.ResolveResourceID(s.Status.OsokStatus.Ocid, s.Spec.SecretId)
+	if err != nil {
+		ocid, lookupErr := c.GetSecretOcid(ctx, *s)
+		if lookupErr != nil {
+			c.Log.ErrorLog(lookupErr, "Error while getting existing OciVaultSecret")
+			return nil, lookupErr
+		}
+		if ocid == nil {
+			return nil, nil
+		}
+		secretID = *ocid
+	}
+
+	secretInstance, err := c.GetSecret(ctx, secretID)
+	if err != nil {
+		c.Log.ErrorLog(err, "Error while getting OciVaultSecret by OCID")
+		return nil, err
+	}
+	return secretInstance, nil
+}
+
+// readSourceContent returns the base64-encoded value of Spec.Content from the Kubernetes secret.
+func (c *OciVaultSecretServiceManager) readSourceContent(ctx context.Context, s *ociv1beta1.OciVaultSecret) (string, error) {
+	data, err := c.CredentialClient.GetSecret(ctx, s.Spec.Content.SecretName, s.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("reading secret %s/%s: %w", s.Namespace, s.Spec.Content.SecretName, err)
+	}
+	value, ok := data[s.Spec.Content.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %s/%s", s.Spec.Content.Key, s.Namespace, s.Spec.Content.SecretName)
+	}
+	return base64.StdEncoding.EncodeToString(value), nil
+}
+
+// Delete schedules the secret for deletion (called by the finalizer). OCI keeps a secret in
+// PENDING_DELETION until its deletion time, so that state counts as deleted.
+func (c *OciVaultSecretServiceManager) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	s, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	targetID, err := servicemanager.ResolveResourceID(s.Status.OsokStatus.Ocid, s.Spec.SecretId)
+	if err != nil {
+		c.Log.InfoLog("OciVaultSecret has no OCID, nothing to delete")
+		return true, nil
+	}
+
+	secretInstance, err := c.GetSecret(ctx, targetID)
+	if err != nil {
+		if servicemanager.IsNotFoundServiceError(err) {
+			return true, nil
+		}
+		c.Log.ErrorLog(err, "Error while getting OciVaultSecret")
+		return false, err
+	}
+	if isSecretDeleted(secretInstance.LifecycleState) {
+		return true, nil
+	}
+
+	c.Log.InfoLog(fmt.Sprintf("Scheduling deletion of OciVaultSecret %s", targetID))
+	if err := c.ScheduleSecretDeletion(ctx, targetID); err != nil {
+		if servicemanager.IsNotFoundServiceError(err) {
+			return true, nil
+		}
+		c.Log.ErrorLog(err, "Error while deleting OciVaultSecret")
+		return false, err
+	}
+
+	secretInstance, err = c.GetSecret(ctx, targetID)
+	if err != nil {
+		if servicemanager.IsNotFoundServiceError(err) {
+			return true, nil
+		}
+		c.Log.ErrorLog(err, "Error while checking OciVaultSecret deletion")
+		return false, err
+	}
+	return isSecretDeleted(secretInstance.LifecycleState), nil
+}
+
+// HasPendingAction reports whether the source secret value differs from what was last written to the
+// vault, so the reconciler creates a new secret version even though the spec is unchanged. An unreadable
+// source secret also counts, so the error is reported on the resource.
+func (c *OciVaultSecretServiceManager) HasPendingAction(ctx context.Context, obj runtime.Object) bool {
+	s, err := c.convert(obj)
+	if err != nil {
+		return false
+	}
+	content, err := c.readSourceContent(ctx, s)
+	if err != nil {
+		return true
+	}
+	return contentHash(content) != s.Status.ContentHash
+}
+
+// SkipUnchangedSpec opts in to skipping reconciles of an unchanged spec. The source secret is the only
+// input outside the spec, and HasPendingAction reports when it changes.
+func (c *OciVaultSecretServiceManager) SkipUnchangedSpec() bool {
+	return true
+}
+
+// GetCrdStatus returns the OSOK status from the resource.
+func (c *OciVaultSecretServiceManager) GetCrdStatus(obj runtime.Object) (*ociv1beta1.OSOKStatus, error) {
+	resource, err := c.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &resource.Status.OsokStatus, nil
+}
+
+func (c *OciVaultSecretServiceManager) convert(obj runtime.Object) (*ociv1beta1.OciVaultSecret, error) {
+	s, ok := obj.(*ociv1beta1.OciVaultSecret)
+	if !ok {
+		return nil, fmt.Errorf("failed type assertion for OciVaultSecret")
+	}
+	return s, nil
+}
+
+func isSecretDeleted(state ocivault.SecretLifecycleStateEnum) bool {
+	return state == ocivault.SecretLifecycleStatePendingDeletion || state == ocivault.SecretLifecycleStateDeleted
+}
+
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func safeString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
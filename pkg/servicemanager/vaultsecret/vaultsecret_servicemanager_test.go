@@ -0,0 +1,412 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package vaultsecret_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocivault "github.com/oracle/oci-go-sdk/v65/vault"
+	ociv1beta1 "github.com/oracle/oci-service-operator/api/v1beta1"
+	"github.com/oracle/oci-service-operator/pkg/core"
+	"github.com/oracle/oci-service-operator/pkg/loggerutil"
+	"github.com/oracle/oci-service-operator/pkg/metrics"
+	. "github.com/oracle/oci-service-operator/pkg/servicemanager/vaultsecret"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// ---------------------------------------------------------------------------
+// fakeCredentialClient — implements credhelper.CredentialClient for testing.
+// ---------------------------------------------------------------------------
+
+type fakeCredentialClient struct {
+	secrets map[string]map[string][]byte
+}
+
+func (f *fakeCredentialClient) CreateSecret(ctx context.Context, name, ns string, labels map[string]string, data map[string][]byte) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeCredentialClient) DeleteSecret(ctx context.Context, name, ns string) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeCredentialClient) GetSecret(ctx context.Context, name, ns string) (map[string][]byte, error) {
+	data, ok := f.secrets[ns+"/"+name]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s not found", ns, name)
+	}
+	return data, nil
+}
+
+func (f *fakeCredentialClient) UpdateSecret(ctx context.Context, name, ns string, labels map[string]string, data map[string][]byte) (bool, error) {
+	return true, nil
+}
+
+type fakeServiceError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e fakeServiceError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.statusCode, e.code, e.message)
+}
+func (e fakeServiceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e fakeServiceError) GetMessage() string      { return e.message }
+func (e fakeServiceError) GetCode() string         { return e.code }
+func (e fakeServiceError) GetOpcRequestID() string { return "opc-request-id" }
+
+// ---------------------------------------------------------------------------
+// fakeVaultsClient — implements VaultsClientInterface for testing.
+// ---------------------------------------------------------------------------
+
+type fakeVaultsClient struct {
+	createSecretFn           func(ctx context.Context, req ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error)
+	getSecretFn              func(ctx context.Context, req ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error)
+	listSecretsFn            func(ctx context.Context, req ocivault.ListSecretsRequest) (ocivault.ListSecretsResponse, error)
+	updateSecretFn           func(ctx context.Context, req ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error)
+	scheduleSecretDeletionFn func(ctx context.Context, req ocivault.ScheduleSecretDeletionRequest) (ocivault.ScheduleSecretDeletionResponse, error)
+}
+
+func (f *fakeVaultsClient) CreateSecret(ctx context.Context, req ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+	if f.createSecretFn != nil {
+		return f.createSecretFn(ctx, req)
+	}
+	return ocivault.CreateSecretResponse{}, nil
+}
+
+func (f *fakeVaultsClient) GetSecret(ctx context.Context, req ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+	if f.getSecretFn != nil {
+		return f.getSecretFn(ctx, req)
+	}
+	return ocivault.GetSecretResponse{}, nil
+}
+
+func (f *fakeVaultsClient) ListSecrets(ctx context.Context, req ocivault.ListSecretsRequest) (ocivault.ListSecretsResponse, error) {
+	if f.listSecretsFn != nil {
+		return f.listSecretsFn(ctx, req)
+	}
+	return ocivault.ListSecretsResponse{}, nil
+}
+
+func (f *fakeVaultsClient) UpdateSecret(ctx context.Context, req ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error) {
+	if f.updateSecretFn != nil {
+		return f.updateSecretFn(ctx, req)
+	}
+	return ocivault.UpdateSecretResponse{}, nil
+}
+
+func (f *fakeVaultsClient) ScheduleSecretDeletion(ctx context.Context, req ocivault.ScheduleSecretDeletionRequest) (ocivault.ScheduleSecretDeletionResponse, error) {
+	if f.scheduleSecretDeletionFn != nil {
+		return f.scheduleSecretDeletionFn(ctx, req)
+	}
+	return ocivault.ScheduleSecretDeletionResponse{}, nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+const testSecretID = "ocid1.vaultsecret.oc1..xxx"
+
+func defaultLog() loggerutil.OSOKLogger {
+	return loggerutil.OSOKLogger{Logger: ctrl.Log.WithName("test")}
+}
+
+func emptyProvider() common.ConfigurationProvider {
+	return common.NewRawConfigurationProvider("", "", "", "", "", nil)
+}
+
+func credClientWith(value string) *fakeCredentialClient {
+	return &fakeCredentialClient{secrets: map[string]map[string][]byte{
+		"default/db-creds": {"password": []byte(value)},
+	}}
+}
+
+func mgrWithFake(credClient *fakeCredentialClient, fake *fakeVaultsClient) *OciVaultSecretServiceManager {
+	mgr := NewOciVaultSecretServiceManager(emptyProvider(), credClient, nil, defaultLog())
+	ExportSetClientForTest(mgr, fake)
+	return mgr
+}
+
+func makeVaultSecret() *ociv1beta1.OciVaultSecret {
+	s := &ociv1beta1.OciVaultSecret{}
+	s.Name = "db-password"
+	s.Namespace = "default"
+	s.Spec.CompartmentId = "ocid1.compartment.oc1..xxx"
+	s.Spec.VaultId = "ocid1.vault.oc1..xxx"
+	s.Spec.KeyId = "ocid1.key.oc1..xxx"
+	s.Spec.SecretName = "db-password"
+	s.Spec.Content = ociv1beta1.VaultSecretContentSource{SecretName: "db-creds", Key: "password"}
+	return s
+}
+
+func makeSecret(state ocivault.SecretLifecycleStateEnum, version int64) ocivault.Secret {
+	return ocivault.Secret{
+		Id:                   common.String(testSecretID),
+		SecretName:           common.String("db-password"),
+		LifecycleState:       state,
+		CurrentVersionNumber: common.Int64(version),
+	}
+}
+
+func encodedContent(t *testing.T, details ocivault.SecretContentDetails) string {
+	t.Helper()
+	content, ok := details.(ocivault.Base64SecretContentDetails)
+	if !assert.True(t, ok, "secret content should be base64 content details") {
+		return ""
+	}
+	return *content.Content
+}
+
+// ---------------------------------------------------------------------------
+// TestCreateOrUpdate
+// ---------------------------------------------------------------------------
+
+// TestCreateOrUpdate_CreatesSecretFromSourceKey verifies a missing secret is created with the base64
+// value of the referenced Kubernetes secret key.
+func TestCreateOrUpdate_CreatesSecretFromSourceKey(t *testing.T) {
+	var created ocivault.CreateSecretDetails
+	fake := &fakeVaultsClient{
+		createSecretFn: func(_ context.Context, req ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+			created = req.CreateSecretDetails
+			return ocivault.CreateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateCreating, 1)}, nil
+		},
+	}
+	mgr := mgrWithFake(credClientWith("s3cr3t"), fake)
+	s := makeVaultSecret()
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.False(t, resp.IsSuccessful)
+	assert.True(t, resp.ShouldRequeue)
+	assert.Equal(t, "ocid1.vault.oc1..xxx", *created.VaultId)
+	assert.Equal(t, "ocid1.key.oc1..xxx", *created.KeyId)
+	assert.Equal(t, "db-password", *created.SecretName)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("s3cr3t")), encodedContent(t, created.SecretContent))
+	assert.Equal(t, ociv1beta1.OCID(testSecretID), s.Status.OsokStatus.Ocid)
+	assert.Equal(t, int64(1), s.Status.CurrentVersionNumber)
+	assert.NotEmpty(t, s.Status.ContentHash)
+}
+
+// TestCreateOrUpdate_SourceChangeCreatesNewVersion verifies a changed source value is written as a new
+// secret version and the new version number is recorded.
+func TestCreateOrUpdate_SourceChangeCreatesNewVersion(t *testing.T) {
+	version := int64(1)
+	var updates []ocivault.UpdateSecretDetails
+	fake := &fakeVaultsClient{
+		createSecretFn: func(_ context.Context, _ ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+			return ocivault.CreateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+		getSecretFn: func(_ context.Context, _ ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+			return ocivault.GetSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+		updateSecretFn: func(_ context.Context, req ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error) {
+			assert.Equal(t, testSecretID, *req.SecretId)
+			updates = append(updates, req.UpdateSecretDetails)
+			version++
+			return ocivault.UpdateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+	}
+	credClient := credClientWith("v1")
+	mgr := mgrWithFake(credClient, fake)
+	s := makeVaultSecret()
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	firstHash := s.Status.ContentHash
+
+	credClient.secrets["default/db-creds"]["password"] = []byte("v2")
+	resp, err = mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("v2")), encodedContent(t, updates[0].SecretContent))
+	}
+	assert.Equal(t, int64(2), s.Status.CurrentVersionNumber)
+	assert.NotEqual(t, firstHash, s.Status.ContentHash)
+}
+
+// TestCreateOrUpdate_UnchangedSourceSkipsUpdate verifies no new version is created when the source value
+// matches what was last written.
+func TestCreateOrUpdate_UnchangedSourceSkipsUpdate(t *testing.T) {
+	updateCalled := false
+	fake := &fakeVaultsClient{
+		createSecretFn: func(_ context.Context, _ ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+			return ocivault.CreateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, 1)}, nil
+		},
+		getSecretFn: func(_ context.Context, _ ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+			return ocivault.GetSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, 1)}, nil
+		},
+		updateSecretFn: func(_ context.Context, _ ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error) {
+			updateCalled = true
+			return ocivault.UpdateSecretResponse{}, nil
+		},
+	}
+	mgr := mgrWithFake(credClientWith("v1"), fake)
+	s := makeVaultSecret()
+
+	_, err := mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.NoError(t, err)
+	resp, err := mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.NoError(t, err)
+	assert.True(t, resp.IsSuccessful)
+	assert.False(t, updateCalled)
+	assert.Equal(t, int64(1), s.Status.CurrentVersionNumber)
+}
+
+// TestCreateOrUpdate_MissingSourceKeyFails verifies a missing key fails before any OCI call.
+func TestCreateOrUpdate_MissingSourceKeyFails(t *testing.T) {
+	fake := &fakeVaultsClient{
+		createSecretFn: func(_ context.Context, _ ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+			t.Fatal("CreateSecret should not be called when the source key is missing")
+			return ocivault.CreateSecretResponse{}, nil
+		},
+	}
+	mgr := mgrWithFake(credClientWith("v1"), fake)
+	s := makeVaultSecret()
+	s.Spec.Content.Key = "token"
+
+	resp, err := mgr.CreateOrUpdate(context.Background(), s, ctrl.Request{})
+	assert.Error(t, err)
+	assert.False(t, resp.IsSuccessful)
+	conditions := s.Status.OsokStatus.Conditions
+	if assert.NotEmpty(t, conditions) {
+		assert.Equal(t, ociv1beta1.Failed, conditions[len(conditions)-1].Type)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// TestDelete
+// ---------------------------------------------------------------------------
+
+// TestDelete_SchedulesDeletion verifies deletion is scheduled and PENDING_DELETION completes the delete.
+func TestDelete_SchedulesDeletion(t *testing.T) {
+	scheduled := false
+	fake := &fakeVaultsClient{
+		getSecretFn: func(_ context.Context, _ ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+			if scheduled {
+				return ocivault.GetSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStatePendingDeletion, 1)}, nil
+			}
+			return ocivault.GetSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, 1)}, nil
+		},
+		scheduleSecretDeletionFn: func(_ context.Context, req ocivault.ScheduleSecretDeletionRequest) (ocivault.ScheduleSecretDeletionResponse, error) {
+			assert.Equal(t, testSecretID, *req.SecretId)
+			scheduled = true
+			return ocivault.ScheduleSecretDeletionResponse{}, nil
+		},
+	}
+	mgr := mgrWithFake(credClientWith("v1"), fake)
+	s := makeVaultSecret()
+	s.Status.OsokStatus.Ocid = testSecretID
+
+	done, err := mgr.Delete(context.Background(), s)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.True(t, scheduled)
+}
+
+// TestDelete_NotFound verifies a secret that no longer exists is treated as deleted.
+func TestDelete_NotFound(t *testing.T) {
+	fake := &fakeVaultsClient{
+		getSecretFn: func(_ context.Context, _ ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+			return ocivault.GetSecretResponse{}, fakeServiceError{statusCode: 404, code: "NotAuthorizedOrNotFound", message: "not found"}
+		},
+		scheduleSecretDeletionFn: func(_ context.Context, _ ocivault.ScheduleSecretDeletionRequest) (ocivault.ScheduleSecretDeletionResponse, error) {
+			t.Fatal("ScheduleSecretDeletion should not be called for a missing secret")
+			return ocivault.ScheduleSecretDeletionResponse{}, nil
+		},
+	}
+	mgr := mgrWithFake(credClientWith("v1"), fake)
+	s := makeVaultSecret()
+	s.Status.OsokStatus.Ocid = testSecretID
+
+	done, err := mgr.Delete(context.Background(), s)
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+// TestDelete_NoOcid verifies deletion with no OCID set is a no-op.
+func TestDelete_NoOcid(t *testing.T) {
+	mgr := mgrWithFake(credClientWith("v1"), &fakeVaultsClient{})
+
+	done, err := mgr.Delete(context.Background(), makeVaultSecret())
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+// ---------------------------------------------------------------------------
+// Reconcile through BaseReconciler
+// ---------------------------------------------------------------------------
+
+// TestReconcile_SourceRotationBypassesUnchangedSpecSkip verifies that once the applied spec hash is
+// recorded, an unchanged source secret skips OCI while a rotated one still creates a new secret version.
+func TestReconcile_SourceRotationBypassesUnchangedSpecSkip(t *testing.T) {
+	version := int64(1)
+	var getCalls, updates int
+	fake := &fakeVaultsClient{
+		createSecretFn: func(_ context.Context, _ ocivault.CreateSecretRequest) (ocivault.CreateSecretResponse, error) {
+			return ocivault.CreateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+		getSecretFn: func(_ context.Context, _ ocivault.GetSecretRequest) (ocivault.GetSecretResponse, error) {
+			getCalls++
+			return ocivault.GetSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+		updateSecretFn: func(_ context.Context, _ ocivault.UpdateSecretRequest) (ocivault.UpdateSecretResponse, error) {
+			updates++
+			version++
+			return ocivault.UpdateSecretResponse{Secret: makeSecret(ocivault.SecretLifecycleStateActive, version)}, nil
+		},
+	}
+	credClient := credClientWith("v1")
+	mgr := mgrWithFake(credClient, fake)
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, ociv1beta1.AddToScheme(scheme))
+	s := makeVaultSecret()
+	k8sClient := fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(s).
+		WithStatusSubresource(&ociv1beta1.OciVaultSecret{}).
+		Build()
+	log := defaultLog()
+	reconciler := &core.BaseReconciler{
+		Client:             k8sClient,
+		OSOKServiceManager: mgr,
+		Log:                log,
+		Metrics:            &metrics.Metrics{ServiceName: "test", Logger: log},
+		Recorder:           record.NewFakeRecorder(20),
+		Scheme:             scheme,
+	}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(s)}
+
+	_, err := reconciler.Reconcile(context.Background(), req, &ociv1beta1.OciVaultSecret{})
+	assert.NoError(t, err)
+	stored := &ociv1beta1.OciVaultSecret{}
+	assert.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, stored))
+	assert.NotEmpty(t, stored.Annotations[core.LastAppliedHashAnnotation])
+
+	_, err = reconciler.Reconcile(context.Background(), req, &ociv1beta1.OciVaultSecret{})
+	assert.NoError(t, err)
+	assert.Zero(t, getCalls, "an unchanged spec and source must not reach OCI")
+
+	credClient.secrets["default/db-creds"]["password"] = []byte("v2")
+	_, err = reconciler.Reconcile(context.Background(), req, &ociv1beta1.OciVaultSecret{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updates, "a rotated source must create a new secret version")
+	assert.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, stored))
+	assert.Equal(t, int64(2), stored.Status.CurrentVersionNumber)
+}
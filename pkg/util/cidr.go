@@ -0,0 +1,82 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package util
+
+import (
+	"fmt"
+	"net"
+)
+
+const (
+	// minVcnPrefixLength and maxCidrPrefixLength bound the IPv4 prefix lengths OCI accepts for a VCN.
+	minVcnPrefixLength  = 16
+	maxCidrPrefixLength = 30
+)
+
+// ValidateCidr checks that cidr is an IPv4 CIDR block OCI accepts for a VCN (/16 to /30) or, when isVcn
+// is false, for a subnet (/30 or larger). Whether a subnet fits within its VCN is checked by
+// ValidateCidrWithin.
+func ValidateCidr(cidr string, isVcn bool) error {
+	kind := "subnet"
+	if isVcn {
+		kind = "VCN"
+	}
+
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("%s CIDR block %q is not a valid CIDR block", kind, cidr)
+	}
+	if len(network.Mask) != net.IPv4len {
+		return fmt.Errorf("%s CIDR block %q is not an IPv4 CIDR block", kind, cidr)
+	}
+
+	prefix, _ := network.Mask.Size()
+	if isVcn && (prefix < minVcnPrefixLength || prefix > maxCidrPrefixLength) {
+		return fmt.Errorf("VCN CIDR block %q has prefix length /%d; OCI allows /%d to /%d",
+			cidr, prefix, minVcnPrefixLength, maxCidrPrefixLength)
+	}
+	if !isVcn && prefix > maxCidrPrefixLength {
+		return fmt.Errorf("subnet CIDR block %q has prefix length /%d; OCI allows /%d or larger",
+			cidr, prefix, maxCidrPrefixLength)
+	}
+	return nil
+}
+
+// ValidateCidrWithin checks that the subnet CIDR block fits entirely within one of the VCN's CIDR blocks.
+// Both the subnet and the VCN CIDR blocks must already be valid.
+func ValidateCidrWithin(subnetCidr string, vcnCidrs ...string) error {
+	_, subnet, err := net.ParseCIDR(subnetCidr)
+	if err != nil {
+		return fmt.Errorf("subnet CIDR block %q is not a valid CIDR block", subnetCidr)
+	}
+	subnetPrefix, _ := subnet.Mask.Size()
+
+	for _, vcnCidr := range vcnCidrs {
+		_, vcn, err := net.ParseCIDR(vcnCidr)
+		if err != nil {
+			continue
+		}
+		vcnPrefix, _ := vcn.Mask.Size()
+		if vcn.Contains(subnet.IP) && subnetPrefix >= vcnPrefix {
+			return nil
+		}
+	}
+	if len(vcnCidrs) == 1 {
+		return fmt.Errorf("subnet CIDR block %q does not fit within the VCN CIDR block %q", subnetCidr, vcnCidrs[0])
+	}
+	return fmt.Errorf("subnet CIDR block %q does not fit within any of the VCN CIDR blocks %v", subnetCidr, vcnCidrs)
+}
+
+// NormalizeCidr returns cidr in the network form OCI stores, with the host bits cleared, so
+// "10.0.0.5/24" becomes "10.0.0.0/24". Values that are not CIDR blocks, such as the service CIDR labels
+// security rules accept, are returned unchanged.
+func NormalizeCidr(cidr string) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return network.String()
+}
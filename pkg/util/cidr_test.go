@@ -0,0 +1,118 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCidr(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		isVcn   bool
+		wantErr string
+	}{
+		{name: "vcn /16", cidr: "10.0.0.0/16", isVcn: true},
+		{name: "vcn /24", cidr: "192.168.1.0/24", isVcn: true},
+		{name: "vcn /30", cidr: "10.0.0.0/30", isVcn: true},
+		{name: "vcn with host bits", cidr: "10.0.0.1/16", isVcn: true},
+		{name: "vcn /15 too large", cidr: "10.0.0.0/15", isVcn: true,
+			wantErr: `VCN CIDR block "10.0.0.0/15" has prefix length /15; OCI allows /16 to /30`},
+		{name: "vcn /8 too large", cidr: "10.0.0.0/8", isVcn: true,
+			wantErr: `VCN CIDR block "10.0.0.0/8" has prefix length /8; OCI allows /16 to /30`},
+		{name: "vcn /31 too small", cidr: "10.0.0.0/31", isVcn: true,
+			wantErr: `VCN CIDR block "10.0.0.0/31" has prefix length /31; OCI allows /16 to /30`},
+		{name: "vcn /32 too small", cidr: "10.0.0.0/32", isVcn: true,
+			wantErr: `VCN CIDR block "10.0.0.0/32" has prefix length /32; OCI allows /16 to /30`},
+		{name: "subnet /24", cidr: "10.0.1.0/24"},
+		{name: "subnet /16", cidr: "10.0.0.0/16"},
+		{name: "subnet /30", cidr: "10.0.1.0/30"},
+		{name: "subnet /31 too small", cidr: "10.0.1.0/31",
+			wantErr: `subnet CIDR block "10.0.1.0/31" has prefix length /31; OCI allows /30 or larger`},
+		{name: "subnet /32 too small", cidr: "10.0.1.1/32",
+			wantErr: `subnet CIDR block "10.0.1.1/32" has prefix length /32; OCI allows /30 or larger`},
+		{name: "empty", cidr: "", isVcn: true,
+			wantErr: `VCN CIDR block "" is not a valid CIDR block`},
+		{name: "missing prefix", cidr: "10.0.0.0", isVcn: true,
+			wantErr: `VCN CIDR block "10.0.0.0" is not a valid CIDR block`},
+		{name: "bad address", cidr: "10.0.0.256/24",
+			wantErr: `subnet CIDR block "10.0.0.256/24" is not a valid CIDR block`},
+		{name: "bad prefix", cidr: "10.0.0.0/33",
+			wantErr: `subnet CIDR block "10.0.0.0/33" is not a valid CIDR block`},
+		{name: "ipv6", cidr: "2001:db8::/56", isVcn: true,
+			wantErr: `VCN CIDR block "2001:db8::/56" is not an IPv4 CIDR block`},
+		{name: "ipv4-mapped ipv6", cidr: "::ffff:10.0.0.0/112",
+			wantErr: `subnet CIDR block "::ffff:10.0.0.0/112" is not an IPv4 CIDR block`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCidr(tt.cidr, tt.isVcn)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateCidrWithin(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnet  string
+		vcns    []string
+		wantErr string
+	}{
+		{name: "inside", subnet: "10.0.1.0/24", vcns: []string{"10.0.0.0/16"}},
+		{name: "same as vcn", subnet: "10.0.0.0/16", vcns: []string{"10.0.0.0/16"}},
+		{name: "last block of vcn", subnet: "10.0.255.252/30", vcns: []string{"10.0.0.0/16"}},
+		{name: "inside second vcn block", subnet: "172.16.4.0/24", vcns: []string{"10.0.0.0/16", "172.16.0.0/16"}},
+		{name: "outside", subnet: "10.1.0.0/24", vcns: []string{"10.0.0.0/16"},
+			wantErr: `subnet CIDR block "10.1.0.0/24" does not fit within the VCN CIDR block "10.0.0.0/16"`},
+		{name: "larger than vcn", subnet: "10.0.0.0/15", vcns: []string{"10.0.0.0/16"},
+			wantErr: `subnet CIDR block "10.0.0.0/15" does not fit within the VCN CIDR block "10.0.0.0/16"`},
+		{name: "overlaps vcn edge", subnet: "10.0.0.0/23", vcns: []string{"10.0.1.0/24"},
+			wantErr: `subnet CIDR block "10.0.0.0/23" does not fit within the VCN CIDR block "10.0.1.0/24"`},
+		{name: "outside every vcn block", subnet: "192.168.0.0/24", vcns: []string{"10.0.0.0/16", "172.16.0.0/16"},
+			wantErr: `subnet CIDR block "192.168.0.0/24" does not fit within any of the VCN CIDR blocks [10.0.0.0/16 172.16.0.0/16]`},
+		{name: "invalid subnet", subnet: "not-a-cidr", vcns: []string{"10.0.0.0/16"},
+			wantErr: `subnet CIDR block "not-a-cidr" is not a valid CIDR block`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCidrWithin(tt.subnet, tt.vcns...)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNormalizeCidr(t *testing.T) {
+	tests := []struct {
+		cidr string
+		want string
+	}{
+		{cidr: "10.0.0.5/24", want: "10.0.0.0/24"},
+		{cidr: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{cidr: "192.168.1.130/25", want: "192.168.1.128/25"},
+		{cidr: "0.0.0.0/0", want: "0.0.0.0/0"},
+		{cidr: "10.0.0.5/32", want: "10.0.0.5/32"},
+		{cidr: "2001:db8::1/64", want: "2001:db8::/64"},
+		{cidr: "all-phx-services-in-oracle-services-network", want: "all-phx-services-in-oracle-services-network"},
+		{cidr: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeCidr(tt.cidr))
+		})
+	}
+}
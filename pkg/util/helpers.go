@@ -9,6 +9,8 @@ import (
 	"archive/zip"
 	"context"
 	"io"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/oracle/oci-service-operator/api/v1beta1"
@@ -32,6 +34,19 @@ func DoNotRequeue() (ctrl.Result, error) {
 	return ctrl.Result{}, nil
 }
 
+// requeueJitter is the largest fraction by which JitterDuration moves a duration either way.
+const requeueJitter = 0.2
+
+// JitterDuration returns d moved by a random amount of up to 20% either way, so resources that fail
+// together do not all retry at the same moment. Durations of zero or less are returned unchanged.
+func JitterDuration(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	factor := 1 - requeueJitter + 2*requeueJitter*rand.Float64()
+	return time.Duration(float64(d) * factor)
+}
+
 func GetOSOKStatusCondition(status v1beta1.OSOKStatus, conditionType v1beta1.OSOKConditionType, _ loggerutil.OSOKLogger) *v1beta1.OSOKCondition {
 	for cnt := range status.Conditions {
 		if status.Conditions[cnt].Type == conditionType {
@@ -129,3 +144,78 @@ func ConvertToOciDefinedTags(osokDef *map[string]v1beta1.MapValue) *map[string]m
 
 	return &ociDefTags
 }
+
+// MergeTags returns the spec's tags with the operator-wide default tags added. A freeform key, or a
+// defined key within a namespace, that the spec sets keeps the spec's value. The spec's maps are not
+// modified. A tag map that is nil in both stays nil, so resources without tags are left unmanaged.
+func MergeTags(defaults, spec v1beta1.TagResources) v1beta1.TagResources {
+	merged := v1beta1.TagResources{FreeFormTags: spec.FreeFormTags, DefinedTags: spec.DefinedTags}
+	if len(defaults.FreeFormTags) > 0 {
+		merged.FreeFormTags = make(map[string]string, len(defaults.FreeFormTags)+len(spec.FreeFormTags))
+		for key, value := range defaults.FreeFormTags {
+			merged.FreeFormTags[key] = value
+		}
+		for key, value := range spec.FreeFormTags {
+			merged.FreeFormTags[key] = value
+		}
+	}
+	if len(defaults.DefinedTags) > 0 {
+		merged.DefinedTags = make(map[string]v1beta1.MapValue, len(defaults.DefinedTags)+len(spec.DefinedTags))
+		for namespace, tags := range defaults.DefinedTags {
+			merged.DefinedTags[namespace] = mergeMapValue(nil, tags)
+		}
+		for namespace, tags := range spec.DefinedTags {
+			merged.DefinedTags[namespace] = mergeMapValue(merged.DefinedTags[namespace], tags)
+		}
+	}
+	return merged
+}
+
+// MergeManagedTags is MergeTags for updates. Defaults are only added to a tag map the spec sets, so a
+// nil map stays nil and the tags of an adopted resource that the spec leaves unmanaged are not replaced.
+func MergeManagedTags(defaults, spec v1beta1.TagResources) v1beta1.TagResources {
+	if spec.FreeFormTags == nil {
+		defaults.FreeFormTags = nil
+	}
+	if spec.DefinedTags == nil {
+		defaults.DefinedTags = nil
+	}
+	return MergeTags(defaults, spec)
+}
+
+func mergeMapValue(base, overrides v1beta1.MapValue) v1beta1.MapValue {
+	merged := make(v1beta1.MapValue, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		merged[key] = value
+	}
+	return merged
+}
+
+// DnsLabelMaxLength is the longest DNS label OCI accepts for a VCN or subnet.
+const DnsLabelMaxLength = 15
+
+// SanitizeDnsLabel derives an OCI DNS label from a display name: it lowercases the name, drops
+// everything but letters and digits, prefixes an "x" when the result starts with a digit, and
+// truncates to DnsLabelMaxLength. It returns "" when the name holds no letters or digits.
+func SanitizeDnsLabel(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	label := b.String()
+	if label == "" {
+		return ""
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "x" + label
+	}
+	if len(label) > DnsLabelMaxLength {
+		label = label[:DnsLabelMaxLength]
+	}
+	return label
+}
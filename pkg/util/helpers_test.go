@@ -64,6 +64,20 @@ func TestRequeueWithoutError(t *testing.T) {
 	assert.Equal(t, duration, result.RequeueAfter)
 }
 
+func TestJitterDuration(t *testing.T) {
+	duration := 2 * time.Minute
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 100; i++ {
+		jittered := JitterDuration(duration)
+		assert.GreaterOrEqual(t, jittered, duration*8/10)
+		assert.LessOrEqual(t, jittered, duration*12/10)
+		seen[jittered] = true
+	}
+	assert.Greater(t, len(seen), 1, "jittered durations should vary across calls")
+
+	assert.Zero(t, JitterDuration(0))
+}
+
 func TestGetOSOKStatusCondition_Found(t *testing.T) {
 	log := testLogger()
 	status := v1beta1.OSOKStatus{
@@ -192,6 +206,50 @@ func TestConvertToOciDefinedTags_MultipleNamespaces(t *testing.T) {
 	assert.Equal(t, "2", (*result)["ns2"]["b"])
 }
 
+func TestMergeTags_AppliesDefaults(t *testing.T) {
+	defaults := v1beta1.TagResources{
+		FreeFormTags: map[string]string{"cost-center": "1234", "owner": "platform"},
+		DefinedTags:  map[string]v1beta1.MapValue{"governance": {"env": "prod"}},
+	}
+
+	merged := MergeTags(defaults, v1beta1.TagResources{})
+	assert.Equal(t, map[string]string{"cost-center": "1234", "owner": "platform"}, merged.FreeFormTags)
+	assert.Equal(t, map[string]v1beta1.MapValue{"governance": {"env": "prod"}}, merged.DefinedTags)
+}
+
+func TestMergeTags_SpecWins(t *testing.T) {
+	defaults := v1beta1.TagResources{
+		FreeFormTags: map[string]string{"cost-center": "1234", "owner": "platform"},
+		DefinedTags:  map[string]v1beta1.MapValue{"governance": {"env": "prod", "owner": "platform"}},
+	}
+	spec := v1beta1.TagResources{
+		FreeFormTags: map[string]string{"owner": "network-team", "app": "web"},
+		DefinedTags: map[string]v1beta1.MapValue{
+			"governance": {"owner": "network-team"},
+			"billing":    {"project": "alpha"},
+		},
+	}
+
+	merged := MergeTags(defaults, spec)
+	assert.Equal(t, map[string]string{"cost-center": "1234", "owner": "network-team", "app": "web"}, merged.FreeFormTags)
+	assert.Equal(t, map[string]v1beta1.MapValue{
+		"governance": {"env": "prod", "owner": "network-team"},
+		"billing":    {"project": "alpha"},
+	}, merged.DefinedTags)
+	assert.Equal(t, map[string]string{"owner": "network-team", "app": "web"}, spec.FreeFormTags, "the spec must not be modified")
+	assert.Equal(t, v1beta1.MapValue{"owner": "network-team"}, spec.DefinedTags["governance"], "the spec must not be modified")
+}
+
+func TestMergeTags_NoDefaultsKeepsSpec(t *testing.T) {
+	merged := MergeTags(v1beta1.TagResources{}, v1beta1.TagResources{})
+	assert.Nil(t, merged.FreeFormTags)
+	assert.Nil(t, merged.DefinedTags)
+
+	spec := v1beta1.TagResources{FreeFormTags: map[string]string{"app": "web"}}
+	merged = MergeTags(v1beta1.TagResources{}, spec)
+	assert.Equal(t, spec, merged)
+}
+
 func TestUnzipWallet_ValidZip(t *testing.T) {
 	// Create a temp zip file with test data
 	tmpFile, err := os.CreateTemp("", "wallet*.zip")
@@ -585,3 +643,55 @@ metadata:
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get resource by name")
 }
+
+func TestSanitizeDnsLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "already valid", in: "web", want: "web"},
+		{name: "lowercases", in: "AppTier", want: "apptier"},
+		{name: "strips punctuation and spaces", in: "app-tier_1 (prod)", want: "apptier1prod"},
+		{name: "strips non-ascii letters", in: "café-net", want: "cafnet"},
+		{name: "truncates to 15", in: "privateapplicationsubnet", want: "privateapplicat"},
+		{name: "exactly 15 kept", in: "abcdefghijklmno", want: "abcdefghijklmno"},
+		{name: "leading digit gets letter prefix", in: "10-0-1-0", want: "x10010"},
+		{name: "leading digit prefix counts toward limit", in: "123456789012345678", want: "x12345678901234"},
+		{name: "leading punctuation then digit", in: "--9lives", want: "x9lives"},
+		{name: "no usable characters", in: "--__--", want: ""},
+		{name: "empty", in: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeDnsLabel(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len(got), DnsLabelMaxLength)
+		})
+	}
+}
+
+func TestSanitizeDnsLabel_Collisions(t *testing.T) {
+	// Names differing only in case or punctuation sanitize to the same label.
+	assert.Equal(t, SanitizeDnsLabel("App-Subnet"), SanitizeDnsLabel("app_subnet"))
+	assert.Equal(t, SanitizeDnsLabel("App Subnet"), SanitizeDnsLabel("APPSUBNET"))
+	// Names sharing their first 15 usable characters collide after truncation.
+	assert.Equal(t, SanitizeDnsLabel("production-frontend"), SanitizeDnsLabel("production-frontdoor"))
+	// Distinct short names stay distinct.
+	assert.NotEqual(t, SanitizeDnsLabel("web-1"), SanitizeDnsLabel("web-2"))
+}
+
+func TestMergeManagedTags_LeavesUnmanagedTagsNil(t *testing.T) {
+	defaults := v1beta1.TagResources{
+		FreeFormTags: map[string]string{"cost-center": "1234"},
+		DefinedTags:  map[string]v1beta1.MapValue{"governance": {"env": "prod"}},
+	}
+
+	merged := MergeManagedTags(defaults, v1beta1.TagResources{})
+	assert.Nil(t, merged.FreeFormTags)
+	assert.Nil(t, merged.DefinedTags)
+
+	merged = MergeManagedTags(defaults, v1beta1.TagResources{FreeFormTags: map[string]string{"app": "web"}})
+	assert.Equal(t, map[string]string{"cost-center": "1234", "app": "web"}, merged.FreeFormTags)
+	assert.Nil(t, merged.DefinedTags)
+}
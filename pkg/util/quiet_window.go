@@ -0,0 +1,168 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// quietWindow is one recurring UTC time range. start and end are offsets from midnight of a day the
+// window applies to; an end at or before the start runs past midnight into the next day.
+type quietWindow struct {
+	days  [7]bool
+	start time.Duration
+	end   time.Duration
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ValidateQuietWindow reports whether spec is a valid list of quiet windows. spec is a comma-separated
+// list of windows such as "22:00-06:00", "Sat 00:00-24:00" or "Mon-Fri 18:00-20:00". Times are UTC.
+// Without days a window applies every day, and a window that runs past midnight belongs to the day it
+// starts on. An empty spec has no windows.
+func ValidateQuietWindow(spec string) error {
+	_, err := parseQuietWindows(spec)
+	return err
+}
+
+// InQuietWindow reports whether now falls in one of the windows of spec. An invalid spec has no windows.
+func InQuietWindow(now time.Time, spec string) bool {
+	_, quiet := QuietWindowEnd(now, spec)
+	return quiet
+}
+
+// QuietWindowEnd returns when the quiet window now falls in closes, following on into any window that
+// starts before it closes. It reports false when now is outside every window of spec.
+func QuietWindowEnd(now time.Time, spec string) (time.Time, bool) {
+	windows, err := parseQuietWindows(spec)
+	if err != nil || len(windows) == 0 {
+		return time.Time{}, false
+	}
+
+	end, quiet := now, false
+	// Each step moves past the end of one window; a week of back-to-back windows is the most there can be.
+	for i := 0; i < 8*len(windows); i++ {
+		next, ok := windowEndAt(end, windows)
+		if !ok {
+			break
+		}
+		end, quiet = next, true
+	}
+	return end, quiet
+}
+
+// windowEndAt returns the latest end of the windows that contain t.
+func windowEndAt(t time.Time, windows []quietWindow) (time.Time, bool) {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	var end time.Time
+	found := false
+	for _, window := range windows {
+		// A window containing t started today or, when it runs past midnight, yesterday.
+		for _, day := range []time.Time{midnight, midnight.AddDate(0, 0, -1)} {
+			if !window.days[day.Weekday()] {
+				continue
+			}
+			start, stop := day.Add(window.start), day.Add(window.end)
+			if window.end <= window.start {
+				stop = stop.Add(24 * time.Hour)
+			}
+			if !t.Before(start) && t.Before(stop) && stop.After(end) {
+				end, found = stop, true
+			}
+		}
+	}
+	return end, found
+}
+
+func parseQuietWindows(spec string) ([]quietWindow, error) {
+	var windows []quietWindow
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		window, err := parseQuietWindow(entry)
+		if err != nil {
+			return nil, fmt.Errorf("quiet window %q: %w", entry, err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func parseQuietWindow(entry string) (quietWindow, error) {
+	window := quietWindow{}
+	fields := strings.Fields(entry)
+	switch len(fields) {
+	case 1:
+		for day := range window.days {
+			window.days[day] = true
+		}
+	case 2:
+		if err := parseQuietWindowDays(fields[0], &window.days); err != nil {
+			return quietWindow{}, err
+		}
+		fields = fields[1:]
+	default:
+		return quietWindow{}, fmt.Errorf("expected [days] HH:MM-HH:MM")
+	}
+
+	startText, endText, ok := strings.Cut(fields[0], "-")
+	if !ok {
+		return quietWindow{}, fmt.Errorf("expected a time range HH:MM-HH:MM")
+	}
+	var err error
+	if window.start, err = parseTimeOfDay(startText, false); err != nil {
+		return quietWindow{}, err
+	}
+	if window.end, err = parseTimeOfDay(endText, true); err != nil {
+		return quietWindow{}, err
+	}
+	if window.start == window.end {
+		return quietWindow{}, fmt.Errorf("start and end are the same; use 00:00-24:00 for a whole day")
+	}
+	return window, nil
+}
+
+// parseQuietWindowDays parses a day such as "Sat" or a range such as "Mon-Fri", which may wrap past Sunday.
+func parseQuietWindowDays(text string, days *[7]bool) error {
+	firstText, lastText, isRange := strings.Cut(text, "-")
+	first, ok := weekdayNames[strings.ToLower(firstText)]
+	if !ok {
+		return fmt.Errorf("unknown day %q", firstText)
+	}
+	last := first
+	if isRange {
+		if last, ok = weekdayNames[strings.ToLower(lastText)]; !ok {
+			return fmt.Errorf("unknown day %q", lastText)
+		}
+	}
+	for day := first; ; day = (day + 1) % 7 {
+		days[day] = true
+		if day == last {
+			return nil
+		}
+	}
+}
+
+// parseTimeOfDay parses HH:MM as an offset from midnight. 24:00 is only accepted as an end.
+func parseTimeOfDay(text string, isEnd bool) (time.Duration, error) {
+	hourText, minuteText, ok := strings.Cut(text, ":")
+	hour, hourErr := strconv.Atoi(hourText)
+	minute, minuteErr := strconv.Atoi(minuteText)
+	if !ok || hourErr != nil || minuteErr != nil || len(minuteText) != 2 || hour < 0 || minute < 0 || minute > 59 ||
+		hour > 24 || (hour == 24 && (minute != 0 || !isEnd)) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM in UTC", text)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
@@ -0,0 +1,70 @@
+/*
+  Copyright (c) 2021, Oracle and/or its affiliates. All rights reserved.
+  Licensed under the Universal Permissive License v 1.0 as shown at http://oss.oracle.com/licenses/upl.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 2024-06-07 is a Friday.
+func utcTime(day, hour, minute int) time.Time {
+	return time.Date(2024, time.June, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestInQuietWindow(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  string
+		now   time.Time
+		quiet bool
+	}{
+		{"empty spec", "", utcTime(7, 12, 0), false},
+		{"daily inside", "10:00-14:00", utcTime(7, 12, 0), true},
+		{"daily at end", "10:00-14:00", utcTime(7, 14, 0), false},
+		{"daily before start", "10:00-14:00", utcTime(7, 9, 59), false},
+		{"past midnight, evening", "22:00-06:00", utcTime(7, 23, 0), true},
+		{"past midnight, morning", "22:00-06:00", utcTime(8, 5, 0), true},
+		{"past midnight, afternoon", "22:00-06:00", utcTime(8, 12, 0), false},
+		{"weekend day", "Sat-Sun 00:00-24:00", utcTime(8, 12, 0), true},
+		{"weekday outside weekend window", "Sat-Sun 00:00-24:00", utcTime(7, 12, 0), false},
+		{"wrapping day range", "Fri-Mon 09:00-10:00", utcTime(10, 9, 30), true},
+		{"night starting on a listed day", "Fri 22:00-06:00", utcTime(8, 3, 0), true},
+		{"night starting on an unlisted day", "Fri 22:00-06:00", utcTime(9, 3, 0), false},
+		{"second window", "Sat 00:00-24:00, 12:00-13:00", utcTime(7, 12, 30), true},
+		{"invalid spec", "sometimes", utcTime(7, 12, 0), false},
+		{"local time is converted", "10:00-14:00", time.Date(2024, time.June, 7, 14, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.quiet, InQuietWindow(tt.now, tt.spec))
+		})
+	}
+}
+
+func TestQuietWindowEnd(t *testing.T) {
+	end, quiet := QuietWindowEnd(utcTime(7, 23, 0), "22:00-06:00")
+	assert.True(t, quiet)
+	assert.Equal(t, utcTime(8, 6, 0), end)
+
+	end, quiet = QuietWindowEnd(utcTime(7, 23, 0), "Fri 20:00-24:00,Sat-Sun 00:00-24:00")
+	assert.True(t, quiet)
+	assert.Equal(t, utcTime(10, 0, 0), end, "back-to-back windows close together")
+
+	_, quiet = QuietWindowEnd(utcTime(7, 12, 0), "22:00-06:00")
+	assert.False(t, quiet)
+}
+
+func TestValidateQuietWindow(t *testing.T) {
+	for _, spec := range []string{"", "22:00-06:00", "Sat 00:00-24:00", "mon-fri 18:00-20:00, Sun 01:00-02:30"} {
+		assert.NoError(t, ValidateQuietWindow(spec), spec)
+	}
+	for _, spec := range []string{"22:00", "Someday 01:00-02:00", "25:00-26:00", "24:00-01:00", "10:00-10:00", "1:5-2:00", "Mon Tue 01:00-02:00"} {
+		assert.Error(t, ValidateQuietWindow(spec), spec)
+	}
+}